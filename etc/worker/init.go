@@ -1,8 +1,21 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+)
+
+const (
+	// runTFJobReplicaArg makes init run a TFJob replica's command (the rest of
+	// the arguments) rather than copying binaries, see runTFJobReplica.
+	runTFJobReplicaArg = "run-tfjob-replica"
+	outputDir          = "/pfs/out"
 )
 
 func cp(src, dst string) error {
@@ -31,11 +44,102 @@ func cp(src, dst string) error {
 	return nil
 }
 
+// tfConfig is the part of the TF_CONFIG environment variable, which Kubeflow
+// sets in the pods of a TFJob, that identifies a replica.
+type tfConfig struct {
+	Cluster map[string]interface{} `json:"cluster"`
+	Task    struct {
+		Type  string `json:"type"`
+		Index int    `json:"index"`
+	} `json:"task"`
+}
+
+// isChief returns true if this replica is the chief of its TFJob, which is the
+// chief (or master) replica if there is one, and otherwise the first worker.
+func isChief() bool {
+	var config tfConfig
+	if err := json.Unmarshal([]byte(os.Getenv("TF_CONFIG")), &config); err != nil {
+		// Kubeflow doesn't set TF_CONFIG for TFJobs with a single replica
+		return true
+	}
+	switch config.Task.Type {
+	case "chief", "master":
+		return true
+	case "worker":
+		_, hasChief := config.Cluster["chief"]
+		_, hasMaster := config.Cluster["master"]
+		return !hasChief && !hasMaster && config.Task.Index == 0
+	}
+	return false
+}
+
+// runTFJobReplica runs the command of a TFJob replica. If the command succeeds
+// and the replica is the TFJob's chief, it then uploads the contents of
+// /pfs/out to the job's output commit, so that the replica only succeeds once
+// the output has been uploaded. It returns the exit code of the replica.
+func runTFJobReplica(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "no command to run")
+		return 1
+	}
+	if err := os.MkdirAll(outputDir, 0777); err != nil {
+		fmt.Fprintf(os.Stderr, "could not create %s: %v\n", outputDir, err)
+		return 1
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "could not run %q: %v\n", args[0], err)
+		return 1
+	}
+	// Pass signals (e.g. from the pod being deleted) on to the command
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		for s := range signals {
+			cmd.Process.Signal(s)
+		}
+	}()
+	if err := cmd.Wait(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return exitErr.ExitCode()
+		}
+		fmt.Fprintf(os.Stderr, "error running %q: %v\n", args[0], err)
+		return 1
+	}
+	if !isChief() {
+		return 0
+	}
+	files, err := ioutil.ReadDir(outputDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "could not read %s: %v\n", outputDir, err)
+		return 1
+	}
+	if len(files) == 0 {
+		return 0
+	}
+	put := exec.Command("/pach-bin/pachctl", "put", "file", "-r",
+		fmt.Sprintf("%s@%s:/", os.Getenv("PPS_PIPELINE_NAME"), os.Getenv("PACH_OUTPUT_COMMIT_ID")),
+		"-f", outputDir)
+	put.Stdout, put.Stderr = os.Stdout, os.Stderr
+	if err := put.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "could not upload %s: %v\n", outputDir, err)
+		return 1
+	}
+	return 0
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == runTFJobReplicaArg {
+		os.Exit(runTFJobReplica(os.Args[2:]))
+	}
 	if err := cp("/app/worker", "/pach-bin/worker"); err != nil {
 		panic(err)
 	}
 	if err := cp("/app/pachctl", "/pach-bin/pachctl"); err != nil {
 		panic(err)
 	}
+	if err := cp("/app/init", "/pach-bin/init"); err != nil {
+		panic(err)
+	}
 }
@@ -574,6 +574,11 @@ type PutFileClient interface {
 	// overwrite the entire file, specify an index of 0.
 	PutFileOverwrite(repo, commit, path string, r io.Reader) error
 
+	// PutFileSplit writes data to PFS from a reader, splitting it into
+	// multiple files by the delimiter. The files are written into the
+	// directory at path.
+	PutFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader) error
+
 	// PutFileURL puts a file using the content found at a URL.
 	// The URL is sent to the server which performs the request.
 	// recursive allows for recursive scraping of some types URLs. For example on s3:// urls.
@@ -616,6 +621,10 @@ func (pfc *putFileClient) PutFileOverwrite(repo, commit, path string, r io.Reade
 	return pfc.c.AppendFile(repo, commit, path, true, r)
 }
 
+func (pfc *putFileClient) PutFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader) error {
+	return pfc.c.AppendFileSplit(repo, commit, path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, r)
+}
+
 func (pfc *putFileClient) PutFileURL(repo, commit, path, url string, recursive, overwrite bool) error {
 	return pfc.c.AppendFileURL(repo, commit, path, url, recursive, overwrite)
 }
@@ -660,6 +669,20 @@ func (c APIClient) PutFileOverwrite(repoName string, commitID string, path strin
 	return pfc.PutFileOverwrite(repoName, commitID, path, reader)
 }
 
+// PutFileSplit writes data to PFS from a reader, splitting it into multiple
+// files by the delimiter. The files are written into the directory at path,
+// and are named by their (hex encoded) index in the directory.
+// targetFileDatums and targetFileBytes control the number of records and bytes
+// in each file (each file gets one record if both are 0), and headerRecords is
+// the number of records that are prepended to every file.
+func (c APIClient) PutFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader) error {
+	pfc, err := c.NewPutFileClient()
+	if err != nil {
+		return err
+	}
+	return pfc.PutFileSplit(repo, commit, path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, r)
+}
+
 // PutFileURL puts a file using the content found at a URL.
 // The URL is sent to the server which performs the request.
 // recursive allow for recursive scraping of some types URLs for example on s3:// urls.
@@ -686,12 +709,12 @@ func (c APIClient) CopyFile(srcRepo, srcCommit, srcPath, dstRepo, dstCommit, dst
 }
 
 // GetFile returns the contents of a file at a specific Commit.
-// offset specifies a number of bytes that should be skipped in the beginning of the file.
-// size limits the total amount of data returned, note you will get fewer bytes
+// WithOffset specifies a number of bytes that should be skipped in the beginning of the file.
+// WithSize limits the total amount of data returned, note you will get fewer bytes
 // than size if you pass a value larger than the size of the file.
-// If size is set to 0 then all of the data will be returned.
-func (c APIClient) GetFile(repo, commit, path string, w io.Writer) error {
-	r, err := c.getFile(repo, commit, path)
+// If size is not set then all of the data will be returned.
+func (c APIClient) GetFile(repo, commit, path string, w io.Writer, opts ...GetFileOption) error {
+	r, err := c.getFile(repo, commit, path, opts...)
 	if err != nil {
 		return err
 	}
@@ -701,17 +724,46 @@ func (c APIClient) GetFile(repo, commit, path string, w io.Writer) error {
 }
 
 // GetTarFile gets a tar file from PFS.
-func (c APIClient) GetTarFile(repo, commit, path string) (io.Reader, error) {
-	return c.getFile(repo, commit, path)
+func (c APIClient) GetTarFile(repo, commit, path string, opts ...GetFileOption) (io.Reader, error) {
+	return c.getFile(repo, commit, path, opts...)
 }
 
-func (c APIClient) getFile(repo, commit, path string) (_ io.Reader, retErr error) {
+// GetFileOption configures a GetFile request.
+type GetFileOption func(*pfs.GetFileRequest)
+
+// WithOffset skips the first offset bytes of each file.
+func WithOffset(offset int64) GetFileOption {
+	return func(req *pfs.GetFileRequest) {
+		req.OffsetBytes = offset
+	}
+}
+
+// WithSize limits the number of bytes read from each file to size (fewer
+// bytes are read if the file is smaller).
+func WithSize(size int64) GetFileOption {
+	return func(req *pfs.GetFileRequest) {
+		req.SizeBytes = size
+	}
+}
+
+// WithReadahead sets the number of chunks that pachd fetches from object
+// storage in parallel while reading the files.
+func WithReadahead(n int) GetFileOption {
+	return func(req *pfs.GetFileRequest) {
+		req.Readahead = int64(n)
+	}
+}
+
+func (c APIClient) getFile(repo, commit, path string, opts ...GetFileOption) (_ io.Reader, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	req := &pfs.GetFileRequest{
 		File: NewFile(repo, commit, path),
 	}
+	for _, opt := range opts {
+		opt(req)
+	}
 	client, err := c.PfsAPIClient.GetFile(c.Ctx(), req)
 	if err != nil {
 		return nil, err
@@ -721,14 +773,22 @@ func (c APIClient) getFile(repo, commit, path string) (_ io.Reader, retErr error
 
 // InspectFile returns info about a specific file.
 func (c APIClient) InspectFile(repoName string, commitID string, path string) (*pfs.FileInfo, error) {
-	return c.inspectFile(repoName, commitID, path)
+	return c.inspectFile(repoName, commitID, path, 0)
+}
+
+// InspectFileHistory returns info about a historical version of a file.
+// history 1 is the version in the last commit that modified the file, 2 the
+// version before that and so on, and -1 is the oldest version.
+func (c APIClient) InspectFileHistory(repoName string, commitID string, path string, history int64) (*pfs.FileInfo, error) {
+	return c.inspectFile(repoName, commitID, path, history)
 }
 
-func (c APIClient) inspectFile(repoName string, commitID string, path string) (*pfs.FileInfo, error) {
+func (c APIClient) inspectFile(repoName string, commitID string, path string, history int64) (*pfs.FileInfo, error) {
 	fileInfo, err := c.PfsAPIClient.InspectFile(
 		c.Ctx(),
 		&pfs.InspectFileRequest{
-			File: NewFile(repoName, commitID, path),
+			File:    NewFile(repoName, commitID, path),
+			History: history,
 		},
 	)
 	if err != nil {
@@ -738,14 +798,39 @@ func (c APIClient) inspectFile(repoName string, commitID string, path string) (*
 }
 
 // ListFile returns info about all files in a Commit under path, calling cb with each FileInfo.
-func (c APIClient) ListFile(repo, commit, path string, cb func(fi *pfs.FileInfo) error) (retErr error) {
+func (c APIClient) ListFile(repo, commit, path string, cb func(fi *pfs.FileInfo) error) error {
+	return c.ListFileHistoryF(repo, commit, path, 0, cb)
+}
+
+// ListFileHistory returns info about the historical versions of the files in
+// a Commit under path. history sets how many versions of each file are
+// returned, -1 returns all of them.
+func (c APIClient) ListFileHistory(repo, commit, path string, history int64) (_ []*pfs.FileInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var fis []*pfs.FileInfo
+	if err := c.ListFileHistoryF(repo, commit, path, history, func(fi *pfs.FileInfo) error {
+		fis = append(fis, fi)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return fis, nil
+}
+
+// ListFileHistoryF returns info about the historical versions of the files in
+// a Commit under path, calling cb with each FileInfo. history sets how many
+// versions of each file are returned, -1 returns all of them.
+func (c APIClient) ListFileHistoryF(repo, commit, path string, history int64, cb func(fi *pfs.FileInfo) error) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	fs, err := c.PfsAPIClient.ListFile(
 		c.Ctx(),
 		&pfs.ListFileRequest{
-			File: NewFile(repo, commit, path),
+			File:    NewFile(repo, commit, path),
+			History: history,
 		},
 	)
 	if err != nil {
@@ -981,6 +1066,47 @@ func (c APIClient) FsckFastExit() error {
 	}
 }
 
+// GarbageCollectStorage runs garbage collection on the storage layer, calling
+// cb with each object that is deleted. If dryRun is set, nothing is deleted, and
+// cb is called with each object that would be deleted instead.
+func (c APIClient) GarbageCollectStorage(dryRun bool, cb func(*pfs.GarbageCollectResponse) error) error {
+	gcClient, err := c.PfsAPIClient.GarbageCollect(c.Ctx(), &pfs.GarbageCollectRequest{DryRun: dryRun})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		resp, err := gcClient.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := cb(resp); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				break
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ExplainStorageObject explains why an object in the storage layer (e.g.
+// "chunk/<hash>") has not been garbage collected, by returning the chains of
+// references to it from the filesets that keep it alive.
+func (c APIClient) ExplainStorageObject(id string) (_ *pfs.ExplainStorageObjectResponse, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.ExplainStorageObject(
+		c.Ctx(),
+		&pfs.ExplainStorageObjectRequest{
+			Id: id,
+		},
+	)
+}
+
 // TODO: Delete everything below after 1.12
 
 // PutObjectAsync puts a value into the object store asynchronously.
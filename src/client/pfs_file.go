@@ -23,6 +23,19 @@ func (c APIClient) AppendFile(repo, commit, path string, overwrite bool, r io.Re
 	return mfc.Close()
 }
 
+// AppendFileSplit appends a file that is split into multiple files by the
+// delimiter (see ModifyFileClient.AppendFileSplit).
+func (c APIClient) AppendFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader, tag ...string) error {
+	mfc, err := c.NewModifyFileClient(repo, commit)
+	if err != nil {
+		return err
+	}
+	if err := mfc.AppendFileSplit(path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, r, tag...); err != nil {
+		return err
+	}
+	return mfc.Close()
+}
+
 // AppendFileTar appends a set of files from a tar stream.
 func (c APIClient) AppendFileTar(repo, commit string, overwrite bool, r io.Reader, tag ...string) error {
 	mfc, err := c.NewModifyFileClient(repo, commit)
@@ -123,41 +136,60 @@ type modifyFileCore struct {
 // AppendFile appends a file.
 func (mfc *modifyFileCore) AppendFile(path string, overwrite bool, r io.Reader, tag ...string) error {
 	return mfc.maybeError(func() error {
-		af := &pfs.AppendFile{
-			Overwrite: overwrite,
-			Source: &pfs.AppendFile_RawFileSource{
-				RawFileSource: &pfs.RawFileSource{
-					Path: path,
-				},
-			},
-		}
-		if len(tag) > 0 {
-			if len(tag) > 1 {
-				return errors.Errorf("AppendFile called with %v tags, expected 0 or 1", len(tag))
-			}
-			af.Tag = tag[0]
-		}
-		if err := mfc.sendAppendFile(af); err != nil {
-			return err
-		}
-		if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
-			return mfc.sendAppendFile(&pfs.AppendFile{
-				Source: &pfs.AppendFile_RawFileSource{
-					RawFileSource: &pfs.RawFileSource{
-						Data: data,
-					},
-				},
-			})
-		}); err != nil {
-			return err
+		return mfc.appendFile(&pfs.AppendFile{Overwrite: overwrite}, path, r, tag...)
+	})
+}
+
+// AppendFileSplit appends a file that is split into multiple files by the
+// delimiter. The files are written into the directory at path, and are named
+// by their (hex encoded) index in the directory. targetFileDatums and
+// targetFileBytes control the number of records and bytes in each file (each
+// file gets one record if both are 0), and headerRecords is the number of
+// records that are prepended to every file.
+func (mfc *modifyFileCore) AppendFileSplit(path string, delimiter pfs.Delimiter, targetFileDatums, targetFileBytes, headerRecords int64, overwrite bool, r io.Reader, tag ...string) error {
+	return mfc.maybeError(func() error {
+		return mfc.appendFile(&pfs.AppendFile{
+			Overwrite:        overwrite,
+			Delimiter:        delimiter,
+			TargetFileDatums: targetFileDatums,
+			TargetFileBytes:  targetFileBytes,
+			HeaderRecords:    headerRecords,
+		}, path, r, tag...)
+	})
+}
+
+func (mfc *modifyFileCore) appendFile(af *pfs.AppendFile, path string, r io.Reader, tag ...string) error {
+	af.Source = &pfs.AppendFile_RawFileSource{
+		RawFileSource: &pfs.RawFileSource{
+			Path: path,
+		},
+	}
+	if len(tag) > 0 {
+		if len(tag) > 1 {
+			return errors.Errorf("AppendFile called with %v tags, expected 0 or 1", len(tag))
 		}
+		af.Tag = tag[0]
+	}
+	if err := mfc.sendAppendFile(af); err != nil {
+		return err
+	}
+	if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
 		return mfc.sendAppendFile(&pfs.AppendFile{
 			Source: &pfs.AppendFile_RawFileSource{
 				RawFileSource: &pfs.RawFileSource{
-					EOF: true,
+					Data: data,
 				},
 			},
 		})
+	}); err != nil {
+		return err
+	}
+	return mfc.sendAppendFile(&pfs.AppendFile{
+		Source: &pfs.AppendFile_RawFileSource{
+			RawFileSource: &pfs.RawFileSource{
+				EOF: true,
+			},
+		},
 	})
 }
 
@@ -258,6 +290,31 @@ func (mfc *modifyFileCore) sendDeleteFile(req *pfs.DeleteFile) error {
 	})
 }
 
+// CopyFile copies the file (or directory) at srcPath in the finished commit
+// srcCommit to dst. The content is copied by reference, so none of it is
+// re-uploaded.
+// The optional tag field indicates the tag of the copied files.
+func (mfc *modifyFileCore) CopyFile(dst, srcRepo, srcCommit, srcPath string, overwrite bool, tag ...string) error {
+	return mfc.maybeError(func() error {
+		req := &pfs.CopyFile{
+			Src:       NewFile(srcRepo, srcCommit, srcPath),
+			Dst:       dst,
+			Overwrite: overwrite,
+		}
+		if len(tag) > 0 {
+			if len(tag) > 1 {
+				return errors.Errorf("CopyFile called with %v tags, expected 0 or 1", len(tag))
+			}
+			req.Tag = tag[0]
+		}
+		return mfc.client.Send(&pfs.ModifyFileRequest{
+			Modification: &pfs.ModifyFileRequest_CopyFile{
+				CopyFile: req,
+			},
+		})
+	})
+}
+
 // TmpRepoName is a reserved repo name used for namespacing temporary filesets
 const TmpRepoName = "__tmp__"
 
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"io"
+	"strconv"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -13,6 +14,8 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 
 	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -297,16 +300,35 @@ func (c APIClient) ListJobFilterF(pipelineName string, inputCommit []*pfs.Commit
 	if pipelineName != "" {
 		pipeline = NewPipeline(pipelineName)
 	}
-	client, err := c.PpsAPIClient.ListJob(
-		c.Ctx(),
-		&pps.ListJobRequest{
-			Pipeline:     pipeline,
-			InputCommit:  inputCommit,
-			OutputCommit: outputCommit,
-			History:      history,
-			Full:         includePipelineInfo,
-			JqFilter:     jqFilter,
-		})
+	return c.listJob(&pps.ListJobRequest{
+		Pipeline:     pipeline,
+		InputCommit:  inputCommit,
+		OutputCommit: outputCommit,
+		History:      history,
+		Full:         includePipelineInfo,
+		JqFilter:     jqFilter,
+	}, f)
+}
+
+// ListJobPageF returns info about a page of the jobs of a pipeline (or of all
+// pipelines if pipelineName is empty), calling f with each JobInfo. Jobs are
+// returned from newest to oldest. pageSize sets the number of jobs in the
+// page. startAfter, if set, is the ID of the last job of the previous page,
+// only the jobs after it are returned (it's an error if there's no such job).
+func (c APIClient) ListJobPageF(pipelineName string, pageSize int64, startAfter string, f func(*pps.JobInfo) error) error {
+	var pipeline *pps.Pipeline
+	if pipelineName != "" {
+		pipeline = NewPipeline(pipelineName)
+	}
+	return c.listJob(&pps.ListJobRequest{
+		Pipeline:   pipeline,
+		PageSize:   pageSize,
+		StartAfter: startAfter,
+	}, f)
+}
+
+func (c APIClient) listJob(req *pps.ListJobRequest, f func(*pps.JobInfo) error) error {
+	client, err := c.PpsAPIClient.ListJob(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
@@ -435,6 +457,54 @@ func (c APIClient) ListDatum(job string, cb func(*pps.DatumInfo) error) (retErr
 	}
 }
 
+// ListDatumPage returns info about a page of the datums in a job, calling cb
+// with each DatumInfo, and returns the total number of datums in the job.
+// Datums are returned in order of their IDs. pageSize sets the number of
+// datums in the page, and page the number of pages to skip. startAfter, if
+// set, is the ID of the last datum of the previous page, only the datums after
+// it are returned.
+func (c APIClient) ListDatumPage(job string, pageSize, page int64, startAfter string, cb func(*pps.DatumInfo) error) (_ int64, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	var header metadata.MD
+	client, err := c.PpsAPIClient.ListDatum(
+		c.Ctx(),
+		&pps.ListDatumRequest{
+			Job:        NewJob(job),
+			PageSize:   pageSize,
+			Page:       page,
+			StartAfter: startAfter,
+		},
+		grpc.Header(&header),
+	)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		di, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return 0, err
+		}
+		if err := cb(di); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				break
+			}
+			return 0, err
+		}
+	}
+	// The header is received before the first datum, so it is available once
+	// the stream has been read from.
+	total := header.Get(pps.DatumTotalHeader)
+	if len(total) == 0 {
+		return 0, errors.Errorf("response is missing the %v header", pps.DatumTotalHeader)
+	}
+	return strconv.ParseInt(total[0], 10, 64)
+}
+
 // ListDatumAll returns info about datums in a job.
 func (c APIClient) ListDatumAll(job string) (_ []*pps.DatumInfo, retErr error) {
 	defer func() {
@@ -467,114 +537,113 @@ func (c APIClient) InspectDatum(jobID string, datumID string) (*pps.DatumInfo, e
 	return datumInfo, nil
 }
 
-// TODO: Implement logs with V2.
-//// LogsIter iterates through log messages returned from pps.GetLogs. Logs can
-//// be fetched with 'Next()'. The log message received can be examined with
-//// 'Message()', and any errors can be examined with 'Err()'.
-//type LogsIter struct {
-//	logsClient pps.API_GetLogsClient
-//	msg        *pps.LogMessage
-//	err        error
-//}
-//
-//// Next retrieves the next relevant log message from pachd
-//func (l *LogsIter) Next() bool {
-//	if l.err != nil {
-//		l.msg = nil
-//		return false
-//	}
-//	l.msg, l.err = l.logsClient.Recv()
-//	return l.err == nil
-//}
-//
-//// Message returns the most recently retrieve log message (as an annotated log
-//// line, in the form of a pps.LogMessage)
-//func (l *LogsIter) Message() *pps.LogMessage {
-//	return l.msg
-//}
-//
-//// Err retrieves any errors encountered in the course of calling 'Next()'.
-//func (l *LogsIter) Err() error {
-//	if errors.Is(l.err, io.EOF) {
-//		return nil
-//	}
-//	return grpcutil.ScrubGRPC(l.err)
-//}
-//
-//// GetLogs gets logs from a job (logs includes stdout and stderr). 'pipelineName',
-//// 'jobID', 'data', and 'datumID', are all filters. To forego any filter,
-//// simply pass an empty value, though one of 'pipelineName' and 'jobID'
-//// must be set. Responses are written to 'messages'
-//func (c APIClient) GetLogs(
-//	pipelineName string,
-//	jobID string,
-//	data []string,
-//	datumID string,
-//	master bool,
-//	follow bool,
-//	tail int64,
-//) *LogsIter {
-//	request := pps.GetLogsRequest{
-//		Master: master,
-//		Follow: follow,
-//		Tail:   tail,
-//	}
-//	resp := &LogsIter{}
-//	if pipelineName != "" {
-//		request.Pipeline = NewPipeline(pipelineName)
-//	}
-//	if jobID != "" {
-//		request.Job = NewJob(jobID)
-//	}
-//	request.DataFilters = data
-//	if datumID != "" {
-//		request.Datum = &pps.Datum{
-//			Job: NewJob(jobID),
-//			ID:  datumID,
-//		}
-//	}
-//	resp.logsClient, resp.err = c.PpsAPIClient.GetLogs(c.Ctx(), &request)
-//	resp.err = grpcutil.ScrubGRPC(resp.err)
-//	return resp
-//}
-//
-//// GetLogsLoki gets logs from a job (logs includes stdout and stderr). 'pipelineName',
-//// 'jobID', 'data', and 'datumID', are all filters. To forego any filter,
-//// simply pass an empty value, though one of 'pipelineName' and 'jobID'
-//// must be set. Responses are written to 'messages'
-//func (c APIClient) GetLogsLoki(
-//	pipelineName string,
-//	jobID string,
-//	data []string,
-//	datumID string,
-//	master bool,
-//	follow bool,
-//	tail int64,
-//) *LogsIter {
-//	request := pps.GetLogsRequest{
-//		Master:         master,
-//		Follow:         follow,
-//		Tail:           tail,
-//		UseLokiBackend: true,
-//	}
-//	resp := &LogsIter{}
-//	if pipelineName != "" {
-//		request.Pipeline = NewPipeline(pipelineName)
-//	}
-//	if jobID != "" {
-//		request.Job = NewJob(jobID)
-//	}
-//	request.DataFilters = data
-//	if datumID != "" {
-//		request.Datum = &pps.Datum{
-//			Job: NewJob(jobID),
-//			ID:  datumID,
-//		}
-//	}
-//	resp.logsClient, resp.err = c.PpsAPIClient.GetLogs(c.Ctx(), &request)
-//	resp.err = grpcutil.ScrubGRPC(resp.err)
-//	return resp
-//}
+// LogsIter iterates through log messages returned from pps.GetLogs. Logs can
+// be fetched with 'Next()'. The log message received can be examined with
+// 'Message()', and any errors can be examined with 'Err()'.
+type LogsIter struct {
+	logsClient pps.API_GetLogsClient
+	msg        *pps.LogMessage
+	err        error
+}
+
+// Next retrieves the next relevant log message from pachd
+func (l *LogsIter) Next() bool {
+	if l.err != nil {
+		l.msg = nil
+		return false
+	}
+	l.msg, l.err = l.logsClient.Recv()
+	return l.err == nil
+}
+
+// Message returns the most recently retrieve log message (as an annotated log
+// line, in the form of a pps.LogMessage)
+func (l *LogsIter) Message() *pps.LogMessage {
+	return l.msg
+}
+
+// Err retrieves any errors encountered in the course of calling 'Next()'.
+func (l *LogsIter) Err() error {
+	if errors.Is(l.err, io.EOF) {
+		return nil
+	}
+	return grpcutil.ScrubGRPC(l.err)
+}
+
+// GetLogs gets logs from a job (logs includes stdout and stderr). 'pipelineName',
+// 'jobID', 'data', and 'datumID', are all filters. To forego any filter,
+// simply pass an empty value, though one of 'pipelineName' and 'jobID'
+// must be set. Responses are written to 'messages'
+func (c APIClient) GetLogs(
+	pipelineName string,
+	jobID string,
+	data []string,
+	datumID string,
+	master bool,
+	follow bool,
+	tail int64,
+) *LogsIter {
+	request := pps.GetLogsRequest{
+		Master: master,
+		Follow: follow,
+		Tail:   tail,
+	}
+	resp := &LogsIter{}
+	if pipelineName != "" {
+		request.Pipeline = NewPipeline(pipelineName)
+	}
+	if jobID != "" {
+		request.Job = NewJob(jobID)
+	}
+	request.DataFilters = data
+	if datumID != "" {
+		request.Datum = &pps.Datum{
+			Job: NewJob(jobID),
+			ID:  datumID,
+		}
+	}
+	resp.logsClient, resp.err = c.PpsAPIClient.GetLogs(c.Ctx(), &request)
+	resp.err = grpcutil.ScrubGRPC(resp.err)
+	return resp
+}
+
+// GetLogsLoki gets logs from a job (logs includes stdout and stderr). 'pipelineName',
+// 'jobID', 'data', and 'datumID', are all filters. To forego any filter,
+// simply pass an empty value, though one of 'pipelineName' and 'jobID'
+// must be set. Responses are written to 'messages'
+func (c APIClient) GetLogsLoki(
+	pipelineName string,
+	jobID string,
+	data []string,
+	datumID string,
+	master bool,
+	follow bool,
+	tail int64,
+) *LogsIter {
+	request := pps.GetLogsRequest{
+		Master:         master,
+		Follow:         follow,
+		Tail:           tail,
+		UseLokiBackend: true,
+	}
+	resp := &LogsIter{}
+	if pipelineName != "" {
+		request.Pipeline = NewPipeline(pipelineName)
+	}
+	if jobID != "" {
+		request.Job = NewJob(jobID)
+	}
+	request.DataFilters = data
+	if datumID != "" {
+		request.Datum = &pps.Datum{
+			Job: NewJob(jobID),
+			ID:  datumID,
+		}
+	}
+	resp.logsClient, resp.err = c.PpsAPIClient.GetLogs(c.Ctx(), &request)
+	resp.err = grpcutil.ScrubGRPC(resp.err)
+	return resp
+}
 
 // CreatePipeline creates a new pipeline, pipelines are the main computation
 // object in PPS they create a flow of data from a set of input Repos to an
@@ -1462,6 +1462,7 @@ type DatumInfo struct {
 	Stats                *ProcessStats   `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
 	PfsState             *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState,proto3" json:"pfs_state,omitempty"`
 	Data                 []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Logs                 []*LogMessage   `protobuf:"bytes,6,rep,name=logs,proto3" json:"logs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -1535,6 +1536,13 @@ func (m *DatumInfo) GetData() []*pfs.FileInfo {
 	return nil
 }
 
+func (m *DatumInfo) GetLogs() []*LogMessage {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 type Aggregate struct {
 	Count                 int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	Mean                  float64  `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
@@ -3407,7 +3415,15 @@ type ListJobRequest struct {
 	// Note that if 'input_commit' is set, this field is coerced to "true"
 	Full bool `protobuf:"varint,5,opt,name=full,proto3" json:"full,omitempty"`
 	// A jq program string for additional result filtering
-	JqFilter             string   `protobuf:"bytes,6,opt,name=jqFilter,proto3" json:"jqFilter,omitempty"`
+	JqFilter string `protobuf:"bytes,6,opt,name=jqFilter,proto3" json:"jqFilter,omitempty"`
+	// page_size, if nonzero, limits the number of jobs returned. Jobs are
+	// returned from newest to oldest.
+	PageSize int64 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// start_after, if set, is a job ID, only the jobs after it (i.e. older than
+	// it) are returned. It is the cursor for fetching the page that follows the
+	// last job of the previous page. If no listed job has this ID, a "not
+	// found" error is returned.
+	StartAfter           string   `protobuf:"bytes,8,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3488,6 +3504,20 @@ func (m *ListJobRequest) GetJqFilter() string {
 	return ""
 }
 
+func (m *ListJobRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListJobRequest) GetStartAfter() string {
+	if m != nil {
+		return m.StartAfter
+	}
+	return ""
+}
+
 type FlushJobRequest struct {
 	Commits              []*pfs.Commit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
 	ToPipelines          []*Pipeline   `protobuf:"bytes,2,rep,name=to_pipelines,json=toPipelines,proto3" json:"to_pipelines,omitempty"`
@@ -4099,7 +4129,17 @@ type ListDatumRequest struct {
 	// Job and Input are two different ways to specify the datums you want.
 	// Only one can be set.
 	// Job is the job to list datums from.
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// page_size, if nonzero, limits the number of datums returned. Datums are
+	// returned in order of their IDs.
+	PageSize int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page is the number of pages of page_size datums to skip, after the datums
+	// that are skipped by start_after.
+	Page int64 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// start_after, if set, is a datum ID, only the datums after it are returned.
+	// It is the cursor for fetching the page that follows the last datum of the
+	// previous page.
+	StartAfter           string   `protobuf:"bytes,5,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4145,6 +4185,27 @@ func (m *ListDatumRequest) GetJob() *Job {
 	return nil
 }
 
+func (m *ListDatumRequest) GetPageSize() int64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListDatumRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListDatumRequest) GetStartAfter() string {
+	if m != nil {
+		return m.StartAfter
+	}
+	return ""
+}
+
 // ChunkSpec specifies how a pipeline should chunk its datums.
 type ChunkSpec struct {
 	// number, if nonzero, specifies that each chunk should contain `number`
@@ -5500,317 +5561,321 @@ func init() {
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptor_dbf57f97f56369c0) }
 
 var fileDescriptor_dbf57f97f56369c0 = []byte{
-	// 4957 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0xcd, 0x6f, 0x1b, 0x49,
-	0x76, 0x37, 0xc9, 0x26, 0xd9, 0x7c, 0xa4, 0xa8, 0x56, 0xe9, 0xc3, 0x14, 0x6d, 0x4b, 0x72, 0xfb,
-	0x63, 0x6c, 0xaf, 0x57, 0xf6, 0xc8, 0xbb, 0x93, 0x5d, 0xcf, 0x64, 0x66, 0xf4, 0x65, 0x47, 0x5c,
-	0x8d, 0xad, 0x6d, 0xda, 0x13, 0x24, 0x17, 0xa2, 0xd9, 0x2c, 0x52, 0x6d, 0x35, 0xbb, 0x7b, 0xfa,
-	0x43, 0x1e, 0xcd, 0x25, 0xff, 0x40, 0x0e, 0x8b, 0x04, 0xc8, 0x61, 0x0f, 0x01, 0xf2, 0x07, 0x04,
-	0xc9, 0x29, 0xa7, 0xfd, 0x03, 0x16, 0x08, 0x02, 0xe4, 0x92, 0xab, 0x11, 0x18, 0x0b, 0xe4, 0x90,
-	0x63, 0x6e, 0xd9, 0x4b, 0x50, 0xaf, 0xaa, 0x9b, 0xdd, 0x24, 0x45, 0x52, 0xd2, 0x20, 0x07, 0x02,
-	0x5d, 0xef, 0xbd, 0xaa, 0xae, 0x7a, 0xf5, 0xea, 0xbd, 0xdf, 0x7b, 0xd5, 0x84, 0x25, 0xc3, 0x32,
-	0xa9, 0x1d, 0x3c, 0x71, 0x5d, 0x9f, 0xfd, 0x36, 0x5d, 0xcf, 0x09, 0x1c, 0x92, 0x73, 0x5d, 0xbf,
-	0x7e, 0xa3, 0xe7, 0x38, 0x3d, 0x8b, 0x3e, 0x41, 0x52, 0x3b, 0xec, 0x3e, 0xa1, 0x7d, 0x37, 0x38,
-	0xe3, 0x12, 0xf5, 0xf5, 0x61, 0x66, 0x60, 0xf6, 0xa9, 0x1f, 0xe8, 0x7d, 0x57, 0x08, 0xac, 0x0d,
-	0x0b, 0x74, 0x42, 0x4f, 0x0f, 0x4c, 0xc7, 0x16, 0xfc, 0xa5, 0x9e, 0xd3, 0x73, 0xf0, 0xf1, 0x09,
-	0x7b, 0x8a, 0xa8, 0xd1, 0x74, 0xba, 0x3e, 0xfb, 0x71, 0xaa, 0x7a, 0x02, 0xe5, 0x26, 0x35, 0x3c,
-	0x1a, 0x7c, 0xe3, 0x84, 0x76, 0x40, 0x08, 0x48, 0xb6, 0xde, 0xa7, 0xb5, 0xcc, 0x46, 0xe6, 0x41,
-	0x49, 0xc3, 0x67, 0xa2, 0x40, 0xee, 0x84, 0x9e, 0xd5, 0x24, 0x24, 0xb1, 0x47, 0x72, 0x0b, 0xa0,
-	0xcf, 0xc4, 0x5b, 0xae, 0x1e, 0x1c, 0xd7, 0xb2, 0xc8, 0x28, 0x21, 0xe5, 0x48, 0x0f, 0x8e, 0xc9,
-	0x75, 0x28, 0x52, 0xfb, 0xb4, 0x75, 0xaa, 0x7b, 0xb5, 0x1c, 0xf2, 0x0a, 0xd4, 0x3e, 0xfd, 0x56,
-	0xf7, 0xd4, 0x3f, 0xe6, 0xa0, 0xf4, 0xc6, 0xd3, 0x6d, 0xbf, 0xeb, 0x78, 0x7d, 0xb2, 0x04, 0x79,
-	0xb3, 0xaf, 0xf7, 0xa2, 0x97, 0xf1, 0x06, 0x7b, 0x9b, 0xd1, 0xef, 0xd4, 0xb2, 0x1b, 0x39, 0xf6,
-	0x36, 0xa3, 0xdf, 0xc1, 0xe1, 0x3c, 0xaf, 0xc5, 0xa8, 0x73, 0x48, 0x2d, 0x50, 0xcf, 0xdb, 0xed,
-	0x77, 0xc8, 0x43, 0xc8, 0x51, 0xfb, 0xb4, 0x96, 0xdb, 0xc8, 0x3d, 0x28, 0x6f, 0x5d, 0xdf, 0x64,
-	0x3a, 0x8e, 0x47, 0xdf, 0xdc, 0xb7, 0x4f, 0xf7, 0xed, 0xc0, 0x3b, 0xd3, 0x98, 0x0c, 0x79, 0x04,
-	0x45, 0x1f, 0x97, 0xe9, 0xd7, 0x24, 0x14, 0x57, 0x50, 0x3c, 0xb1, 0x74, 0x2d, 0x12, 0x20, 0x8f,
-	0x81, 0xe0, 0x54, 0x5a, 0x6e, 0x68, 0x59, 0xad, 0xa8, 0x5b, 0x09, 0x5f, 0xad, 0x20, 0xe7, 0x28,
-	0xb4, 0xac, 0xa6, 0x90, 0x5e, 0x82, 0xbc, 0x1f, 0x74, 0x4c, 0xbb, 0x96, 0x47, 0x01, 0xde, 0x20,
-	0x37, 0xa0, 0xc4, 0xe6, 0xcc, 0x39, 0x55, 0xe4, 0xc8, 0xd4, 0xf3, 0x9a, 0xc8, 0x7c, 0x0c, 0x44,
-	0x37, 0x0c, 0xea, 0x06, 0x2d, 0x8f, 0x06, 0xa1, 0x67, 0xb7, 0x0c, 0xa7, 0x43, 0x6b, 0x85, 0x8d,
-	0xdc, 0x83, 0x9c, 0xa6, 0x70, 0x8e, 0x86, 0x8c, 0x5d, 0xa7, 0x43, 0xd9, 0x0b, 0x3a, 0xb4, 0x1d,
-	0xf6, 0x6a, 0xc5, 0x8d, 0xcc, 0x03, 0x59, 0xe3, 0x0d, 0xb6, 0x51, 0xa1, 0x4f, 0xbd, 0x1a, 0xf0,
-	0x8d, 0x62, 0xcf, 0x64, 0x1d, 0xca, 0xef, 0x1d, 0xef, 0xc4, 0xb4, 0x7b, 0xad, 0x8e, 0xe9, 0xd5,
-	0xca, 0xc8, 0x02, 0x41, 0xda, 0x33, 0x3d, 0xb2, 0x06, 0xd0, 0x71, 0x8c, 0x13, 0xea, 0x75, 0x4d,
-	0x8b, 0xd6, 0x2a, 0x9c, 0x3f, 0xa0, 0x90, 0xbb, 0x90, 0x6f, 0x87, 0xa6, 0xd5, 0xa9, 0xcd, 0x6f,
-	0x64, 0x1e, 0x94, 0xb7, 0xaa, 0xa8, 0xa3, 0x1d, 0x46, 0x69, 0xba, 0xd4, 0xd0, 0x38, 0xb3, 0xfe,
-	0x19, 0xc8, 0x91, 0x72, 0x23, 0xdb, 0xc8, 0x0c, 0x6c, 0x63, 0x09, 0xf2, 0xa7, 0xba, 0x15, 0x52,
-	0x61, 0x16, 0xbc, 0xf1, 0x3c, 0xfb, 0x8b, 0x8c, 0xfa, 0x6b, 0x28, 0xc5, 0x63, 0xb1, 0xf9, 0xa3,
-	0xf1, 0x08, 0x43, 0x63, 0xcf, 0xa4, 0x0e, 0xb2, 0xa5, 0xdb, 0xbd, 0x90, 0xd9, 0x04, 0xef, 0x1d,
-	0xb7, 0x07, 0xc6, 0x92, 0x4b, 0x18, 0x8b, 0xfa, 0x10, 0xf2, 0x6f, 0x5e, 0x34, 0x9c, 0x36, 0xd9,
-	0x80, 0x42, 0xd0, 0x6d, 0xbd, 0x73, 0xda, 0x7c, 0xc0, 0x9d, 0xd2, 0xc7, 0x0f, 0xeb, 0x9c, 0xa5,
-	0xe5, 0x83, 0x6e, 0xc3, 0x69, 0xab, 0x75, 0x28, 0xec, 0xf7, 0x3c, 0xea, 0xfb, 0x6c, 0xce, 0x6f,
-	0xb5, 0xc3, 0x68, 0xce, 0x6f, 0xb5, 0x43, 0xf5, 0x16, 0xe4, 0xd8, 0x20, 0x2b, 0x90, 0x35, 0x3b,
-	0x62, 0x80, 0xc2, 0xc7, 0x0f, 0xeb, 0xd9, 0x83, 0x3d, 0x2d, 0x6b, 0x76, 0xd4, 0xff, 0xcd, 0x80,
-	0xfc, 0x0d, 0x0d, 0xf4, 0x8e, 0x1e, 0xe8, 0xe4, 0x6b, 0x28, 0xeb, 0xb6, 0xed, 0x04, 0x78, 0xe0,
-	0xfc, 0x5a, 0x06, 0xad, 0x69, 0x0d, 0x35, 0x15, 0xc9, 0x6c, 0x6e, 0x0f, 0x04, 0xb8, 0x0d, 0x26,
-	0xbb, 0x90, 0x4f, 0xa1, 0x60, 0xe9, 0x6d, 0x6a, 0xf9, 0x68, 0xe4, 0xe5, 0xad, 0xd5, 0x74, 0xe7,
-	0x43, 0xe4, 0xf1, 0x7e, 0x42, 0xb0, 0xfe, 0x25, 0x28, 0xc3, 0x63, 0x5e, 0x44, 0xf5, 0xf5, 0x5f,
-	0x42, 0x39, 0x31, 0xec, 0x85, 0x76, 0xed, 0xaf, 0xa0, 0xd8, 0xa4, 0xde, 0xa9, 0x69, 0x50, 0x72,
-	0x07, 0xe6, 0x4c, 0x3b, 0xa0, 0x9e, 0xad, 0x5b, 0x2d, 0xd7, 0xf1, 0x02, 0x1c, 0x20, 0xaf, 0x55,
-	0x22, 0xe2, 0x91, 0xe3, 0x05, 0x4c, 0x88, 0x7e, 0x9f, 0x14, 0xca, 0x72, 0xa1, 0x88, 0x88, 0x42,
-	0x4c, 0xd3, 0x2e, 0xdf, 0x4a, 0xa1, 0xe9, 0x23, 0x2d, 0x6b, 0xba, 0xcc, 0x2a, 0x82, 0x33, 0x97,
-	0x0a, 0x5f, 0x83, 0xcf, 0x2a, 0x85, 0x7c, 0xd3, 0x75, 0xc2, 0x80, 0xdc, 0x84, 0x92, 0x73, 0x4a,
-	0xbd, 0xf7, 0x9e, 0x19, 0x70, 0x9f, 0x21, 0x6b, 0x03, 0x02, 0xb9, 0xcf, 0x4e, 0x38, 0xce, 0x13,
-	0xdf, 0x58, 0xde, 0xaa, 0x88, 0x13, 0x8e, 0x34, 0x2d, 0x62, 0x92, 0x15, 0x28, 0xf4, 0x75, 0xef,
-	0x84, 0xc6, 0xbe, 0x89, 0xb7, 0xd4, 0x7f, 0xc9, 0x82, 0x7c, 0xf4, 0xa2, 0x79, 0x60, 0xbb, 0xe1,
-	0x78, 0x37, 0x48, 0x40, 0xf2, 0xa8, 0xeb, 0x08, 0x0d, 0xe1, 0x33, 0x1b, 0xac, 0xed, 0xe9, 0xb6,
-	0x71, 0x1c, 0x0d, 0xc6, 0x5b, 0x8c, 0x6e, 0x38, 0xfd, 0xbe, 0x19, 0x88, 0x95, 0x88, 0x16, 0x1b,
-	0xa3, 0x67, 0x39, 0xed, 0x5a, 0x9e, 0x8f, 0xc1, 0x9e, 0x99, 0x7b, 0x7b, 0xe7, 0x98, 0x76, 0xcb,
-	0xb1, 0x6b, 0x32, 0x17, 0x66, 0xcd, 0xd7, 0x36, 0xf3, 0xb2, 0x4e, 0x18, 0x50, 0xaf, 0xc5, 0xda,
-	0x78, 0x5a, 0xd9, 0x82, 0x19, 0xa5, 0xe1, 0x98, 0x36, 0x59, 0x05, 0xb9, 0xe7, 0x39, 0xa1, 0xdb,
-	0x6a, 0x9f, 0x89, 0xa3, 0x5e, 0xc4, 0xf6, 0xce, 0x19, 0x7b, 0x8d, 0xa5, 0xff, 0x70, 0x56, 0x2b,
-	0x60, 0x1f, 0x7c, 0x66, 0xce, 0x01, 0x83, 0x4c, 0x8b, 0x9d, 0x74, 0x5f, 0x38, 0x13, 0x40, 0xd2,
-	0x0b, 0x46, 0x21, 0x55, 0xc8, 0xfa, 0xcf, 0x6a, 0x25, 0xa4, 0x67, 0xfd, 0x67, 0x4c, 0xa1, 0x81,
-	0x67, 0xf6, 0x7a, 0xc2, 0xc9, 0xa0, 0x42, 0xbb, 0xcc, 0xc3, 0x22, 0x4d, 0x8b, 0x98, 0xea, 0x3f,
-	0x65, 0xa0, 0xb4, 0xeb, 0x39, 0xf6, 0x85, 0x35, 0x27, 0x34, 0x94, 0x1b, 0xd6, 0x90, 0xef, 0x52,
-	0x23, 0xb2, 0x00, 0xf6, 0x9c, 0xde, 0xf8, 0xc2, 0xf0, 0xc6, 0x3f, 0x65, 0x0e, 0x58, 0xf7, 0x02,
-	0x54, 0x6a, 0x79, 0xab, 0xbe, 0xc9, 0xa3, 0xe3, 0x66, 0x14, 0x1d, 0x37, 0xdf, 0x44, 0xe1, 0x53,
-	0xe3, 0x82, 0xaa, 0x09, 0xf2, 0x4b, 0x33, 0x38, 0x7f, 0xbe, 0xab, 0x90, 0x0b, 0x3d, 0x8b, 0x4f,
-	0x77, 0xa7, 0xf8, 0xf1, 0xc3, 0x3a, 0x73, 0x12, 0x1a, 0xa3, 0x5d, 0x74, 0xc3, 0xd5, 0xff, 0xc9,
-	0x40, 0x9e, 0xbf, 0x68, 0x1d, 0x72, 0x6e, 0xd7, 0xc7, 0xe9, 0x97, 0xb7, 0xe6, 0xd0, 0x36, 0x23,
-	0x73, 0xd3, 0x18, 0x87, 0xac, 0x81, 0x84, 0x1b, 0x5d, 0x44, 0xa7, 0x00, 0x28, 0xc1, 0xd9, 0x48,
-	0x27, 0x1b, 0x90, 0xc7, 0xfd, 0xad, 0xc9, 0x23, 0x02, 0x9c, 0xc1, 0x24, 0x0c, 0xcf, 0xf1, 0x23,
-	0xbf, 0x92, 0x92, 0x40, 0x06, 0x93, 0x08, 0x6d, 0xd3, 0xb1, 0x45, 0xcc, 0x4c, 0x49, 0x20, 0x83,
-	0xa8, 0x20, 0x19, 0x9e, 0x63, 0xe3, 0x32, 0xa2, 0x08, 0x10, 0xef, 0xae, 0x86, 0x3c, 0xb6, 0x94,
-	0x9e, 0x19, 0xe9, 0x9b, 0x2f, 0x25, 0xd2, 0xa7, 0xc6, 0x38, 0xea, 0x09, 0xc8, 0x0d, 0xa7, 0x9d,
-	0x56, 0xb0, 0x94, 0x50, 0xf0, 0x9d, 0x58, 0x5b, 0x19, 0x1c, 0xa3, 0x8c, 0x96, 0xb5, 0x8b, 0xa4,
-	0x91, 0xb3, 0x92, 0x4d, 0x9c, 0x95, 0xc8, 0xb0, 0x73, 0x03, 0xc3, 0x56, 0xdf, 0xc2, 0xfc, 0x91,
-	0xee, 0xe9, 0x96, 0x45, 0x2d, 0xd3, 0xef, 0x63, 0x70, 0xa9, 0x83, 0x6c, 0x38, 0xb6, 0x1f, 0xe8,
-	0x36, 0x77, 0x3f, 0x92, 0x16, 0xb7, 0xc9, 0x06, 0x94, 0x0d, 0x87, 0x76, 0xbb, 0xa6, 0xc1, 0xd0,
-	0x10, 0x8e, 0x94, 0xd1, 0x92, 0xa4, 0x86, 0x24, 0x67, 0x94, 0xac, 0xfa, 0x0c, 0x4a, 0xb8, 0x00,
-	0x76, 0x38, 0xe2, 0x68, 0x25, 0x25, 0xa2, 0x15, 0x01, 0xe9, 0x58, 0xf7, 0x8f, 0x51, 0x0d, 0x15,
-	0x0d, 0x9f, 0xd5, 0xcf, 0x21, 0xbf, 0xa7, 0x07, 0x61, 0xff, 0xbc, 0x50, 0x42, 0xea, 0x90, 0x7b,
-	0x27, 0xd6, 0x54, 0xde, 0x92, 0x51, 0x75, 0x2c, 0x46, 0x31, 0xa2, 0xfa, 0xfb, 0x0c, 0x94, 0xb0,
-	0xf7, 0x81, 0xdd, 0x75, 0xd8, 0x56, 0x75, 0x58, 0x43, 0xa8, 0x88, 0x6f, 0x15, 0xb2, 0x35, 0xce,
-	0x20, 0xf7, 0xd0, 0xf0, 0x03, 0xee, 0xef, 0xaa, 0x5b, 0xf3, 0x03, 0x89, 0x26, 0x23, 0x6b, 0x9c,
-	0x4b, 0x3e, 0xe1, 0x62, 0x3e, 0x2e, 0xb5, 0xbc, 0xb5, 0xc0, 0x4d, 0xcf, 0x73, 0x0c, 0xea, 0xfb,
-	0x4c, 0xd0, 0xe7, 0x82, 0x3e, 0xb9, 0x0f, 0x25, 0xb7, 0xeb, 0xb7, 0xf8, 0x98, 0x7c, 0xff, 0x4b,
-	0xb8, 0x31, 0x4c, 0x05, 0x9a, 0xec, 0x76, 0x51, 0x9c, 0x92, 0xdb, 0x20, 0xb1, 0x40, 0x85, 0x80,
-	0x07, 0xf7, 0x5f, 0x88, 0xb0, 0x69, 0x6b, 0xc8, 0x52, 0xff, 0x39, 0x03, 0xa5, 0xed, 0x5e, 0xcf,
-	0xa3, 0x3d, 0xd6, 0x61, 0x09, 0xf2, 0x06, 0x83, 0x58, 0xb8, 0x94, 0x9c, 0xc6, 0x1b, 0x4c, 0x7f,
-	0x7d, 0xaa, 0xdb, 0x38, 0xfb, 0x8c, 0x86, 0xcf, 0xec, 0x18, 0xf9, 0x41, 0xa7, 0x43, 0x4f, 0xc5,
-	0xbe, 0x88, 0x16, 0x79, 0x08, 0x4a, 0xd7, 0xec, 0x06, 0xc7, 0x2d, 0x97, 0x7a, 0x06, 0xb5, 0x03,
-	0x06, 0x5f, 0x24, 0x94, 0x98, 0x47, 0xfa, 0x51, 0x4c, 0x26, 0x9f, 0xc1, 0x75, 0xdb, 0xb4, 0x29,
-	0x3a, 0xba, 0xa1, 0x1e, 0x79, 0xec, 0xb1, 0xcc, 0xd9, 0x2f, 0xd2, 0xfd, 0xd4, 0xbf, 0xc9, 0x42,
-	0x25, 0xa9, 0x15, 0xf2, 0x25, 0xcc, 0x75, 0x9c, 0xf7, 0xb6, 0xe5, 0xe8, 0x9d, 0x16, 0x43, 0xe0,
-	0x62, 0x23, 0x56, 0x47, 0xfc, 0xcb, 0x9e, 0x40, 0xdf, 0x5a, 0x25, 0x92, 0x67, 0x1e, 0x87, 0x7c,
-	0x01, 0x15, 0x97, 0x8f, 0xc7, 0xbb, 0x67, 0xa7, 0x75, 0x2f, 0x0b, 0x71, 0xec, 0xfd, 0x1c, 0xca,
-	0xa1, 0x3b, 0x78, 0x77, 0x6e, 0x5a, 0x67, 0xe0, 0xd2, 0xd8, 0xf7, 0x1e, 0x54, 0xe3, 0x99, 0xb7,
-	0xcf, 0x02, 0xea, 0xa3, 0xae, 0x24, 0x2d, 0x5e, 0xcf, 0x0e, 0x23, 0x92, 0xdb, 0x50, 0x11, 0xaf,
-	0xe0, 0x42, 0x79, 0x14, 0x12, 0xaf, 0x45, 0x11, 0xf5, 0xb7, 0x59, 0x58, 0x8e, 0xf7, 0x31, 0xa5,
-	0x9d, 0x67, 0xe3, 0xb5, 0xc3, 0x1d, 0x46, 0xdc, 0x65, 0x48, 0x25, 0x9f, 0x8e, 0x55, 0xc9, 0x70,
-	0x9f, 0x94, 0x1e, 0x9e, 0x8c, 0xd3, 0xc3, 0x70, 0x8f, 0xe4, 0xe2, 0x7f, 0x3e, 0x76, 0xf1, 0xa3,
-	0x7d, 0x86, 0x94, 0xf1, 0xe9, 0x18, 0x65, 0x8c, 0x99, 0x5a, 0x52, 0x39, 0xff, 0x9a, 0x85, 0xca,
-	0x9f, 0x3b, 0x0c, 0x3c, 0x30, 0x95, 0x84, 0x3e, 0x79, 0x08, 0xa5, 0xf7, 0xd8, 0x6e, 0xc5, 0x67,
-	0xbf, 0xf2, 0xf1, 0xc3, 0xba, 0xcc, 0x85, 0x0e, 0xf6, 0x34, 0x99, 0xb3, 0x0f, 0x3a, 0x0c, 0xaf,
-	0xbe, 0x73, 0xda, 0x4c, 0x2e, 0x3b, 0xc0, 0xab, 0xcc, 0x67, 0xee, 0x69, 0xf9, 0x77, 0x4e, 0xfb,
-	0xa0, 0xc3, 0x1c, 0x31, 0x9e, 0x32, 0xee, 0xa9, 0xab, 0x03, 0x4f, 0x8d, 0xa7, 0x11, 0x79, 0xe4,
-	0x67, 0x50, 0xc4, 0x88, 0x46, 0x3b, 0x62, 0x91, 0x93, 0x82, 0x5f, 0x24, 0x3a, 0x70, 0x08, 0xf9,
-	0x29, 0x0e, 0xe1, 0x16, 0xc0, 0x77, 0x21, 0x0d, 0x69, 0xcb, 0x37, 0x7f, 0xe0, 0x81, 0x37, 0xa7,
-	0x95, 0x90, 0xd2, 0x34, 0x7f, 0xe0, 0x66, 0xa6, 0x07, 0x7a, 0x4b, 0x6c, 0x17, 0xed, 0x20, 0xa8,
-	0xc8, 0x69, 0x73, 0x8c, 0x7a, 0x14, 0x11, 0x63, 0x31, 0x8f, 0x1a, 0x2c, 0x68, 0xd3, 0x0e, 0xc2,
-	0x1c, 0x21, 0xa6, 0x45, 0x44, 0xd5, 0x83, 0x8a, 0x46, 0x7d, 0x27, 0xf4, 0x0c, 0x8a, 0x3e, 0x9c,
-	0xe5, 0x81, 0x6e, 0x88, 0x6a, 0xcc, 0x6a, 0xec, 0x11, 0x91, 0x1b, 0xed, 0x3b, 0xde, 0x99, 0x08,
-	0x09, 0xa2, 0x45, 0xd6, 0x20, 0xd7, 0x73, 0x43, 0xb1, 0x1a, 0x8e, 0xfa, 0x5e, 0x1e, 0xbd, 0xc5,
-	0x8c, 0x85, 0x31, 0x98, 0xa3, 0xe9, 0x98, 0xfe, 0x49, 0xe4, 0xbc, 0xd9, 0x73, 0x43, 0x92, 0x73,
-	0x8a, 0xa4, 0xfe, 0x1c, 0x8a, 0x42, 0x32, 0x46, 0x9e, 0x99, 0x01, 0xf2, 0x64, 0x2f, 0xb4, 0xc3,
-	0x7e, 0x9b, 0x7a, 0xf8, 0xc2, 0x9c, 0x26, 0x5a, 0xea, 0x7f, 0x48, 0x50, 0xde, 0x0f, 0x8c, 0x0e,
-	0xc6, 0xb8, 0xae, 0x13, 0x39, 0xf5, 0xcc, 0x18, 0xa7, 0x4e, 0x1e, 0x82, 0xec, 0x9a, 0x2e, 0xb5,
-	0x4c, 0x3b, 0x32, 0x77, 0x11, 0xfb, 0x05, 0x51, 0x8b, 0xd9, 0xe4, 0x29, 0xcc, 0x39, 0x61, 0xe0,
-	0x86, 0x41, 0x2b, 0x81, 0x8c, 0x86, 0x82, 0x63, 0x85, 0x4b, 0xf0, 0x16, 0xa9, 0x41, 0xd1, 0xa3,
-	0x1c, 0xfc, 0xf0, 0x13, 0x1e, 0x35, 0xc7, 0xec, 0x4d, 0x7e, 0xdc, 0xde, 0xdc, 0x86, 0x0a, 0x8a,
-	0xf9, 0x27, 0xa6, 0xeb, 0xd2, 0x8e, 0xd8, 0xe3, 0x32, 0xa3, 0x35, 0x39, 0x89, 0x19, 0x01, 0x8a,
-	0x04, 0x4e, 0xa0, 0x5b, 0x62, 0x87, 0x4b, 0x8c, 0xf2, 0x86, 0x11, 0x18, 0xac, 0x44, 0x76, 0x57,
-	0x37, 0xad, 0x78, 0x6b, 0xb1, 0xc7, 0x0b, 0xa4, 0x8c, 0xd9, 0xfe, 0xf9, 0x31, 0xdb, 0x3f, 0x30,
-	0xca, 0xd2, 0x14, 0xa3, 0xdc, 0x84, 0x0a, 0x3e, 0x44, 0x4a, 0x82, 0x51, 0x25, 0x95, 0x51, 0x40,
-	0xe8, 0xe8, 0x4e, 0x14, 0x25, 0xcb, 0x18, 0x25, 0xe7, 0xa2, 0xed, 0x49, 0xc5, 0xc8, 0x15, 0x28,
-	0x78, 0x54, 0xf7, 0x1d, 0x5b, 0x24, 0xc5, 0xa2, 0x95, 0x3c, 0x60, 0x73, 0xb3, 0x1f, 0xb0, 0xcf,
-	0x40, 0xee, 0x9a, 0xb6, 0xe9, 0x1f, 0xd3, 0x4e, 0xad, 0x3a, 0xb5, 0x5b, 0x2c, 0xab, 0xfe, 0x61,
-	0x0e, 0x8a, 0xb3, 0xd8, 0xd4, 0x63, 0x28, 0x05, 0x51, 0x9d, 0x23, 0xe5, 0x43, 0xe3, 0xea, 0x87,
-	0x36, 0x10, 0x48, 0x59, 0x60, 0x6e, 0xb2, 0x05, 0x3e, 0x04, 0x25, 0x7a, 0x6e, 0x9d, 0x52, 0xcf,
-	0x67, 0x48, 0x71, 0x0e, 0x0d, 0x6b, 0x3e, 0xa2, 0x7f, 0xcb, 0xc9, 0xe4, 0x31, 0x94, 0x19, 0x36,
-	0x8f, 0x76, 0xe1, 0xc9, 0xe8, 0x2e, 0x00, 0xe3, 0x8b, 0x4d, 0xf8, 0x0a, 0x14, 0x77, 0x80, 0xd1,
-	0x5a, 0x88, 0xf0, 0x2b, 0xd8, 0x65, 0x89, 0xcf, 0x25, 0x0d, 0xe0, 0xb4, 0x79, 0x77, 0x08, 0xd1,
-	0xdd, 0x81, 0x02, 0xc5, 0xec, 0x5d, 0x94, 0x26, 0xca, 0xd8, 0x8d, 0x27, 0xf4, 0x9a, 0x60, 0x91,
-	0x4f, 0x00, 0x5c, 0xdd, 0xa3, 0x76, 0x80, 0x85, 0x80, 0xc2, 0x90, 0xea, 0x4a, 0x9c, 0xc7, 0x12,
-	0xfd, 0xc4, 0xb6, 0x16, 0x2f, 0xb7, 0xad, 0xf2, 0xec, 0xdb, 0x3a, 0x7a, 0xae, 0x4b, 0xd3, 0xce,
-	0x75, 0x6c, 0xb3, 0x30, 0x93, 0xcd, 0xde, 0x49, 0xd9, 0x6c, 0x22, 0x11, 0xae, 0x4e, 0x4a, 0x84,
-	0x37, 0x20, 0xef, 0xb3, 0xbc, 0xba, 0xf6, 0xd3, 0x04, 0xc0, 0xc4, 0x4c, 0x5b, 0xe3, 0x0c, 0xf2,
-	0x08, 0xca, 0x62, 0xe2, 0x98, 0xbe, 0x91, 0x04, 0x24, 0xd4, 0xa8, 0xeb, 0x68, 0xc0, 0xb9, 0xec,
-	0x99, 0xa5, 0xfd, 0x42, 0x56, 0xe4, 0x47, 0x0b, 0x38, 0x29, 0xb1, 0xae, 0x1d, 0x9e, 0x25, 0x25,
-	0xfc, 0xd5, 0xd2, 0x34, 0x7f, 0xb5, 0x32, 0x8b, 0xbf, 0x5a, 0x1b, 0xf5, 0x57, 0x43, 0x0e, 0xe9,
-	0xc1, 0x0c, 0x0e, 0x69, 0x73, 0x9c, 0x43, 0x4a, 0xfb, 0xbd, 0xeb, 0xc3, 0x7e, 0x2f, 0xf6, 0x57,
-	0xeb, 0x53, 0xfc, 0xd5, 0x67, 0x30, 0x27, 0x40, 0x81, 0x8f, 0x28, 0xa1, 0x56, 0xc3, 0x80, 0xce,
-	0x3b, 0x24, 0xe1, 0x83, 0x56, 0x79, 0x9f, 0x04, 0x13, 0x5f, 0xc2, 0x82, 0x27, 0xe2, 0x61, 0xcb,
-	0xa3, 0xdf, 0x85, 0xd4, 0x0f, 0xfc, 0xda, 0x6a, 0xe2, 0x65, 0xc9, 0x68, 0xa9, 0x29, 0x91, 0xac,
-	0x26, 0x44, 0xc9, 0x73, 0x98, 0x8f, 0xfb, 0x5b, 0x66, 0xdf, 0x0c, 0xfc, 0xda, 0xdd, 0xf3, 0x7a,
-	0x57, 0x23, 0xc9, 0x43, 0x14, 0x24, 0x07, 0x70, 0xdd, 0x37, 0x3b, 0xd4, 0xd0, 0xbd, 0xd6, 0xf0,
-	0x18, 0x4f, 0xcf, 0x1b, 0x63, 0x59, 0xf4, 0xd0, 0xd2, 0x43, 0x6d, 0x40, 0xde, 0x64, 0xa8, 0xa5,
-	0x56, 0x4f, 0x58, 0x99, 0xc8, 0x38, 0x91, 0x41, 0x36, 0x01, 0x6c, 0xfa, 0x3e, 0x32, 0x9b, 0x1b,
-	0x28, 0x36, 0x8f, 0x46, 0xc6, 0xad, 0x06, 0xd3, 0x8a, 0x92, 0x4d, 0xdf, 0x0b, 0x23, 0x1a, 0x0e,
-	0x00, 0xb7, 0xa6, 0x04, 0x80, 0xdb, 0x50, 0xa1, 0xb6, 0xde, 0xb6, 0x68, 0x8b, 0x6f, 0xd8, 0x06,
-	0xe6, 0x8e, 0x65, 0x4e, 0xe3, 0x60, 0x96, 0x80, 0xe4, 0xeb, 0x56, 0x50, 0xbb, 0x2d, 0x8a, 0x0e,
-	0xba, 0x15, 0x90, 0x9f, 0x02, 0x18, 0xc7, 0xa1, 0x7d, 0xc2, 0x9d, 0xd5, 0xbd, 0x64, 0x3a, 0xcc,
-	0xc8, 0xb8, 0xe6, 0x92, 0x11, 0x3d, 0x62, 0xb6, 0xc0, 0x52, 0x2f, 0x84, 0xa9, 0xec, 0x54, 0xdd,
-	0x9f, 0x9e, 0x2d, 0x30, 0xf9, 0x37, 0x5c, 0x9c, 0xe1, 0x7d, 0x06, 0x08, 0xa3, 0xde, 0x9f, 0x4c,
-	0xc5, 0xfb, 0xef, 0x9c, 0x76, 0xd4, 0x97, 0x9b, 0x3c, 0x7b, 0xb7, 0x67, 0x52, 0xbf, 0xf6, 0x30,
-	0x36, 0xf9, 0xb0, 0xff, 0x86, 0x51, 0xc8, 0x17, 0x30, 0xef, 0x1b, 0xc7, 0xb4, 0x13, 0x5a, 0xa6,
-	0xdd, 0xe3, 0x0b, 0x7a, 0x84, 0x2f, 0x58, 0xe4, 0x87, 0x3e, 0xe6, 0x71, 0x6b, 0xf0, 0x53, 0x6d,
-	0xb2, 0x0a, 0xb2, 0xeb, 0x74, 0x78, 0xb7, 0x9f, 0xf0, 0x42, 0x93, 0xeb, 0xf0, 0x2a, 0xee, 0x0d,
-	0x28, 0x31, 0x96, 0xab, 0x07, 0xc6, 0x71, 0xed, 0x31, 0x2f, 0xd9, 0xba, 0x4e, 0xe7, 0x88, 0xb5,
-	0x1b, 0x92, 0x2c, 0x29, 0xf9, 0x86, 0x24, 0xe7, 0x95, 0x42, 0x43, 0x92, 0x6f, 0x2a, 0xb7, 0x1a,
-	0x92, 0xac, 0x2a, 0x77, 0xd4, 0x3d, 0x28, 0x70, 0xbb, 0x1f, 0x5b, 0x7c, 0xb9, 0x9f, 0xce, 0x6a,
-	0x95, 0xa1, 0x73, 0x12, 0xb9, 0x3f, 0x75, 0x0d, 0xe4, 0x28, 0x82, 0x8d, 0x1b, 0x47, 0xfd, 0x63,
-	0x16, 0x14, 0x06, 0xd2, 0x22, 0x21, 0x8c, 0xaa, 0x0f, 0xa2, 0xc1, 0x33, 0x38, 0x38, 0x49, 0x05,
-	0xc2, 0x73, 0xbc, 0xab, 0x94, 0xf2, 0xae, 0x43, 0x71, 0x2f, 0x3b, 0x39, 0xee, 0xed, 0x02, 0xdb,
-	0xa7, 0x16, 0x26, 0xbc, 0xbe, 0x80, 0xf2, 0x77, 0x79, 0xe8, 0x1a, 0x9a, 0x1a, 0x73, 0xef, 0xbb,
-	0x28, 0xc6, 0x2b, 0xbf, 0xa5, 0x77, 0x51, 0x9b, 0x79, 0x22, 0x3d, 0x0c, 0x8e, 0x5b, 0x81, 0x73,
-	0x42, 0x6d, 0x51, 0x3a, 0x2c, 0x31, 0xca, 0x1b, 0x46, 0x20, 0xcf, 0xa0, 0x6a, 0xe9, 0x3e, 0xc6,
-	0x3c, 0x91, 0xbb, 0x17, 0xc6, 0x45, 0x8d, 0x0a, 0x13, 0x8a, 0x5a, 0x64, 0x03, 0xca, 0x89, 0x10,
-	0x8b, 0x51, 0x50, 0xd2, 0x92, 0xa4, 0xfa, 0x17, 0x50, 0x4d, 0x4f, 0x29, 0x59, 0x35, 0xce, 0x8f,
-	0xa9, 0x1a, 0xe7, 0x93, 0x55, 0xe3, 0xbf, 0xae, 0x42, 0x25, 0xa5, 0x79, 0x5e, 0x10, 0x59, 0x18,
-	0x29, 0x88, 0x24, 0xd1, 0x49, 0x66, 0x32, 0x3a, 0xa9, 0x41, 0x31, 0x02, 0x25, 0x65, 0x1e, 0x3d,
-	0x4e, 0x63, 0x30, 0x72, 0x11, 0x40, 0xf4, 0x38, 0xbe, 0x2b, 0xd8, 0x4c, 0xf8, 0x24, 0xbc, 0x2c,
-	0x18, 0xbd, 0x37, 0x18, 0x0b, 0x5d, 0xe0, 0x47, 0x87, 0x2e, 0xbf, 0x04, 0x30, 0x3c, 0xaa, 0x07,
-	0xb4, 0xd3, 0xd2, 0x03, 0x01, 0x5d, 0x26, 0xa1, 0x8b, 0x92, 0x90, 0xde, 0x0e, 0x06, 0x36, 0x5d,
-	0x9c, 0x66, 0xd3, 0x35, 0x06, 0x7b, 0x1c, 0x0c, 0x9c, 0xf7, 0xd1, 0x09, 0x46, 0x4d, 0xe6, 0x23,
-	0x3d, 0x6a, 0x30, 0xe4, 0x44, 0x3d, 0xcf, 0xf1, 0x44, 0x21, 0xba, 0xcc, 0x69, 0xfb, 0x8c, 0x44,
-	0x7e, 0x02, 0x0b, 0x3c, 0x3e, 0xf9, 0x51, 0x38, 0xa2, 0x9d, 0xda, 0xa7, 0xe8, 0x6a, 0x14, 0xc1,
-	0xd0, 0x22, 0x7a, 0x52, 0x58, 0x3f, 0xd5, 0x4d, 0x8b, 0xb9, 0xda, 0xda, 0x56, 0x4a, 0x78, 0x3b,
-	0xa2, 0x93, 0xaf, 0x52, 0x87, 0xa4, 0x84, 0x87, 0x64, 0x23, 0xb5, 0x8a, 0x29, 0x07, 0x64, 0xf4,
-	0x04, 0xfc, 0x64, 0xfa, 0x09, 0x18, 0x01, 0x2c, 0xca, 0x18, 0xc0, 0x32, 0x36, 0x08, 0x2f, 0x5e,
-	0x29, 0x08, 0xaf, 0xff, 0x08, 0x41, 0xf8, 0xd9, 0x65, 0x83, 0xf0, 0xd2, 0x79, 0x41, 0x78, 0x03,
-	0xca, 0x1d, 0xea, 0x1b, 0x9e, 0xe9, 0xb2, 0xe8, 0x52, 0x5b, 0xe6, 0xfb, 0x9f, 0x20, 0x31, 0x2f,
-	0x64, 0xe8, 0xc6, 0xb1, 0x28, 0x06, 0x5c, 0xe7, 0x5e, 0x08, 0x29, 0x58, 0x0c, 0x18, 0x8e, 0xb2,
-	0xb5, 0xf3, 0xa3, 0xec, 0x6a, 0x22, 0xca, 0x0e, 0xdc, 0xec, 0xcd, 0x94, 0x9b, 0xbd, 0x0b, 0xd5,
-	0xbe, 0xfe, 0x7d, 0x2b, 0x51, 0x7e, 0xb8, 0x85, 0xd6, 0x53, 0xe9, 0xeb, 0xdf, 0xff, 0x3a, 0xae,
-	0x40, 0x24, 0xa0, 0xee, 0xda, 0xd5, 0xa0, 0x6e, 0x3a, 0xda, 0x6f, 0x5c, 0x38, 0xda, 0xdf, 0xbe,
-	0x52, 0xb4, 0x57, 0x2f, 0x12, 0xed, 0x9f, 0x40, 0xb9, 0x67, 0x06, 0xc7, 0x8e, 0x73, 0xd2, 0x0a,
-	0x3d, 0x8b, 0x83, 0xff, 0x9d, 0xea, 0xc7, 0x0f, 0xeb, 0xf0, 0x92, 0x93, 0xdf, 0x6a, 0x87, 0x1a,
-	0x08, 0x91, 0xb7, 0x9e, 0x35, 0x1c, 0xb2, 0xee, 0x4e, 0x0e, 0x59, 0xe8, 0x24, 0x74, 0xbb, 0xd3,
-	0x3e, 0x43, 0xd0, 0x83, 0x4e, 0x02, 0x9b, 0xc3, 0x30, 0xe3, 0x93, 0x59, 0x60, 0xc6, 0x83, 0xcb,
-	0xc1, 0x8c, 0x87, 0xb3, 0xc3, 0x0c, 0xb2, 0x0c, 0x05, 0xff, 0x59, 0x8b, 0xa9, 0xf1, 0x09, 0xbf,
-	0x20, 0xf7, 0x9f, 0xbd, 0x0e, 0x03, 0x16, 0x58, 0xfa, 0xe2, 0x4a, 0x55, 0x80, 0xd6, 0xb9, 0xd4,
-	0x3d, 0xab, 0x16, 0xb3, 0xaf, 0x16, 0xea, 0x78, 0x29, 0x29, 0x06, 0x3b, 0x2b, 0xca, 0xf5, 0x86,
-	0x24, 0xd7, 0x95, 0x1b, 0x0d, 0x49, 0xbe, 0xa1, 0xdc, 0x6c, 0x48, 0x32, 0x51, 0x16, 0xd5, 0x97,
-	0x30, 0x97, 0xf4, 0x65, 0x98, 0x15, 0xc4, 0x99, 0xb6, 0x69, 0x77, 0x1d, 0x71, 0x8f, 0xbc, 0x30,
-	0xe2, 0xf6, 0xb4, 0x8a, 0x9b, 0x68, 0xa9, 0xbf, 0xcb, 0x83, 0xb2, 0x8b, 0xae, 0x9f, 0x85, 0x28,
-	0xee, 0x66, 0xae, 0x54, 0x63, 0x5a, 0xbd, 0x40, 0x8d, 0xa9, 0x3e, 0x2d, 0x67, 0xbb, 0x31, 0x4b,
-	0xce, 0x76, 0x73, 0x5a, 0x8d, 0xe9, 0xd6, 0x94, 0x1a, 0xd3, 0xda, 0x0c, 0x29, 0xdd, 0xfa, 0xc4,
-	0x1a, 0xd3, 0xc6, 0x05, 0x6b, 0x4c, 0xb7, 0x67, 0xad, 0x31, 0xa9, 0x97, 0xc8, 0xd7, 0x13, 0xc5,
-	0x88, 0xbb, 0x97, 0x2b, 0x46, 0xdc, 0x9b, 0xbd, 0x18, 0x31, 0x64, 0xad, 0x19, 0x25, 0xdb, 0x90,
-	0x64, 0x50, 0xca, 0x0d, 0x49, 0x2e, 0x2a, 0x72, 0x43, 0x92, 0x4b, 0x0a, 0x34, 0x24, 0x59, 0x56,
-	0x4a, 0x0d, 0x49, 0xae, 0x28, 0x73, 0x0d, 0x49, 0x2e, 0x2b, 0x95, 0x86, 0x24, 0xcf, 0x29, 0xd5,
-	0x86, 0x24, 0x57, 0x95, 0xf9, 0x86, 0x24, 0x2f, 0x2b, 0x2b, 0x0d, 0x49, 0x9e, 0x57, 0x94, 0x86,
-	0x24, 0x2b, 0xca, 0x42, 0x43, 0x92, 0x17, 0x14, 0xc2, 0x2d, 0xbd, 0x21, 0xc9, 0x8b, 0xca, 0x52,
-	0x43, 0x92, 0x97, 0x94, 0xe5, 0xf8, 0x34, 0x5c, 0x57, 0x6a, 0x0d, 0x49, 0xae, 0x29, 0xab, 0xea,
-	0xdf, 0x65, 0x60, 0xe1, 0xc0, 0x66, 0x47, 0x3c, 0x48, 0xd8, 0xef, 0xa4, 0x5a, 0xd7, 0xc5, 0x8b,
-	0xa2, 0xeb, 0x50, 0x6e, 0x5b, 0x8e, 0x71, 0xd2, 0x1a, 0xa4, 0x11, 0xb2, 0x06, 0x48, 0xe2, 0x91,
-	0x9f, 0x80, 0xd4, 0x0d, 0x2d, 0x0b, 0x81, 0xbd, 0xac, 0xe1, 0xb3, 0xfa, 0x5f, 0x19, 0xa8, 0x1e,
-	0x9a, 0x7e, 0x70, 0xce, 0xa9, 0x9a, 0x82, 0x4c, 0x37, 0xa1, 0x82, 0x61, 0x74, 0x90, 0x15, 0xe4,
-	0x46, 0xec, 0x05, 0x05, 0xc4, 0x14, 0x2f, 0x55, 0xe9, 0x3d, 0x36, 0xfd, 0xc0, 0xf1, 0xf8, 0x77,
-	0x58, 0x39, 0x2d, 0x6a, 0xc6, 0xab, 0xc9, 0x0f, 0x56, 0x43, 0xea, 0x20, 0xbf, 0xfb, 0xee, 0x85,
-	0x69, 0x05, 0xd4, 0x43, 0x2c, 0x59, 0xd2, 0xe2, 0xb6, 0xfa, 0x0e, 0xe6, 0x5f, 0x58, 0xa1, 0x7f,
-	0x9c, 0x58, 0xe9, 0x3d, 0x28, 0xf2, 0x79, 0x44, 0x9f, 0xb3, 0xa4, 0x26, 0x12, 0xf1, 0xc8, 0x53,
-	0xa8, 0x04, 0x4e, 0x2b, 0x5a, 0x74, 0x74, 0xcb, 0x3c, 0xa4, 0x94, 0x72, 0xe0, 0x44, 0xcf, 0xbe,
-	0xba, 0x09, 0xca, 0x1e, 0xb5, 0x68, 0xca, 0x59, 0x4d, 0xd8, 0x6c, 0xf5, 0x31, 0x54, 0x9b, 0x81,
-	0xe3, 0xce, 0x28, 0xfd, 0x87, 0x2c, 0x2c, 0xbf, 0x75, 0x3b, 0xdc, 0x17, 0xf2, 0xa3, 0x36, 0x83,
-	0x41, 0xdd, 0x49, 0xe7, 0x97, 0xd3, 0xce, 0x6a, 0x2e, 0x75, 0x56, 0xff, 0x3f, 0x0a, 0xee, 0x43,
-	0xde, 0xae, 0x38, 0x83, 0xb7, 0x93, 0xa7, 0x17, 0xb0, 0x4a, 0xe7, 0x16, 0xb0, 0x60, 0xb2, 0x33,
-	0x54, 0x7f, 0x93, 0x85, 0xea, 0x4b, 0x1a, 0x1c, 0x3a, 0x3d, 0xff, 0x12, 0x01, 0x67, 0xd2, 0x56,
-	0x44, 0xca, 0xe8, 0xa2, 0x65, 0xf2, 0xfc, 0xb8, 0xc4, 0x95, 0xc1, 0x8d, 0xd5, 0x1f, 0xdc, 0x82,
-	0x17, 0xce, 0xbb, 0x05, 0xc7, 0xef, 0x79, 0x7c, 0x66, 0xe9, 0xfc, 0x04, 0x88, 0x16, 0xa3, 0x77,
-	0x1d, 0xcb, 0x72, 0xde, 0x8b, 0x4f, 0x5d, 0x44, 0x0b, 0x2f, 0x7a, 0x74, 0xd3, 0x12, 0x3a, 0xc3,
-	0x67, 0xf2, 0x00, 0x94, 0xd0, 0xa7, 0x2d, 0xcb, 0x39, 0x31, 0x5b, 0x6d, 0xdd, 0x38, 0xa1, 0x76,
-	0x47, 0x7c, 0x08, 0x53, 0x0d, 0x7d, 0x7a, 0xe8, 0x9c, 0x98, 0x3b, 0x9c, 0xca, 0x1d, 0xa7, 0xfa,
-	0xbb, 0x2c, 0xc0, 0xa1, 0xd3, 0xfb, 0x86, 0xfa, 0xbe, 0xde, 0xc3, 0x54, 0x22, 0x0e, 0xe6, 0x89,
-	0x3a, 0x44, 0x1c, 0xb9, 0x5f, 0xe9, 0x7d, 0x9a, 0xb8, 0xf1, 0xcb, 0x9d, 0x73, 0xe3, 0x97, 0xba,
-	0x3e, 0x2c, 0x4e, 0xbc, 0x3e, 0xbc, 0x0f, 0x32, 0x87, 0x62, 0x26, 0x9f, 0x68, 0x69, 0xa7, 0xfc,
-	0xf1, 0xc3, 0x7a, 0x91, 0x7f, 0x3d, 0xb0, 0xa7, 0x15, 0x91, 0x79, 0xd0, 0x49, 0x28, 0x07, 0x52,
-	0xca, 0x89, 0x2e, 0x17, 0xa5, 0x09, 0x97, 0x8b, 0xd1, 0x17, 0x86, 0x32, 0x77, 0x2c, 0xf8, 0x85,
-	0xe1, 0x23, 0xc8, 0xc6, 0xf7, 0x86, 0x93, 0xe2, 0x4d, 0x36, 0xf0, 0xd9, 0x59, 0xe9, 0x73, 0x05,
-	0x09, 0x1f, 0x14, 0x35, 0xd5, 0x37, 0xb0, 0xa8, 0xf1, 0x63, 0xc3, 0x77, 0x72, 0x86, 0x53, 0x3b,
-	0x6c, 0x2a, 0xd9, 0x11, 0x53, 0x51, 0xff, 0x04, 0x16, 0x45, 0x68, 0x49, 0x8d, 0x3a, 0xf5, 0x3b,
-	0x0a, 0xe6, 0xa5, 0x98, 0xeb, 0x9f, 0x75, 0x2e, 0xea, 0x0e, 0x94, 0xe2, 0xa4, 0x20, 0x71, 0x47,
-	0x98, 0x49, 0xde, 0x11, 0xb2, 0xd3, 0xc7, 0xd2, 0x16, 0x71, 0x9b, 0xcc, 0xef, 0x0f, 0x4b, 0x8c,
-	0xc2, 0xef, 0x8e, 0xff, 0x2d, 0x03, 0xd5, 0x34, 0x1e, 0x26, 0x0d, 0x98, 0xb3, 0x9d, 0x0e, 0x6d,
-	0xf9, 0xd4, 0xa2, 0x46, 0xe0, 0x78, 0xc2, 0x17, 0xdf, 0x1b, 0x83, 0x9d, 0x37, 0x5f, 0x39, 0x1d,
-	0xda, 0x14, 0x72, 0x3c, 0x1d, 0xae, 0xd8, 0x09, 0x12, 0xd9, 0x84, 0x45, 0xd7, 0x33, 0x1d, 0xcf,
-	0x0c, 0xce, 0x5a, 0x86, 0xa5, 0xfb, 0x3e, 0xb7, 0x4b, 0x7e, 0x6f, 0xba, 0x10, 0xb1, 0x76, 0x19,
-	0x87, 0x19, 0x67, 0xfd, 0x2b, 0x58, 0x18, 0x19, 0xf2, 0x42, 0x5f, 0x09, 0xfe, 0x16, 0x60, 0x99,
-	0xe3, 0xd2, 0xd8, 0x07, 0x5c, 0x3c, 0x8c, 0x0e, 0x0a, 0x33, 0x77, 0x66, 0x28, 0xcc, 0x5c, 0xac,
-	0xe8, 0x33, 0xae, 0x8c, 0x53, 0xbc, 0x5c, 0x19, 0xa7, 0x74, 0x7e, 0x19, 0x67, 0x05, 0x0a, 0x21,
-	0x46, 0xa4, 0xc8, 0x19, 0xf1, 0xd6, 0x68, 0xb1, 0x01, 0xc6, 0x14, 0x1b, 0x06, 0x89, 0xcc, 0xdd,
-	0x64, 0x22, 0x33, 0xb6, 0x06, 0x51, 0xb9, 0x52, 0x0d, 0x62, 0xe5, 0x47, 0xa8, 0x41, 0x3c, 0xb9,
-	0x6c, 0x0d, 0x62, 0x6e, 0xc6, 0x1a, 0x44, 0x75, 0x5a, 0x0d, 0x42, 0x99, 0x56, 0x83, 0x58, 0x18,
-	0xad, 0x41, 0xdc, 0x84, 0x92, 0x47, 0x45, 0x8c, 0xc6, 0x0b, 0x2d, 0x59, 0x1b, 0x10, 0xc6, 0x54,
-	0x1d, 0x96, 0x26, 0x57, 0x1d, 0x96, 0x67, 0xaa, 0x3a, 0xdc, 0x9e, 0xad, 0xea, 0x70, 0xfd, 0xc2,
-	0x55, 0x87, 0xda, 0x95, 0xaa, 0x0e, 0xab, 0x17, 0xa9, 0x3a, 0x44, 0xc5, 0x9b, 0x7a, 0xa2, 0x78,
-	0x93, 0x28, 0x15, 0xdc, 0x98, 0x58, 0x2a, 0xb8, 0x39, 0x4b, 0xa9, 0xe0, 0xd6, 0xe5, 0x4a, 0x05,
-	0x6b, 0x13, 0x4a, 0x05, 0x1b, 0x43, 0xa5, 0x82, 0xa1, 0x4a, 0x88, 0x3a, 0xb9, 0x12, 0x92, 0xac,
-	0x20, 0x6c, 0x4e, 0xac, 0x20, 0x0c, 0x65, 0x55, 0x3c, 0x63, 0xe2, 0xf9, 0xd1, 0xa2, 0xb2, 0xa4,
-	0xee, 0xc2, 0x8a, 0x88, 0x4c, 0x97, 0x77, 0x8e, 0xea, 0x3f, 0x64, 0x60, 0x91, 0x85, 0xa9, 0x2b,
-	0xf8, 0xd7, 0x44, 0x12, 0x91, 0x4d, 0x27, 0x11, 0x0f, 0x41, 0xd1, 0x19, 0x3a, 0x6a, 0x99, 0xb6,
-	0xe1, 0xf4, 0x5d, 0x06, 0xd9, 0xc5, 0x37, 0x96, 0xf3, 0x48, 0x3f, 0x88, 0xc9, 0xa9, 0xdc, 0x42,
-	0x1a, 0xca, 0x2d, 0xfe, 0x36, 0x03, 0xcb, 0x1c, 0xf0, 0x5f, 0x61, 0x96, 0x0a, 0xe4, 0xf4, 0x38,
-	0x3b, 0x63, 0x8f, 0x2c, 0xec, 0x74, 0x1d, 0xcf, 0x88, 0x9c, 0x2a, 0x6f, 0xb0, 0x9d, 0x3e, 0xa1,
-	0xd4, 0xe5, 0x77, 0xd3, 0xfc, 0xab, 0x60, 0x99, 0x11, 0x34, 0xea, 0x3a, 0x0d, 0x49, 0xce, 0x2a,
-	0x39, 0xf1, 0x95, 0xcf, 0x36, 0x2c, 0x35, 0x19, 0xd8, 0xb8, 0x82, 0xf2, 0xbf, 0x86, 0x45, 0x96,
-	0x98, 0x5c, 0x61, 0x84, 0xbf, 0xcf, 0x00, 0xd1, 0x42, 0xfb, 0x0a, 0x7a, 0xf9, 0x39, 0x80, 0xeb,
-	0x39, 0xa7, 0xd4, 0xd6, 0x6d, 0xfc, 0xc6, 0x9d, 0x81, 0x83, 0xe5, 0x84, 0xed, 0x1e, 0xc5, 0x4c,
-	0x2d, 0x21, 0x98, 0xc0, 0x9d, 0xd2, 0x78, 0xdc, 0x29, 0xb4, 0xf4, 0x39, 0x54, 0xb5, 0xd0, 0xde,
-	0xf5, 0x1c, 0xfb, 0x12, 0xab, 0x7b, 0x08, 0x8b, 0x3c, 0xfa, 0xf3, 0x7f, 0xc5, 0x44, 0x23, 0xb0,
-	0xdc, 0xd4, 0xb4, 0x78, 0xef, 0x8a, 0x86, 0xcf, 0xea, 0x73, 0x58, 0xe4, 0x26, 0x92, 0x16, 0xbd,
-	0x03, 0x05, 0xfe, 0x4f, 0x9b, 0xc1, 0x27, 0xc1, 0xf1, 0xff, 0x73, 0x34, 0xc1, 0x52, 0x3f, 0x87,
-	0x25, 0x71, 0x90, 0x2e, 0xd1, 0xf9, 0x26, 0x14, 0x38, 0x65, 0xec, 0x75, 0xe1, 0x6f, 0x32, 0x00,
-	0x9c, 0x8d, 0xd7, 0x55, 0xb3, 0x8c, 0x18, 0x7f, 0x33, 0x96, 0x4d, 0x7c, 0x33, 0x76, 0x00, 0x04,
-	0xaf, 0x66, 0x4c, 0xc7, 0x6e, 0xc5, 0xff, 0xdb, 0x12, 0xf9, 0xfd, 0x24, 0xc4, 0xbc, 0x10, 0xf5,
-	0x8a, 0x49, 0xea, 0x57, 0xd1, 0x5f, 0xb3, 0x78, 0xc9, 0xf0, 0x29, 0x94, 0xf9, 0x7b, 0x93, 0x05,
-	0xc3, 0xf9, 0xc4, 0xbc, 0xb0, 0x5c, 0x08, 0x7e, 0xfc, 0xac, 0x3e, 0x87, 0xe5, 0x97, 0xba, 0xd7,
-	0xd6, 0x7b, 0x74, 0xd7, 0xb1, 0x18, 0xb2, 0x8b, 0xf4, 0x75, 0x1b, 0x2a, 0xfc, 0xdb, 0x39, 0x01,
-	0x4f, 0x39, 0x74, 0x2d, 0x73, 0x1a, 0x07, 0xa8, 0x35, 0x58, 0x19, 0xee, 0xeb, 0xbb, 0x8e, 0xed,
-	0x53, 0x75, 0x19, 0x16, 0xb7, 0x8d, 0xc0, 0x3c, 0xd5, 0x03, 0xba, 0x1d, 0x06, 0xc7, 0x62, 0x4c,
-	0x75, 0x05, 0x96, 0xd2, 0x64, 0x2e, 0xfe, 0xc8, 0xc3, 0x6f, 0xc1, 0x79, 0xe5, 0x45, 0x81, 0x4a,
-	0xe3, 0xf5, 0x4e, 0xab, 0xf9, 0x66, 0x5b, 0x7b, 0x73, 0xf0, 0xea, 0xa5, 0x72, 0x8d, 0xcc, 0x43,
-	0x99, 0x51, 0xb4, 0xb7, 0xaf, 0x5e, 0x31, 0x42, 0x26, 0x22, 0xbc, 0xd8, 0x3e, 0x38, 0x7c, 0xab,
-	0xed, 0x2b, 0xd9, 0x88, 0xd0, 0x7c, 0xbb, 0xbb, 0xbb, 0xdf, 0x6c, 0x2a, 0x39, 0x52, 0x05, 0x60,
-	0x84, 0x5f, 0x1d, 0x1c, 0x1e, 0xee, 0xef, 0x29, 0x12, 0x59, 0x80, 0x39, 0xd6, 0xde, 0x7f, 0xa9,
-	0xed, 0x37, 0x9b, 0x6c, 0x90, 0xc2, 0xa3, 0xd7, 0x00, 0x83, 0xef, 0xa0, 0x09, 0x40, 0x81, 0x0d,
-	0xb7, 0xbf, 0xa7, 0x5c, 0x23, 0x65, 0x28, 0x46, 0x23, 0x65, 0xb0, 0xf1, 0xab, 0x83, 0xa3, 0xa3,
-	0xfd, 0x3d, 0x25, 0x4b, 0x2a, 0x20, 0xc7, 0xf3, 0xca, 0x91, 0x39, 0x28, 0x69, 0xfb, 0xbb, 0xaf,
-	0xbf, 0xdd, 0xd7, 0xd8, 0x3b, 0x1e, 0x7d, 0x05, 0xe5, 0xc4, 0x15, 0x34, 0x9b, 0xd3, 0xd1, 0xeb,
-	0xbd, 0x78, 0xd6, 0xd7, 0x22, 0xc2, 0x60, 0xe8, 0x2a, 0x00, 0x23, 0x88, 0xf7, 0x66, 0x1f, 0xfd,
-	0x63, 0x66, 0x50, 0x01, 0xe6, 0x63, 0x2c, 0xc3, 0xc2, 0xd1, 0xc1, 0xd1, 0xfe, 0xe1, 0xc1, 0xab,
-	0xfd, 0xa4, 0x42, 0x96, 0x40, 0x89, 0xc9, 0x03, 0xad, 0x5c, 0x87, 0xc5, 0x01, 0x75, 0x3f, 0x16,
-	0xcf, 0xa6, 0xc4, 0x23, 0x9d, 0xe5, 0xc8, 0x22, 0xcc, 0xc7, 0xd4, 0xa3, 0xed, 0xb7, 0x4d, 0xd4,
-	0x53, 0x52, 0xb4, 0xf9, 0x66, 0xfb, 0xd5, 0xde, 0xce, 0x5f, 0x28, 0xf9, 0xd4, 0x34, 0x76, 0xb5,
-	0xed, 0xe6, 0x9f, 0xa1, 0x06, 0xb7, 0xfe, 0xbb, 0x0c, 0xb9, 0xed, 0xa3, 0x03, 0xb2, 0x09, 0xa5,
-	0xb8, 0xdc, 0x4c, 0x96, 0xc5, 0xbf, 0x01, 0xd2, 0xe5, 0xe7, 0x7a, 0x9c, 0x1e, 0xa9, 0xd7, 0xc8,
-	0xcf, 0x00, 0x06, 0xf5, 0x3d, 0xb2, 0x22, 0x40, 0xde, 0x50, 0xc1, 0xaf, 0x5e, 0x89, 0x7a, 0xa0,
-	0x99, 0x5e, 0x23, 0x4f, 0xa1, 0x28, 0x8a, 0x6f, 0x84, 0xc7, 0xff, 0x74, 0x29, 0x6e, 0x58, 0xfe,
-	0x69, 0x86, 0x6c, 0x81, 0x1c, 0x55, 0xb1, 0x08, 0x07, 0xf0, 0x43, 0x45, 0xad, 0x31, 0x7d, 0xbe,
-	0x80, 0x52, 0x5c, 0x8d, 0x12, 0x6b, 0x19, 0xae, 0x4e, 0xd5, 0x57, 0x46, 0x8e, 0xe8, 0x7e, 0xdf,
-	0x0d, 0xce, 0xd4, 0x6b, 0xe4, 0x17, 0x50, 0x14, 0xb5, 0x29, 0x31, 0xc7, 0x74, 0xa5, 0x6a, 0x42,
-	0xcf, 0xe7, 0x50, 0x49, 0x26, 0xa6, 0xa4, 0x96, 0xd4, 0x4a, 0x32, 0xeb, 0xac, 0x57, 0x07, 0xc9,
-	0xa9, 0xd0, 0xcc, 0x67, 0x50, 0x8a, 0x73, 0x53, 0x31, 0xe7, 0xe1, 0x5c, 0x75, 0xb4, 0xd7, 0xd3,
-	0x0c, 0xd9, 0xc1, 0xaf, 0x69, 0xe3, 0x14, 0x5b, 0xbc, 0x73, 0x4c, 0xd6, 0x3d, 0x61, 0xde, 0x2f,
-	0xa0, 0x9a, 0x4e, 0xe9, 0x48, 0x3d, 0x61, 0x00, 0x43, 0x91, 0x6c, 0xc2, 0x38, 0xbb, 0x30, 0x3f,
-	0x04, 0x7f, 0xc8, 0x8d, 0xa4, 0x0a, 0x86, 0x47, 0x1a, 0xbd, 0x04, 0x51, 0xaf, 0x91, 0x2f, 0xa1,
-	0x92, 0x44, 0x3f, 0x62, 0x41, 0x63, 0x00, 0x51, 0x9d, 0x8c, 0x74, 0xf7, 0xf9, 0x62, 0xd2, 0xc8,
-	0x44, 0x2c, 0x66, 0x2c, 0x5c, 0x99, 0xb0, 0x98, 0x3d, 0x98, 0x4b, 0x81, 0x09, 0xb2, 0x2a, 0x8c,
-	0x61, 0x14, 0x60, 0x4c, 0x18, 0x65, 0x07, 0x2a, 0x49, 0x3c, 0x21, 0x56, 0x33, 0x06, 0x62, 0x4c,
-	0x18, 0xe3, 0x6b, 0x28, 0x27, 0x00, 0x05, 0xe1, 0xff, 0x7f, 0x1d, 0x85, 0x18, 0x93, 0x4d, 0x5a,
-	0x84, 0x7c, 0x61, 0xd2, 0x69, 0x00, 0x30, 0x79, 0xfe, 0xc9, 0x78, 0x2f, 0xe6, 0x3f, 0x06, 0x02,
-	0x4c, 0x1e, 0x23, 0x09, 0x04, 0xc4, 0x18, 0x63, 0xb0, 0xc1, 0xc4, 0x15, 0x00, 0x33, 0x01, 0x31,
-	0xc2, 0x39, 0x72, 0x75, 0x65, 0x28, 0x48, 0x32, 0x7b, 0xf8, 0x53, 0x98, 0x4b, 0x41, 0x09, 0xb1,
-	0x8f, 0xe3, 0xe0, 0x45, 0x7d, 0x38, 0xc8, 0x62, 0x77, 0xe1, 0x4b, 0xb6, 0x2d, 0xeb, 0xdc, 0xf7,
-	0x9e, 0x3f, 0xef, 0x7d, 0xa8, 0x24, 0x83, 0xa5, 0x58, 0xfb, 0x98, 0xb0, 0x5a, 0x5f, 0x1d, 0xc3,
-	0x11, 0x81, 0x18, 0x8d, 0x3a, 0x5d, 0x00, 0x17, 0x46, 0x3d, 0xb6, 0x2a, 0x7e, 0xfe, 0x74, 0x76,
-	0x3e, 0xff, 0xfd, 0xc7, 0xb5, 0xcc, 0xbf, 0x7f, 0x5c, 0xcb, 0xfc, 0xe7, 0xc7, 0xb5, 0xcc, 0x5f,
-	0xfe, 0xb4, 0x67, 0x06, 0xc7, 0x61, 0x7b, 0xd3, 0x70, 0xfa, 0x4f, 0x5c, 0xdd, 0x38, 0x3e, 0xeb,
-	0x50, 0x2f, 0xf9, 0xe4, 0x7b, 0xc6, 0x93, 0xc1, 0x5f, 0xdb, 0xdb, 0x05, 0x1c, 0xee, 0xd9, 0xff,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xc9, 0xab, 0xd0, 0xc7, 0xef, 0x3e, 0x00, 0x00,
+	// 5024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0xcd, 0x73, 0xdb, 0x48,
+	0x76, 0x37, 0x49, 0x90, 0x04, 0x1f, 0x29, 0x0a, 0x6a, 0x7d, 0x18, 0xa6, 0x6d, 0x49, 0x86, 0x3f,
+	0xc6, 0xf6, 0x78, 0x64, 0x8f, 0xbc, 0x33, 0xd9, 0xf5, 0x4c, 0x66, 0x46, 0x5f, 0x76, 0xc4, 0xd5,
+	0xd8, 0x5a, 0xd0, 0x9e, 0x54, 0x72, 0x61, 0x81, 0x64, 0x93, 0x82, 0x05, 0x02, 0x18, 0x7c, 0xc8,
+	0xa3, 0xb9, 0x24, 0x7f, 0x40, 0x0e, 0x5b, 0x49, 0x55, 0x0e, 0x7b, 0x48, 0x25, 0x87, 0x1c, 0x53,
+	0xc9, 0x29, 0xa7, 0xfd, 0x03, 0x52, 0x95, 0x4a, 0x55, 0x2e, 0xb9, 0xba, 0x52, 0xae, 0xbd, 0xe6,
+	0x94, 0x5b, 0xf6, 0x92, 0xea, 0xd7, 0x0d, 0x10, 0x20, 0x29, 0x92, 0x92, 0xa6, 0xf6, 0xc0, 0x2a,
+	0xf4, 0x7b, 0xaf, 0x1b, 0xdd, 0xaf, 0x5f, 0xbf, 0x8f, 0x5f, 0x83, 0xb0, 0xd4, 0xb6, 0x4c, 0x6a,
+	0x07, 0x8f, 0x5d, 0xd7, 0x67, 0xbf, 0x0d, 0xd7, 0x73, 0x02, 0x87, 0xe4, 0x5c, 0xd7, 0xaf, 0x5d,
+	0xef, 0x39, 0x4e, 0xcf, 0xa2, 0x8f, 0x91, 0xd4, 0x0a, 0xbb, 0x8f, 0x69, 0xdf, 0x0d, 0x4e, 0xb9,
+	0x44, 0x6d, 0x6d, 0x98, 0x19, 0x98, 0x7d, 0xea, 0x07, 0x46, 0xdf, 0x15, 0x02, 0xab, 0xc3, 0x02,
+	0x9d, 0xd0, 0x33, 0x02, 0xd3, 0xb1, 0x05, 0x7f, 0xa9, 0xe7, 0xf4, 0x1c, 0x7c, 0x7c, 0xcc, 0x9e,
+	0x22, 0x6a, 0x34, 0x9d, 0xae, 0xcf, 0x7e, 0x9c, 0xaa, 0x1d, 0x43, 0xb9, 0x41, 0xdb, 0x1e, 0x0d,
+	0xbe, 0x75, 0x42, 0x3b, 0x20, 0x04, 0x24, 0xdb, 0xe8, 0x53, 0x35, 0xb3, 0x9e, 0xb9, 0x5f, 0xd2,
+	0xf1, 0x99, 0x28, 0x90, 0x3b, 0xa6, 0xa7, 0xaa, 0x84, 0x24, 0xf6, 0x48, 0x6e, 0x02, 0xf4, 0x99,
+	0x78, 0xd3, 0x35, 0x82, 0x23, 0x35, 0x8b, 0x8c, 0x12, 0x52, 0x0e, 0x8d, 0xe0, 0x88, 0x5c, 0x85,
+	0x22, 0xb5, 0x4f, 0x9a, 0x27, 0x86, 0xa7, 0xe6, 0x90, 0x57, 0xa0, 0xf6, 0xc9, 0x77, 0x86, 0xa7,
+	0xfd, 0x3e, 0x07, 0xa5, 0xd7, 0x9e, 0x61, 0xfb, 0x5d, 0xc7, 0xeb, 0x93, 0x25, 0xc8, 0x9b, 0x7d,
+	0xa3, 0x17, 0xbd, 0x8c, 0x37, 0xd8, 0xdb, 0xda, 0xfd, 0x8e, 0x9a, 0x5d, 0xcf, 0xb1, 0xb7, 0xb5,
+	0xfb, 0x1d, 0x1c, 0xce, 0xf3, 0x9a, 0x8c, 0x3a, 0x87, 0xd4, 0x02, 0xf5, 0xbc, 0x9d, 0x7e, 0x87,
+	0x3c, 0x80, 0x1c, 0xb5, 0x4f, 0xd4, 0xdc, 0x7a, 0xee, 0x7e, 0x79, 0xf3, 0xea, 0x06, 0xd3, 0x71,
+	0x3c, 0xfa, 0xc6, 0x9e, 0x7d, 0xb2, 0x67, 0x07, 0xde, 0xa9, 0xce, 0x64, 0xc8, 0x43, 0x28, 0xfa,
+	0xb8, 0x4c, 0x5f, 0x95, 0x50, 0x5c, 0x41, 0xf1, 0xc4, 0xd2, 0xf5, 0x48, 0x80, 0x3c, 0x02, 0x82,
+	0x53, 0x69, 0xba, 0xa1, 0x65, 0x35, 0xa3, 0x6e, 0x25, 0x7c, 0xb5, 0x82, 0x9c, 0xc3, 0xd0, 0xb2,
+	0x1a, 0x42, 0x7a, 0x09, 0xf2, 0x7e, 0xd0, 0x31, 0x6d, 0x35, 0x8f, 0x02, 0xbc, 0x41, 0xae, 0x43,
+	0x89, 0xcd, 0x99, 0x73, 0xaa, 0xc8, 0x91, 0xa9, 0xe7, 0x35, 0x90, 0xf9, 0x08, 0x88, 0xd1, 0x6e,
+	0x53, 0x37, 0x68, 0x7a, 0x34, 0x08, 0x3d, 0xbb, 0xd9, 0x76, 0x3a, 0x54, 0x2d, 0xac, 0xe7, 0xee,
+	0xe7, 0x74, 0x85, 0x73, 0x74, 0x64, 0xec, 0x38, 0x1d, 0xca, 0x5e, 0xd0, 0xa1, 0xad, 0xb0, 0xa7,
+	0x16, 0xd7, 0x33, 0xf7, 0x65, 0x9d, 0x37, 0xd8, 0x46, 0x85, 0x3e, 0xf5, 0x54, 0xe0, 0x1b, 0xc5,
+	0x9e, 0xc9, 0x1a, 0x94, 0xdf, 0x39, 0xde, 0xb1, 0x69, 0xf7, 0x9a, 0x1d, 0xd3, 0x53, 0xcb, 0xc8,
+	0x02, 0x41, 0xda, 0x35, 0x3d, 0xb2, 0x0a, 0xd0, 0x71, 0xda, 0xc7, 0xd4, 0xeb, 0x9a, 0x16, 0x55,
+	0x2b, 0x9c, 0x3f, 0xa0, 0x90, 0x3b, 0x90, 0x6f, 0x85, 0xa6, 0xd5, 0x51, 0xe7, 0xd7, 0x33, 0xf7,
+	0xcb, 0x9b, 0x55, 0xd4, 0xd1, 0x36, 0xa3, 0x34, 0x5c, 0xda, 0xd6, 0x39, 0xb3, 0xf6, 0x39, 0xc8,
+	0x91, 0x72, 0x23, 0xdb, 0xc8, 0x0c, 0x6c, 0x63, 0x09, 0xf2, 0x27, 0x86, 0x15, 0x52, 0x61, 0x16,
+	0xbc, 0xf1, 0x2c, 0xfb, 0xf3, 0x8c, 0xf6, 0x2b, 0x28, 0xc5, 0x63, 0xb1, 0xf9, 0xa3, 0xf1, 0x08,
+	0x43, 0x63, 0xcf, 0xa4, 0x06, 0xb2, 0x65, 0xd8, 0xbd, 0xd0, 0xe8, 0x45, 0xbd, 0xe3, 0xf6, 0xc0,
+	0x58, 0x72, 0x09, 0x63, 0xd1, 0x1e, 0x40, 0xfe, 0xf5, 0xf3, 0xba, 0xd3, 0x22, 0xeb, 0x50, 0x08,
+	0xba, 0xcd, 0xb7, 0x4e, 0x8b, 0x0f, 0xb8, 0x5d, 0xfa, 0xf0, 0x7e, 0x8d, 0xb3, 0xf4, 0x7c, 0xd0,
+	0xad, 0x3b, 0x2d, 0xad, 0x06, 0x85, 0xbd, 0x9e, 0x47, 0x7d, 0x9f, 0xcd, 0xf9, 0x8d, 0x7e, 0x10,
+	0xcd, 0xf9, 0x8d, 0x7e, 0xa0, 0xdd, 0x84, 0x1c, 0x1b, 0x64, 0x05, 0xb2, 0x66, 0x47, 0x0c, 0x50,
+	0xf8, 0xf0, 0x7e, 0x2d, 0xbb, 0xbf, 0xab, 0x67, 0xcd, 0x8e, 0xf6, 0x7f, 0x19, 0x90, 0xbf, 0xa5,
+	0x81, 0xd1, 0x31, 0x02, 0x83, 0x7c, 0x03, 0x65, 0xc3, 0xb6, 0x9d, 0x00, 0x0f, 0x9c, 0xaf, 0x66,
+	0xd0, 0x9a, 0x56, 0x51, 0x53, 0x91, 0xcc, 0xc6, 0xd6, 0x40, 0x80, 0xdb, 0x60, 0xb2, 0x0b, 0xf9,
+	0x14, 0x0a, 0x96, 0xd1, 0xa2, 0x96, 0x8f, 0x46, 0x5e, 0xde, 0xbc, 0x96, 0xee, 0x7c, 0x80, 0x3c,
+	0xde, 0x4f, 0x08, 0xd6, 0xbe, 0x02, 0x65, 0x78, 0xcc, 0xf3, 0xa8, 0xbe, 0xf6, 0x0b, 0x28, 0x27,
+	0x86, 0x3d, 0xd7, 0xae, 0xfd, 0x05, 0x14, 0x1b, 0xd4, 0x3b, 0x31, 0xdb, 0x94, 0xdc, 0x86, 0x39,
+	0xd3, 0x0e, 0xa8, 0x67, 0x1b, 0x56, 0xd3, 0x75, 0xbc, 0x00, 0x07, 0xc8, 0xeb, 0x95, 0x88, 0x78,
+	0xe8, 0x78, 0x01, 0x13, 0xa2, 0x3f, 0x24, 0x85, 0xb2, 0x5c, 0x88, 0xfe, 0x90, 0x10, 0x62, 0x9a,
+	0x76, 0xd5, 0x5c, 0x42, 0xd3, 0x87, 0x7a, 0xd6, 0x74, 0x99, 0x55, 0x04, 0xa7, 0x2e, 0x15, 0xbe,
+	0x06, 0x9f, 0x35, 0x0a, 0xf9, 0x86, 0xeb, 0x84, 0x01, 0xb9, 0x01, 0x25, 0xe7, 0x84, 0x7a, 0xef,
+	0x3c, 0x33, 0xe0, 0x3e, 0x43, 0xd6, 0x07, 0x04, 0x72, 0x8f, 0x9d, 0x70, 0x9c, 0x27, 0xbe, 0xb1,
+	0xbc, 0x59, 0x11, 0x27, 0x1c, 0x69, 0x7a, 0xc4, 0x24, 0x2b, 0x50, 0xe8, 0x1b, 0xde, 0x31, 0x8d,
+	0x7d, 0x13, 0x6f, 0x69, 0xff, 0x9a, 0x05, 0xf9, 0xf0, 0x79, 0x63, 0xdf, 0x76, 0xc3, 0xf1, 0x6e,
+	0x90, 0x80, 0xe4, 0x51, 0xd7, 0x11, 0x1a, 0xc2, 0x67, 0x36, 0x58, 0xcb, 0x33, 0xec, 0xf6, 0x51,
+	0x34, 0x18, 0x6f, 0x31, 0x7a, 0xdb, 0xe9, 0xf7, 0xcd, 0x40, 0xac, 0x44, 0xb4, 0xd8, 0x18, 0x3d,
+	0xcb, 0x69, 0xa9, 0x79, 0x3e, 0x06, 0x7b, 0x66, 0xee, 0xed, 0xad, 0x63, 0xda, 0x4d, 0xc7, 0x56,
+	0x65, 0x2e, 0xcc, 0x9a, 0xaf, 0x6c, 0xe6, 0x65, 0x9d, 0x30, 0xa0, 0x5e, 0x93, 0xb5, 0xd5, 0x8a,
+	0x58, 0x30, 0xa3, 0xd4, 0x1d, 0xd3, 0x26, 0xd7, 0x40, 0xee, 0x79, 0x4e, 0xe8, 0x36, 0x5b, 0xa7,
+	0xe2, 0xa8, 0x17, 0xb1, 0xbd, 0x7d, 0xca, 0x5e, 0x63, 0x19, 0x3f, 0x9e, 0xaa, 0x05, 0xec, 0x83,
+	0xcf, 0xcc, 0x39, 0x60, 0x90, 0x69, 0xb2, 0x93, 0xee, 0x0b, 0x67, 0x02, 0x48, 0x7a, 0xce, 0x28,
+	0xa4, 0x0a, 0x59, 0xff, 0xa9, 0x5a, 0x42, 0x7a, 0xd6, 0x7f, 0xca, 0x14, 0x1a, 0x78, 0x66, 0xaf,
+	0x27, 0x9c, 0x0c, 0x2a, 0xb4, 0xcb, 0x3c, 0x2c, 0xd2, 0xf4, 0x88, 0xa9, 0xfd, 0x73, 0x06, 0x4a,
+	0x3b, 0x9e, 0x63, 0x9f, 0x5b, 0x73, 0x42, 0x43, 0xb9, 0x61, 0x0d, 0xf9, 0x2e, 0x6d, 0x47, 0x16,
+	0xc0, 0x9e, 0xd3, 0x1b, 0x5f, 0x18, 0xde, 0xf8, 0x27, 0xcc, 0x01, 0x1b, 0x5e, 0x80, 0x4a, 0x2d,
+	0x6f, 0xd6, 0x36, 0x78, 0x74, 0xdc, 0x88, 0xa2, 0xe3, 0xc6, 0xeb, 0x28, 0x7c, 0xea, 0x5c, 0x50,
+	0x33, 0x41, 0x7e, 0x61, 0x06, 0x67, 0xcf, 0xf7, 0x1a, 0xe4, 0x42, 0xcf, 0xe2, 0xd3, 0xdd, 0x2e,
+	0x7e, 0x78, 0xbf, 0xc6, 0x9c, 0x84, 0xce, 0x68, 0xe7, 0xdd, 0x70, 0xed, 0x7f, 0x33, 0x90, 0xe7,
+	0x2f, 0x5a, 0x83, 0x9c, 0xdb, 0xf5, 0x71, 0xfa, 0xe5, 0xcd, 0x39, 0xb4, 0xcd, 0xc8, 0xdc, 0x74,
+	0xc6, 0x21, 0xab, 0x20, 0xe1, 0x46, 0x17, 0xd1, 0x29, 0x00, 0x4a, 0x70, 0x36, 0xd2, 0xc9, 0x3a,
+	0xe4, 0x71, 0x7f, 0x55, 0x79, 0x44, 0x80, 0x33, 0x98, 0x44, 0xdb, 0x73, 0xfc, 0xc8, 0xaf, 0xa4,
+	0x24, 0x90, 0xc1, 0x24, 0x42, 0xdb, 0x74, 0x6c, 0x35, 0x37, 0x2a, 0x81, 0x0c, 0xa2, 0x81, 0xd4,
+	0xf6, 0x1c, 0x5b, 0x95, 0x12, 0x11, 0x20, 0xde, 0x5d, 0x1d, 0x79, 0x6c, 0x29, 0x3d, 0x33, 0xd2,
+	0x37, 0x5f, 0x4a, 0xa4, 0x4f, 0x9d, 0x71, 0xb4, 0x63, 0x90, 0xeb, 0x4e, 0x2b, 0xad, 0x60, 0x29,
+	0xa1, 0xe0, 0xdb, 0xb1, 0xb6, 0x32, 0x38, 0x46, 0x19, 0x2d, 0x6b, 0x07, 0x49, 0x23, 0x67, 0x25,
+	0x9b, 0x38, 0x2b, 0x91, 0x61, 0xe7, 0x06, 0x86, 0xad, 0xbd, 0x81, 0xf9, 0x43, 0xc3, 0x33, 0x2c,
+	0x8b, 0x5a, 0xa6, 0xdf, 0xc7, 0xe0, 0x52, 0x03, 0xb9, 0xed, 0xd8, 0x7e, 0x60, 0xd8, 0xdc, 0xfd,
+	0x48, 0x7a, 0xdc, 0x26, 0xeb, 0x50, 0x6e, 0x3b, 0xb4, 0xdb, 0x35, 0xdb, 0x2c, 0x1b, 0xc2, 0x91,
+	0x32, 0x7a, 0x92, 0x54, 0x97, 0xe4, 0x8c, 0x92, 0xd5, 0x9e, 0x42, 0x09, 0x17, 0xc0, 0x0e, 0x47,
+	0x1c, 0xad, 0xa4, 0x44, 0xb4, 0x22, 0x20, 0x1d, 0x19, 0xfe, 0x11, 0xaa, 0xa1, 0xa2, 0xe3, 0xb3,
+	0xf6, 0x05, 0xe4, 0x77, 0x8d, 0x20, 0xec, 0x9f, 0x15, 0x4a, 0x48, 0x0d, 0x72, 0x6f, 0xc5, 0x9a,
+	0xca, 0x9b, 0x32, 0xaa, 0x8e, 0xc5, 0x28, 0x46, 0xd4, 0xfe, 0x27, 0x03, 0x25, 0xec, 0xbd, 0x6f,
+	0x77, 0x1d, 0xb6, 0x55, 0x1d, 0xd6, 0x10, 0x2a, 0xe2, 0x5b, 0x85, 0x6c, 0x9d, 0x33, 0xc8, 0x5d,
+	0x34, 0xfc, 0x80, 0xfb, 0xbb, 0xea, 0xe6, 0xfc, 0x40, 0xa2, 0xc1, 0xc8, 0x3a, 0xe7, 0x92, 0x8f,
+	0xb8, 0x98, 0x8f, 0x4b, 0x2d, 0x6f, 0x2e, 0x70, 0xd3, 0xf3, 0x9c, 0x36, 0xf5, 0x7d, 0x26, 0xe8,
+	0x73, 0x41, 0x9f, 0xdc, 0x83, 0x92, 0xdb, 0xf5, 0x9b, 0x7c, 0x4c, 0xbe, 0xff, 0x25, 0xdc, 0x18,
+	0xa6, 0x02, 0x5d, 0x76, 0xbb, 0x28, 0x4e, 0xc9, 0x2d, 0x90, 0x58, 0xa0, 0xc2, 0x84, 0x07, 0xf7,
+	0x5f, 0x88, 0xb0, 0x69, 0xeb, 0xc8, 0x22, 0xb7, 0x41, 0xb2, 0x9c, 0x9e, 0x8f, 0x39, 0x4d, 0x59,
+	0xcc, 0xec, 0xc0, 0xe9, 0x7d, 0x4b, 0x7d, 0xdf, 0xe8, 0x51, 0x1d, 0x99, 0xda, 0xbf, 0x64, 0xa0,
+	0xb4, 0xd5, 0xeb, 0x79, 0xb4, 0xc7, 0x46, 0x5d, 0x82, 0x7c, 0x9b, 0xe5, 0x61, 0xb8, 0xde, 0x9c,
+	0xce, 0x1b, 0x4c, 0xc9, 0x7d, 0x6a, 0xd8, 0xb8, 0xc4, 0x8c, 0x8e, 0xcf, 0xec, 0xac, 0xf9, 0x41,
+	0xa7, 0x43, 0x4f, 0xc4, 0xe6, 0x89, 0x16, 0x79, 0x00, 0x4a, 0xd7, 0xec, 0x06, 0x47, 0x4d, 0x97,
+	0x7a, 0x6d, 0x6a, 0x07, 0xa6, 0xc5, 0x97, 0x91, 0xd1, 0xe7, 0x91, 0x7e, 0x18, 0x93, 0xc9, 0xe7,
+	0x70, 0xd5, 0x36, 0x6d, 0x8a, 0xde, 0x70, 0xa8, 0x47, 0x1e, 0x7b, 0x2c, 0x73, 0xf6, 0xf3, 0x74,
+	0x3f, 0xed, 0xaf, 0xb3, 0x50, 0x49, 0xaa, 0x8e, 0x7c, 0x05, 0x73, 0x1d, 0xe7, 0x9d, 0x6d, 0x39,
+	0x46, 0xa7, 0xc9, 0xd2, 0x74, 0xb1, 0x5b, 0xd7, 0x46, 0x9c, 0xd0, 0xae, 0x48, 0xd1, 0xf5, 0x4a,
+	0x24, 0xcf, 0xdc, 0x12, 0xf9, 0x12, 0x2a, 0x2e, 0x1f, 0x8f, 0x77, 0xcf, 0x4e, 0xeb, 0x5e, 0x16,
+	0xe2, 0xd8, 0xfb, 0x19, 0x94, 0x43, 0x77, 0xf0, 0xee, 0xdc, 0xb4, 0xce, 0xc0, 0xa5, 0xb1, 0xef,
+	0x5d, 0xa8, 0xc6, 0x33, 0x6f, 0x9d, 0x06, 0xd4, 0x47, 0x5d, 0x49, 0x7a, 0xbc, 0x9e, 0x6d, 0x46,
+	0x24, 0xb7, 0xa0, 0x12, 0xba, 0x09, 0xa1, 0x3c, 0x0a, 0x89, 0xd7, 0xa2, 0x88, 0xf6, 0x9b, 0x2c,
+	0x2c, 0xc7, 0xfb, 0x98, 0xd2, 0xce, 0xd3, 0xf1, 0xda, 0xe1, 0x5e, 0x25, 0xee, 0x32, 0xa4, 0x92,
+	0x4f, 0xc7, 0xaa, 0x64, 0xb8, 0x4f, 0x4a, 0x0f, 0x8f, 0xc7, 0xe9, 0x61, 0xb8, 0x47, 0x72, 0xf1,
+	0x9f, 0x8d, 0x5d, 0xfc, 0x68, 0x9f, 0x21, 0x65, 0x7c, 0x3a, 0x46, 0x19, 0x63, 0xa6, 0x96, 0x54,
+	0xce, 0xbf, 0x67, 0xa1, 0xf2, 0xa7, 0x0e, 0xcb, 0x30, 0x98, 0x4a, 0x42, 0x9f, 0x3c, 0x80, 0xd2,
+	0x3b, 0x6c, 0x37, 0x63, 0x07, 0x51, 0xf9, 0xf0, 0x7e, 0x4d, 0xe6, 0x42, 0xfb, 0xbb, 0xba, 0xcc,
+	0xd9, 0xfb, 0x1d, 0x96, 0xd4, 0xbe, 0x75, 0x5a, 0x4c, 0x2e, 0x3b, 0x48, 0x6a, 0x99, 0x63, 0xdd,
+	0xd5, 0xf3, 0x6f, 0x9d, 0xd6, 0x7e, 0x87, 0x79, 0x6b, 0x3c, 0x8a, 0xdc, 0x9d, 0x57, 0x07, 0xee,
+	0x1c, 0x8f, 0x2c, 0xf2, 0xc8, 0xcf, 0xa0, 0x88, 0x61, 0x8f, 0x76, 0x54, 0x69, 0x6a, 0x84, 0x8c,
+	0x44, 0x07, 0x5e, 0x23, 0x3f, 0xc5, 0x6b, 0xdc, 0x04, 0xf8, 0x3e, 0xa4, 0x21, 0x6d, 0xfa, 0xe6,
+	0x8f, 0x3c, 0x3a, 0xe7, 0xf4, 0x12, 0x52, 0x1a, 0xe6, 0x8f, 0xdc, 0xcc, 0x8c, 0xc0, 0x68, 0x8a,
+	0xed, 0xa2, 0x1d, 0xcc, 0x3c, 0x72, 0xfa, 0x1c, 0xa3, 0x1e, 0x46, 0xc4, 0x58, 0xcc, 0xa3, 0x6d,
+	0x16, 0xd9, 0x69, 0x47, 0x95, 0x07, 0x62, 0x7a, 0x44, 0xd4, 0x3c, 0xa8, 0xe8, 0xd4, 0x77, 0x42,
+	0xaf, 0x4d, 0xd1, 0xd1, 0xb3, 0x62, 0xd1, 0x0d, 0x51, 0x8d, 0x59, 0x9d, 0x3d, 0x62, 0x7a, 0x47,
+	0xfb, 0x8e, 0x77, 0x2a, 0xe2, 0x86, 0x68, 0x91, 0x55, 0xc8, 0xf5, 0xdc, 0x50, 0xcd, 0x27, 0x52,
+	0xc3, 0x17, 0x87, 0x6f, 0xd8, 0x20, 0x3a, 0x63, 0x30, 0x47, 0xd3, 0x31, 0xfd, 0xe3, 0xc8, 0xc3,
+	0xb3, 0xe7, 0xba, 0x24, 0xe7, 0x14, 0x49, 0xfb, 0x0c, 0x8a, 0x42, 0x32, 0x4e, 0x4f, 0x33, 0x83,
+	0xf4, 0x94, 0xbd, 0xd0, 0x0e, 0xfb, 0x2d, 0xea, 0xe1, 0x0b, 0x73, 0xba, 0x68, 0x69, 0xff, 0x25,
+	0x41, 0x79, 0x2f, 0x68, 0x77, 0x30, 0x10, 0x76, 0x9d, 0xc8, 0xf3, 0x67, 0xc6, 0x78, 0x7e, 0xf2,
+	0x00, 0x64, 0xd7, 0x74, 0xa9, 0x65, 0xda, 0x91, 0xb9, 0x8b, 0x04, 0x41, 0x10, 0xf5, 0x98, 0x4d,
+	0x9e, 0xc0, 0x9c, 0x13, 0x06, 0x6e, 0x18, 0x34, 0x13, 0xe9, 0xd3, 0x50, 0x04, 0xad, 0x70, 0x09,
+	0xde, 0x22, 0x2a, 0x14, 0x3d, 0xca, 0x33, 0x24, 0x7e, 0xc2, 0xa3, 0xe6, 0x98, 0xbd, 0xc9, 0x8f,
+	0xdb, 0x9b, 0x5b, 0x50, 0x41, 0x31, 0xff, 0xd8, 0x74, 0x5d, 0xda, 0x11, 0x7b, 0x5c, 0x66, 0xb4,
+	0x06, 0x27, 0x31, 0x23, 0x40, 0x91, 0xc0, 0x09, 0x0c, 0x4b, 0xec, 0x70, 0x89, 0x51, 0x5e, 0x33,
+	0x02, 0xcb, 0x3d, 0x91, 0xdd, 0x35, 0x4c, 0x2b, 0xde, 0x5a, 0xec, 0xf1, 0x1c, 0x29, 0x63, 0xb6,
+	0x7f, 0x7e, 0xcc, 0xf6, 0x0f, 0x8c, 0xb2, 0x34, 0xc5, 0x28, 0x37, 0xa0, 0x82, 0x0f, 0x91, 0x92,
+	0x60, 0x54, 0x49, 0x65, 0x14, 0xe0, 0x0d, 0x72, 0x3b, 0x0a, 0xa5, 0x65, 0x0c, 0xa5, 0x73, 0xd1,
+	0xf6, 0xa4, 0x02, 0xe9, 0x0a, 0x14, 0x3c, 0x6a, 0xf8, 0x8e, 0x2d, 0x2a, 0x67, 0xd1, 0x4a, 0x1e,
+	0xb0, 0xb9, 0xd9, 0x0f, 0xd8, 0xe7, 0x20, 0x77, 0x4d, 0xdb, 0xf4, 0x8f, 0x68, 0x47, 0xad, 0x4e,
+	0xed, 0x16, 0xcb, 0x6a, 0xbf, 0x9b, 0x83, 0xe2, 0x2c, 0x36, 0xf5, 0x08, 0x4a, 0x41, 0x04, 0x86,
+	0xa4, 0x7c, 0x68, 0x0c, 0x91, 0xe8, 0x03, 0x81, 0x94, 0x05, 0xe6, 0x26, 0x5b, 0xe0, 0x03, 0x50,
+	0xa2, 0xe7, 0xe6, 0x09, 0xf5, 0x7c, 0x96, 0x4e, 0xce, 0xa1, 0x61, 0xcd, 0x47, 0xf4, 0xef, 0x38,
+	0x99, 0x3c, 0x82, 0x32, 0x4b, 0xe0, 0xa3, 0x5d, 0x78, 0x3c, 0xba, 0x0b, 0xc0, 0xf8, 0xfc, 0x99,
+	0x7c, 0x0d, 0x8a, 0x3b, 0x48, 0xe4, 0x9a, 0x8c, 0x83, 0x9a, 0x2e, 0x6f, 0x2e, 0xf1, 0xb9, 0xa4,
+	0xb3, 0x3c, 0x7d, 0xde, 0x4d, 0x13, 0x58, 0x5a, 0x49, 0xb1, 0xc4, 0x17, 0xf8, 0x45, 0x19, 0xbb,
+	0xf1, 0xaa, 0x5f, 0x17, 0x2c, 0xf2, 0x11, 0x80, 0x6b, 0x78, 0xd4, 0x0e, 0x10, 0x2d, 0x28, 0x0c,
+	0xa9, 0xae, 0xc4, 0x79, 0x0c, 0x0d, 0x48, 0x6c, 0x6b, 0xf1, 0x62, 0xdb, 0x2a, 0xcf, 0xbe, 0xad,
+	0xa3, 0xe7, 0xba, 0x34, 0xed, 0x5c, 0xc7, 0x36, 0x0b, 0x33, 0xd9, 0xec, 0xed, 0x94, 0xcd, 0x26,
+	0xaa, 0xe5, 0xea, 0xa4, 0x6a, 0x79, 0x1d, 0xf2, 0x3e, 0x2b, 0xbe, 0xd5, 0x4f, 0x12, 0x59, 0x28,
+	0x96, 0xe3, 0x3a, 0x67, 0x90, 0x87, 0x50, 0x16, 0x13, 0xc7, 0x1a, 0x8f, 0x24, 0xf2, 0x46, 0x9d,
+	0xba, 0x8e, 0x0e, 0x9c, 0xcb, 0x9e, 0x19, 0x36, 0x20, 0x64, 0x45, 0x11, 0xb5, 0x80, 0x93, 0x12,
+	0xeb, 0xda, 0x46, 0x5a, 0xd2, 0x5f, 0x2d, 0x4d, 0xf3, 0x57, 0x2b, 0xb3, 0xf8, 0xab, 0xd5, 0x51,
+	0x7f, 0x35, 0xe4, 0x90, 0xee, 0xcf, 0xe0, 0x90, 0x36, 0xc6, 0x39, 0xa4, 0xb4, 0xdf, 0xbb, 0x3a,
+	0xec, 0xf7, 0x62, 0x7f, 0xb5, 0x36, 0xc5, 0x5f, 0x7d, 0x0e, 0x73, 0x22, 0x29, 0xf0, 0x31, 0x4b,
+	0x50, 0xd5, 0xf5, 0x5c, 0xdc, 0x21, 0x99, 0x3e, 0xe8, 0x95, 0x77, 0x89, 0x16, 0xf9, 0x0a, 0x16,
+	0x3c, 0x11, 0x0f, 0x9b, 0x1e, 0xfd, 0x3e, 0xa4, 0x7e, 0xe0, 0xab, 0xd7, 0x12, 0x2f, 0x4b, 0x46,
+	0x4b, 0x5d, 0x89, 0x64, 0x75, 0x21, 0x4a, 0x9e, 0xc1, 0x7c, 0xdc, 0xdf, 0x32, 0xfb, 0x66, 0xe0,
+	0xab, 0x77, 0xce, 0xea, 0x5d, 0x8d, 0x24, 0x0f, 0x50, 0x90, 0xec, 0xc3, 0x55, 0xdf, 0xec, 0xd0,
+	0xb6, 0xe1, 0x35, 0x87, 0xc7, 0x78, 0x72, 0xd6, 0x18, 0xcb, 0xa2, 0x87, 0x9e, 0x1e, 0x6a, 0x1d,
+	0xf2, 0x26, 0xcb, 0x5a, 0xd4, 0x5a, 0xc2, 0xca, 0x44, 0x59, 0x8a, 0x0c, 0xb2, 0x01, 0x60, 0xd3,
+	0x77, 0x91, 0xd9, 0x5c, 0x47, 0xb1, 0x79, 0x34, 0x32, 0x6e, 0x35, 0x58, 0x7b, 0x94, 0x6c, 0xfa,
+	0x8e, 0x37, 0x47, 0x02, 0xc0, 0xcd, 0x29, 0x01, 0xe0, 0x16, 0x54, 0xa8, 0x6d, 0xb4, 0x2c, 0xda,
+	0xe4, 0x1b, 0xb6, 0x8e, 0x05, 0x66, 0x99, 0xd3, 0x78, 0x32, 0xcb, 0x90, 0x09, 0xc3, 0x0a, 0xd4,
+	0x5b, 0x02, 0x99, 0x30, 0xac, 0x80, 0x7c, 0x02, 0xd0, 0x3e, 0x0a, 0xed, 0x63, 0xee, 0xac, 0xee,
+	0x26, 0x6b, 0x66, 0x46, 0xc6, 0x35, 0x97, 0xda, 0xd1, 0x23, 0x56, 0x0b, 0xac, 0x3e, 0xc3, 0x34,
+	0x95, 0x9d, 0xaa, 0x7b, 0xd3, 0xab, 0x05, 0x26, 0xff, 0x9a, 0x8b, 0xb3, 0x7c, 0x9f, 0x25, 0x84,
+	0x51, 0xef, 0x8f, 0xa6, 0xf5, 0x86, 0xb7, 0x4e, 0x2b, 0xea, 0xcb, 0x4d, 0x9e, 0xbd, 0xdb, 0x33,
+	0xa9, 0xaf, 0x3e, 0x88, 0x4d, 0x3e, 0xec, 0xbf, 0x66, 0x14, 0xf2, 0x25, 0xcc, 0xfb, 0xed, 0x23,
+	0xda, 0x09, 0x2d, 0x06, 0x20, 0xe3, 0x82, 0x1e, 0xe2, 0x0b, 0x16, 0xf9, 0xa1, 0x8f, 0x79, 0xdc,
+	0x1a, 0xfc, 0x54, 0x9b, 0xa1, 0x51, 0xae, 0xd3, 0xe1, 0xdd, 0x3e, 0xe6, 0x68, 0x94, 0xeb, 0x70,
+	0xa8, 0xf7, 0x3a, 0x94, 0x18, 0xcb, 0x35, 0x82, 0xf6, 0x91, 0xfa, 0x08, 0x79, 0x4c, 0xf6, 0x90,
+	0xb5, 0xeb, 0x92, 0x2c, 0x29, 0xf9, 0xba, 0x24, 0xe7, 0x95, 0x42, 0x5d, 0x92, 0x6f, 0x28, 0x37,
+	0xeb, 0x92, 0xac, 0x29, 0xb7, 0xb5, 0x5d, 0x28, 0x70, 0xbb, 0x1f, 0x8b, 0xd0, 0xdc, 0x4b, 0x97,
+	0xbe, 0xca, 0xd0, 0x39, 0x89, 0xdc, 0x9f, 0xb6, 0x0a, 0x72, 0x14, 0xc1, 0xc6, 0x8d, 0xa3, 0xfd,
+	0x3e, 0x0b, 0x0a, 0x4b, 0xd2, 0x22, 0x21, 0x8c, 0xaa, 0xf7, 0xa3, 0xc1, 0x33, 0x38, 0x38, 0x49,
+	0x05, 0xc2, 0x33, 0xbc, 0xab, 0x94, 0xf2, 0xae, 0x43, 0x71, 0x2f, 0x3b, 0x39, 0xee, 0xed, 0x00,
+	0xdb, 0xa7, 0x26, 0x16, 0xbc, 0xbe, 0x48, 0xe5, 0xef, 0xf0, 0xd0, 0x35, 0x34, 0x35, 0xe6, 0xde,
+	0x77, 0x50, 0x8c, 0xc3, 0xc3, 0xa5, 0xb7, 0x51, 0x9b, 0x79, 0x22, 0x23, 0x0c, 0x8e, 0x9a, 0x81,
+	0x73, 0x4c, 0x6d, 0x81, 0x2f, 0x96, 0x18, 0xe5, 0x35, 0x23, 0x90, 0xa7, 0x50, 0xb5, 0x0c, 0x1f,
+	0x63, 0x9e, 0x28, 0xf0, 0x0b, 0xe3, 0xa2, 0x46, 0x85, 0x09, 0x45, 0x2d, 0x06, 0x95, 0x24, 0x42,
+	0x2c, 0x46, 0x41, 0x49, 0x4f, 0x92, 0x6a, 0x5f, 0x42, 0x35, 0x3d, 0xa5, 0x24, 0xb4, 0x9c, 0x1f,
+	0x03, 0x2d, 0xe7, 0x93, 0xd0, 0xf2, 0x5f, 0x55, 0xa1, 0x92, 0xd2, 0x3c, 0x47, 0x4d, 0x16, 0x46,
+	0x50, 0x93, 0x64, 0x76, 0x92, 0x99, 0x9c, 0x9d, 0xa8, 0x50, 0x8c, 0x92, 0x92, 0x32, 0x8f, 0x1e,
+	0x27, 0x71, 0x32, 0x72, 0x9e, 0x84, 0xe8, 0x51, 0x7c, 0xa1, 0xb0, 0x91, 0xf0, 0x49, 0x78, 0xa3,
+	0x30, 0x7a, 0xb9, 0x30, 0x36, 0x75, 0x81, 0x9f, 0x3c, 0x75, 0xf9, 0x05, 0x40, 0xdb, 0xa3, 0x46,
+	0x40, 0x3b, 0x4d, 0x23, 0x50, 0x0b, 0x53, 0xb3, 0x8b, 0x92, 0x90, 0xde, 0x0a, 0x06, 0x36, 0x5d,
+	0x9c, 0x66, 0xd3, 0x2a, 0x4b, 0x7b, 0x1c, 0x0c, 0x9c, 0xf7, 0xd0, 0x09, 0x46, 0x4d, 0xe6, 0x23,
+	0x3d, 0xca, 0x90, 0x90, 0x26, 0xf5, 0x3c, 0xc7, 0x13, 0x68, 0x75, 0x99, 0xd3, 0xf6, 0x18, 0x89,
+	0x7c, 0x0c, 0x0b, 0x3c, 0x3e, 0xf9, 0x51, 0x38, 0xa2, 0x1d, 0xf5, 0x53, 0x74, 0x35, 0x8a, 0x60,
+	0xe8, 0x11, 0x3d, 0x29, 0x6c, 0x9c, 0x18, 0xa6, 0xc5, 0x5c, 0xad, 0xba, 0x99, 0x12, 0xde, 0x8a,
+	0xe8, 0xe4, 0xeb, 0xd4, 0x21, 0x29, 0xe1, 0x21, 0x59, 0x4f, 0xad, 0x62, 0xca, 0x01, 0x19, 0x3d,
+	0x01, 0x1f, 0x4f, 0x3f, 0x01, 0x23, 0x09, 0x8b, 0x32, 0x26, 0x61, 0x19, 0x1b, 0x84, 0x17, 0x2f,
+	0x15, 0x84, 0xd7, 0x7e, 0x82, 0x20, 0xfc, 0xf4, 0xa2, 0x41, 0x78, 0xe9, 0xac, 0x20, 0xbc, 0x0e,
+	0xe5, 0x0e, 0xf5, 0xdb, 0x9e, 0xe9, 0xb2, 0xe8, 0xa2, 0x2e, 0xf3, 0xfd, 0x4f, 0x90, 0x98, 0x17,
+	0x6a, 0x1b, 0xed, 0x23, 0x01, 0x06, 0x5c, 0xe5, 0x5e, 0x08, 0x29, 0x08, 0x06, 0x0c, 0x47, 0x59,
+	0xf5, 0xec, 0x28, 0x7b, 0x2d, 0x11, 0x65, 0x07, 0x6e, 0xf6, 0x46, 0xca, 0xcd, 0xde, 0x81, 0x6a,
+	0xdf, 0xf8, 0xa1, 0x99, 0x80, 0x1f, 0x6e, 0xa2, 0xf5, 0x54, 0xfa, 0xc6, 0x0f, 0xbf, 0x8a, 0x11,
+	0x88, 0x44, 0xaa, 0xbb, 0x7a, 0xb9, 0x54, 0x37, 0x1d, 0xed, 0xd7, 0xcf, 0x1d, 0xed, 0x6f, 0x5d,
+	0x2a, 0xda, 0x6b, 0xe7, 0x89, 0xf6, 0x8f, 0xa1, 0xdc, 0x33, 0x83, 0x23, 0xc7, 0x39, 0x6e, 0xb2,
+	0xab, 0x0c, 0x4c, 0xfe, 0xb7, 0xab, 0x1f, 0xde, 0xaf, 0xc1, 0x0b, 0x4e, 0x66, 0x37, 0x1a, 0x20,
+	0x44, 0xde, 0x78, 0xd6, 0x70, 0xc8, 0xba, 0x33, 0x39, 0x64, 0xa1, 0x93, 0x30, 0xec, 0x4e, 0xeb,
+	0x54, 0xbd, 0x1b, 0x39, 0x09, 0x6c, 0x0e, 0xa7, 0x19, 0x1f, 0xcd, 0x92, 0x66, 0xdc, 0xbf, 0x58,
+	0x9a, 0xf1, 0x60, 0xf6, 0x34, 0x83, 0x2c, 0x43, 0xc1, 0x7f, 0xda, 0x74, 0x42, 0x5e, 0x84, 0xca,
+	0x7a, 0xde, 0x7f, 0xfa, 0x2a, 0x0c, 0x58, 0x60, 0xe9, 0x8b, 0x7b, 0x57, 0x91, 0xb4, 0xce, 0xa5,
+	0x2e, 0x63, 0xf5, 0x98, 0x7d, 0xb9, 0x50, 0xc7, 0xa1, 0xa4, 0x38, 0xd9, 0x59, 0x51, 0xae, 0xd6,
+	0x25, 0xb9, 0xa6, 0x5c, 0xaf, 0x4b, 0xf2, 0x75, 0xe5, 0x46, 0x5d, 0x92, 0x89, 0xb2, 0xa8, 0xbd,
+	0x80, 0xb9, 0xa4, 0x2f, 0xc3, 0xaa, 0x20, 0xae, 0xb4, 0x4d, 0xbb, 0xeb, 0x88, 0xcb, 0xe6, 0x85,
+	0x11, 0xb7, 0xa7, 0x57, 0xdc, 0x44, 0x4b, 0xfb, 0x6d, 0x1e, 0x94, 0x1d, 0x74, 0xfd, 0x2c, 0x44,
+	0x71, 0x37, 0x73, 0x29, 0x8c, 0xe9, 0xda, 0x39, 0x30, 0xa6, 0xda, 0xb4, 0x9a, 0xed, 0xfa, 0x2c,
+	0x35, 0xdb, 0x8d, 0x69, 0x18, 0xd3, 0xcd, 0x29, 0x18, 0xd3, 0xea, 0x0c, 0x25, 0xdd, 0xda, 0x44,
+	0x8c, 0x69, 0xfd, 0x9c, 0x18, 0xd3, 0xad, 0x59, 0x31, 0x26, 0xed, 0x02, 0xf5, 0x7a, 0x02, 0x8c,
+	0xb8, 0x73, 0x31, 0x30, 0xe2, 0xee, 0xec, 0x60, 0xc4, 0x90, 0xb5, 0x66, 0x94, 0x6c, 0x5d, 0x92,
+	0x41, 0x29, 0xd7, 0x25, 0xb9, 0xa8, 0xc8, 0x75, 0x49, 0x2e, 0x29, 0x50, 0x97, 0x64, 0x59, 0x29,
+	0xd5, 0x25, 0xb9, 0xa2, 0xcc, 0xd5, 0x25, 0xb9, 0xac, 0x54, 0xea, 0x92, 0x3c, 0xa7, 0x54, 0xeb,
+	0x92, 0x5c, 0x55, 0xe6, 0xeb, 0x92, 0xbc, 0xac, 0xac, 0xd4, 0x25, 0x79, 0x5e, 0x51, 0xea, 0x92,
+	0xac, 0x28, 0x0b, 0x75, 0x49, 0x5e, 0x50, 0x08, 0xb7, 0xf4, 0xba, 0x24, 0x2f, 0x2a, 0x4b, 0x75,
+	0x49, 0x5e, 0x52, 0x96, 0xe3, 0xd3, 0x70, 0x55, 0x51, 0xeb, 0x92, 0xac, 0x2a, 0xd7, 0xb4, 0xbf,
+	0xcd, 0xc0, 0xc2, 0xbe, 0xcd, 0x8e, 0x78, 0x90, 0xb0, 0xdf, 0x49, 0x58, 0xd7, 0xf9, 0x41, 0xd1,
+	0x35, 0x28, 0xb7, 0x2c, 0xa7, 0x7d, 0xdc, 0x1c, 0x94, 0x11, 0xb2, 0x0e, 0x48, 0xe2, 0x91, 0x9f,
+	0x80, 0xd4, 0x0d, 0x2d, 0x0b, 0x13, 0x7b, 0x59, 0xc7, 0x67, 0xed, 0x1f, 0xb3, 0x50, 0x3d, 0x30,
+	0xfd, 0xe0, 0x8c, 0x53, 0x35, 0x25, 0x33, 0xdd, 0x80, 0x8a, 0x69, 0x27, 0xe6, 0xc8, 0x2f, 0x69,
+	0xd3, 0xf6, 0x82, 0x02, 0x62, 0x8a, 0x17, 0x42, 0x7a, 0x8f, 0x4c, 0x3f, 0x60, 0xe0, 0xb7, 0x84,
+	0xa6, 0x1d, 0x35, 0xe3, 0xd5, 0xe4, 0x07, 0xab, 0x61, 0x97, 0xa4, 0x6f, 0xbf, 0x7f, 0x6e, 0x5a,
+	0x01, 0xf5, 0x30, 0x97, 0x2c, 0xe9, 0x71, 0x1b, 0x7d, 0xa9, 0xd1, 0x13, 0x41, 0x95, 0xc3, 0xb9,
+	0x32, 0x23, 0x60, 0x40, 0x5d, 0x83, 0x32, 0x1a, 0x58, 0xd3, 0xe8, 0x06, 0x34, 0x4a, 0x03, 0x01,
+	0x49, 0x5b, 0x8c, 0xa2, 0xbd, 0x85, 0xf9, 0xe7, 0x56, 0xe8, 0x1f, 0x25, 0xf4, 0x74, 0x17, 0x8a,
+	0x7c, 0x15, 0xd1, 0x17, 0x33, 0xa9, 0x65, 0x44, 0x3c, 0xf2, 0x04, 0x2a, 0x81, 0xd3, 0x8c, 0x54,
+	0x16, 0x5d, 0x64, 0x0f, 0xa9, 0xb4, 0x1c, 0x38, 0xd1, 0xb3, 0xaf, 0x6d, 0x80, 0xb2, 0x4b, 0x2d,
+	0x1a, 0xd0, 0xd9, 0x4c, 0x45, 0x7b, 0x04, 0xd5, 0x46, 0xe0, 0xb8, 0x33, 0x4a, 0xff, 0x2e, 0x0b,
+	0xcb, 0x6f, 0xdc, 0x0e, 0xf7, 0xa4, 0xfc, 0xa0, 0x4e, 0xef, 0x35, 0x38, 0xe9, 0xd9, 0x99, 0x4e,
+	0x7a, 0x2e, 0x75, 0xd2, 0xff, 0x10, 0x70, 0xfd, 0x90, 0xaf, 0x2c, 0xce, 0xe0, 0x2b, 0xe5, 0xe9,
+	0xf0, 0x57, 0xe9, 0x4c, 0xf8, 0x0b, 0x26, 0xbb, 0x52, 0xed, 0xd7, 0x59, 0xa8, 0xbe, 0xa0, 0xc1,
+	0x81, 0xd3, 0xf3, 0x2f, 0x10, 0xae, 0x26, 0x6d, 0x45, 0xa4, 0x8c, 0x2e, 0xda, 0x35, 0xaf, 0xae,
+	0x4b, 0x5c, 0x19, 0xdc, 0xd4, 0xfd, 0xc1, 0x45, 0x7b, 0xe1, 0xac, 0x8b, 0x76, 0xfc, 0x64, 0xc8,
+	0x67, 0xb6, 0xce, 0xcf, 0x8f, 0x68, 0x31, 0x7a, 0xd7, 0xb1, 0x2c, 0xe7, 0x9d, 0xf8, 0x9a, 0x46,
+	0xb4, 0xf0, 0x9a, 0xc8, 0x30, 0x2d, 0xa1, 0x33, 0x7c, 0x26, 0xf7, 0x41, 0x09, 0x7d, 0xda, 0xb4,
+	0x9c, 0x63, 0xb3, 0xd9, 0x32, 0xda, 0xc7, 0xd4, 0xee, 0x88, 0x6f, 0x6d, 0xaa, 0xa1, 0x4f, 0x0f,
+	0x9c, 0x63, 0x73, 0x9b, 0x53, 0xb9, 0xdb, 0xd5, 0x7e, 0x9b, 0x05, 0x18, 0xdc, 0x98, 0xb3, 0x42,
+	0x24, 0x4e, 0x05, 0x12, 0x28, 0x46, 0x1c, 0xf7, 0x5f, 0x32, 0x54, 0x64, 0x70, 0x5f, 0x98, 0x3b,
+	0xe3, 0xbe, 0x30, 0x75, 0xf9, 0x58, 0x9c, 0x78, 0xf9, 0x78, 0x0f, 0x64, 0x9e, 0xc8, 0x99, 0x7c,
+	0xa2, 0xa5, 0xed, 0xf2, 0x87, 0xf7, 0x6b, 0x45, 0xfe, 0x81, 0xc2, 0xae, 0x5e, 0x44, 0xe6, 0x7e,
+	0x27, 0xa1, 0x1c, 0x48, 0x29, 0x27, 0xba, 0x9a, 0x94, 0x26, 0x5c, 0x4d, 0x46, 0x1f, 0x31, 0xca,
+	0xdc, 0x2d, 0xb1, 0x67, 0xf2, 0x10, 0xb2, 0xf1, 0xad, 0xe3, 0xa4, 0x68, 0x95, 0x0d, 0x7c, 0x76,
+	0x56, 0xfa, 0x5c, 0x41, 0xc2, 0x83, 0x45, 0x4d, 0xed, 0x35, 0x2c, 0xea, 0xfc, 0xd8, 0xf0, 0x9d,
+	0x9c, 0xe1, 0xd4, 0x0e, 0x9b, 0x4a, 0x76, 0xc4, 0x54, 0xb4, 0x3f, 0x82, 0x45, 0x11, 0x98, 0x52,
+	0xa3, 0x4e, 0xfd, 0x54, 0x43, 0xfb, 0xcb, 0x0c, 0x28, 0x2c, 0x72, 0xcc, 0x3c, 0x99, 0x94, 0x03,
+	0xce, 0x0e, 0x39, 0x60, 0xfc, 0x1a, 0x45, 0x7c, 0x0a, 0x99, 0xd3, 0xf1, 0x79, 0xd8, 0x29, 0xe7,
+	0x47, 0x9c, 0xf2, 0x36, 0x94, 0xe2, 0x2a, 0x25, 0x71, 0x69, 0x99, 0x49, 0x5e, 0x5a, 0xb2, 0x03,
+	0xcd, 0xde, 0x28, 0xae, 0xb7, 0xf9, 0x7b, 0x4b, 0x8c, 0xc2, 0x2f, 0xb3, 0xff, 0x23, 0x03, 0xd5,
+	0x74, 0x82, 0x4e, 0xea, 0x30, 0x67, 0x3b, 0x1d, 0xda, 0xf4, 0xa9, 0x45, 0xdb, 0x81, 0xe3, 0x09,
+	0xf7, 0x7e, 0x77, 0x4c, 0x32, 0xbf, 0xf1, 0xd2, 0xe9, 0xd0, 0x86, 0x90, 0xe3, 0xf5, 0x79, 0xc5,
+	0x4e, 0x90, 0xc8, 0x06, 0x2c, 0xba, 0x9e, 0xe9, 0x78, 0x66, 0x70, 0xda, 0x6c, 0x5b, 0x86, 0xef,
+	0x73, 0x53, 0xe7, 0x17, 0xb9, 0x0b, 0x11, 0x6b, 0x87, 0x71, 0x98, 0xbd, 0xd7, 0xbe, 0x86, 0x85,
+	0x91, 0x21, 0xcf, 0xf5, 0x6d, 0xe3, 0x6f, 0x00, 0x96, 0x79, 0xa2, 0x1c, 0xbb, 0x95, 0xf3, 0xc7,
+	0xf5, 0x01, 0x52, 0x74, 0x7b, 0x06, 0xa4, 0xe8, 0x7c, 0x28, 0xd4, 0x38, 0x5c, 0xa9, 0x78, 0x31,
+	0x5c, 0xa9, 0x74, 0x36, 0xae, 0xb4, 0x02, 0x85, 0x10, 0x83, 0x5c, 0xe4, 0xdf, 0x78, 0x6b, 0x14,
+	0xfd, 0x80, 0x31, 0xe8, 0xc7, 0xa0, 0xb2, 0xba, 0x93, 0xac, 0xac, 0xc6, 0x82, 0x22, 0x95, 0x4b,
+	0x81, 0x22, 0x2b, 0x3f, 0x01, 0x28, 0xf2, 0xf8, 0xa2, 0xa0, 0xc8, 0xdc, 0x8c, 0xa0, 0x48, 0x75,
+	0x1a, 0x28, 0xa2, 0x4c, 0x03, 0x45, 0x16, 0x46, 0x41, 0x91, 0x1b, 0x50, 0xf2, 0xa8, 0x08, 0xfb,
+	0x78, 0xc3, 0x26, 0xeb, 0x03, 0xc2, 0x18, 0x18, 0x64, 0x69, 0x32, 0x0c, 0xb2, 0x3c, 0x13, 0x0c,
+	0x72, 0x6b, 0x36, 0x18, 0xe4, 0xea, 0xb9, 0x61, 0x10, 0xf5, 0x52, 0x30, 0xc8, 0xb5, 0xf3, 0xc0,
+	0x20, 0x11, 0x9a, 0x54, 0x4b, 0xa0, 0x49, 0x09, 0xec, 0xe2, 0xfa, 0x44, 0xec, 0xe2, 0xc6, 0x2c,
+	0xd8, 0xc5, 0xcd, 0x8b, 0x61, 0x17, 0xab, 0x13, 0xb0, 0x8b, 0xf5, 0x21, 0xec, 0x62, 0x08, 0x9a,
+	0xd1, 0x26, 0x43, 0x33, 0x49, 0x48, 0x63, 0x63, 0x22, 0xa4, 0x31, 0x54, 0xe6, 0xf1, 0x12, 0x8e,
+	0x17, 0x6c, 0x8b, 0xca, 0x92, 0xb6, 0x03, 0x2b, 0x22, 0xd8, 0x5d, 0xdc, 0x39, 0x6a, 0xff, 0x90,
+	0x81, 0x45, 0x16, 0xf8, 0x2e, 0xe1, 0x5f, 0x13, 0x55, 0x4d, 0x36, 0x5d, 0xd5, 0x3c, 0x00, 0xc5,
+	0x60, 0x09, 0x57, 0xd3, 0xb4, 0xdb, 0x4e, 0xdf, 0x65, 0x55, 0x80, 0xf8, 0x32, 0x74, 0x1e, 0xe9,
+	0xfb, 0x31, 0x39, 0x55, 0xec, 0x48, 0xe9, 0x62, 0x47, 0xfb, 0x9b, 0x0c, 0x2c, 0xf3, 0x1a, 0xe2,
+	0x12, 0xb3, 0x54, 0x20, 0x67, 0xc4, 0xe5, 0x22, 0x7b, 0x64, 0x61, 0xa7, 0xeb, 0x78, 0xed, 0xc8,
+	0xa9, 0xf2, 0x06, 0xdb, 0xe9, 0x63, 0x4a, 0x5d, 0x7e, 0x59, 0xce, 0xbf, 0x65, 0x96, 0x19, 0x41,
+	0xa7, 0xae, 0x53, 0x97, 0xe4, 0xac, 0x92, 0x13, 0x9f, 0x1d, 0x6d, 0xc1, 0x52, 0x83, 0x45, 0xef,
+	0x4b, 0x28, 0xff, 0x1b, 0x58, 0x64, 0xb5, 0xce, 0x25, 0x46, 0xf8, 0xbb, 0x0c, 0x10, 0x3d, 0xb4,
+	0x2f, 0xa1, 0x97, 0xcf, 0x00, 0x5c, 0xcf, 0x39, 0xa1, 0xb6, 0x61, 0xe3, 0x97, 0xf9, 0x2c, 0x39,
+	0x58, 0x4e, 0xd8, 0xee, 0x61, 0xcc, 0xd4, 0x13, 0x82, 0x89, 0x54, 0x56, 0x1a, 0x9f, 0xca, 0x0a,
+	0x2d, 0x7d, 0x01, 0x55, 0x3d, 0xb4, 0xd9, 0x07, 0xca, 0x17, 0x58, 0xdd, 0x03, 0x58, 0xe4, 0xd1,
+	0x9f, 0xff, 0x97, 0x27, 0x1a, 0x81, 0x15, 0xcb, 0xa6, 0xc5, 0x7b, 0x57, 0x74, 0x7c, 0xd6, 0x9e,
+	0xc1, 0x22, 0x37, 0x91, 0xb4, 0xe8, 0x6d, 0x28, 0xf0, 0xff, 0x07, 0x0d, 0x3e, 0x64, 0x8e, 0xff,
+	0x55, 0xa4, 0x0b, 0x96, 0xf6, 0x05, 0x2c, 0x89, 0x83, 0x74, 0x81, 0xce, 0x37, 0xa0, 0xc0, 0x29,
+	0x63, 0xef, 0x2f, 0x7f, 0x9d, 0x01, 0xe0, 0x6c, 0xbc, 0x3f, 0x9b, 0x65, 0xc4, 0xf8, 0x23, 0xb6,
+	0x6c, 0xe2, 0x23, 0xb6, 0x7d, 0x20, 0x78, 0x57, 0x64, 0x3a, 0x76, 0x33, 0xfe, 0xb7, 0x99, 0x9a,
+	0x9b, 0x9a, 0x84, 0x2f, 0x44, 0xbd, 0x62, 0x92, 0xf6, 0x35, 0x94, 0x07, 0x33, 0x62, 0x15, 0x7d,
+	0x99, 0xbf, 0x37, 0x89, 0x60, 0xce, 0x27, 0xe6, 0xc5, 0xc4, 0x74, 0xf0, 0xe3, 0x67, 0xed, 0x19,
+	0x2c, 0xbf, 0x30, 0xbc, 0x96, 0xd1, 0xa3, 0x3b, 0x8e, 0xc5, 0x32, 0xbb, 0x48, 0x5f, 0xb7, 0xa0,
+	0xc2, 0x3f, 0xe6, 0x13, 0xe9, 0x29, 0x4f, 0x5d, 0xcb, 0x9c, 0xc6, 0x13, 0x54, 0x15, 0x56, 0x86,
+	0xfb, 0xfa, 0xae, 0x63, 0xfb, 0x54, 0x5b, 0x86, 0xc5, 0xad, 0x76, 0x60, 0x9e, 0x18, 0x01, 0xdd,
+	0x0a, 0x83, 0x23, 0x31, 0xa6, 0xb6, 0x02, 0x4b, 0x69, 0x32, 0x17, 0x7f, 0xe8, 0xe1, 0x17, 0xec,
+	0x1c, 0x0a, 0x52, 0xa0, 0x52, 0x7f, 0xb5, 0xdd, 0x6c, 0xbc, 0xde, 0xd2, 0x5f, 0xef, 0xbf, 0x7c,
+	0xa1, 0x5c, 0x21, 0xf3, 0x50, 0x66, 0x14, 0xfd, 0xcd, 0xcb, 0x97, 0x8c, 0x90, 0x89, 0x08, 0xcf,
+	0xb7, 0xf6, 0x0f, 0xde, 0xe8, 0x7b, 0x4a, 0x36, 0x22, 0x34, 0xde, 0xec, 0xec, 0xec, 0x35, 0x1a,
+	0x4a, 0x8e, 0x54, 0x01, 0x18, 0xe1, 0x97, 0xfb, 0x07, 0x07, 0x7b, 0xbb, 0x8a, 0x44, 0x16, 0x60,
+	0x8e, 0xb5, 0xf7, 0x5e, 0xe8, 0x7b, 0x8d, 0x06, 0x1b, 0xa4, 0xf0, 0xf0, 0x15, 0xc0, 0xe0, 0xeb,
+	0x6d, 0x02, 0x50, 0x60, 0xc3, 0xed, 0xed, 0x2a, 0x57, 0x48, 0x19, 0x8a, 0xd1, 0x48, 0x19, 0x6c,
+	0xfc, 0x72, 0xff, 0xf0, 0x70, 0x6f, 0x57, 0xc9, 0x92, 0x0a, 0xc8, 0xf1, 0xbc, 0x72, 0x64, 0x0e,
+	0x4a, 0xfa, 0xde, 0xce, 0xab, 0xef, 0xf6, 0x74, 0xf6, 0x8e, 0x87, 0x5f, 0x43, 0x39, 0x71, 0x27,
+	0xce, 0xe6, 0x74, 0xf8, 0x6a, 0x37, 0x9e, 0xf5, 0x95, 0x88, 0x30, 0x18, 0xba, 0x0a, 0xc0, 0x08,
+	0xe2, 0xbd, 0xd9, 0x87, 0xff, 0x94, 0x19, 0x40, 0xd2, 0x7c, 0x8c, 0x65, 0x58, 0x38, 0xdc, 0x3f,
+	0xdc, 0x3b, 0xd8, 0x7f, 0xb9, 0x97, 0x54, 0xc8, 0x12, 0x28, 0x31, 0x79, 0xa0, 0x95, 0xab, 0xb0,
+	0x38, 0xa0, 0xee, 0xc5, 0xe2, 0xd9, 0x94, 0x78, 0xa4, 0xb3, 0x1c, 0x59, 0x84, 0xf9, 0x98, 0x7a,
+	0xb8, 0xf5, 0xa6, 0x81, 0x7a, 0x4a, 0x8a, 0x36, 0x5e, 0x6f, 0xbd, 0xdc, 0xdd, 0xfe, 0x33, 0x25,
+	0x9f, 0x9a, 0xc6, 0x8e, 0xbe, 0xd5, 0xf8, 0x13, 0xd4, 0xe0, 0xe6, 0xdf, 0x57, 0x20, 0xb7, 0x75,
+	0xb8, 0x4f, 0x36, 0xa0, 0xc4, 0x0f, 0x36, 0xcb, 0xb8, 0x97, 0xc5, 0x7f, 0x18, 0xd2, 0x78, 0x78,
+	0x2d, 0x2e, 0xb8, 0xb4, 0x2b, 0xe4, 0x67, 0x00, 0x03, 0xc0, 0x91, 0xac, 0x88, 0x24, 0x6f, 0x08,
+	0x81, 0xac, 0x55, 0xa2, 0x1e, 0x68, 0xa6, 0x57, 0xc8, 0x13, 0x28, 0x0a, 0x34, 0x90, 0xf0, 0xf8,
+	0x9f, 0xc6, 0x06, 0x87, 0xe5, 0x9f, 0x64, 0xc8, 0x26, 0xc8, 0x11, 0x30, 0x46, 0x78, 0x02, 0x3f,
+	0x84, 0x93, 0x8d, 0xe9, 0xf3, 0x25, 0x94, 0x62, 0x80, 0x4b, 0xac, 0x65, 0x18, 0xf0, 0xaa, 0xad,
+	0x8c, 0x1c, 0xd1, 0x3d, 0xf6, 0xbf, 0x1e, 0xed, 0x0a, 0xf9, 0x39, 0x14, 0x05, 0xdc, 0x25, 0xe6,
+	0x98, 0x06, 0xbf, 0x26, 0xf4, 0x7c, 0x06, 0x95, 0x64, 0xad, 0x4b, 0xd4, 0xa4, 0x56, 0x92, 0x75,
+	0x6c, 0xad, 0x3a, 0xa8, 0x77, 0x85, 0x66, 0x3e, 0x87, 0x52, 0x5c, 0xed, 0x8a, 0x39, 0x0f, 0x57,
+	0xbf, 0xa3, 0xbd, 0x9e, 0x64, 0xc8, 0x36, 0x7e, 0xde, 0x1b, 0x57, 0xed, 0xe2, 0x9d, 0x63, 0x0a,
+	0xf9, 0x09, 0xf3, 0x7e, 0x0e, 0xd5, 0x74, 0x49, 0x47, 0x6a, 0x09, 0x03, 0x18, 0x8a, 0x64, 0x13,
+	0xc6, 0xd9, 0x81, 0xf9, 0xa1, 0xf4, 0x87, 0x5c, 0x4f, 0xaa, 0x60, 0x78, 0xa4, 0xd1, 0x5b, 0x19,
+	0xed, 0x0a, 0xf9, 0x0a, 0x2a, 0xc9, 0xec, 0x47, 0x2c, 0x68, 0x4c, 0x42, 0x54, 0x23, 0x23, 0xdd,
+	0x7d, 0xbe, 0x98, 0x74, 0x66, 0x22, 0x16, 0x33, 0x36, 0x5d, 0x99, 0xb0, 0x98, 0x5d, 0x98, 0x4b,
+	0x25, 0x13, 0xe4, 0x9a, 0x30, 0x86, 0xd1, 0x04, 0x63, 0xc2, 0x28, 0xdb, 0x50, 0x49, 0xe6, 0x13,
+	0x62, 0x35, 0x63, 0x52, 0x8c, 0x09, 0x63, 0x7c, 0x03, 0xe5, 0x44, 0x42, 0x41, 0xf8, 0xbf, 0x76,
+	0x47, 0x53, 0x8c, 0xc9, 0x26, 0x2d, 0x42, 0xbe, 0x30, 0xe9, 0x74, 0x02, 0x30, 0x79, 0xfe, 0xc9,
+	0x78, 0x2f, 0xe6, 0x3f, 0x26, 0x05, 0x98, 0x3c, 0x46, 0x32, 0x11, 0x10, 0x63, 0x8c, 0xc9, 0x0d,
+	0x26, 0xae, 0x00, 0x98, 0x09, 0x88, 0x11, 0xce, 0x90, 0xab, 0x29, 0x43, 0x41, 0x92, 0xd9, 0xc3,
+	0x1f, 0xc3, 0x5c, 0x2a, 0x95, 0x10, 0xfb, 0x38, 0x2e, 0xbd, 0xa8, 0x0d, 0x07, 0x59, 0xec, 0x2e,
+	0x7c, 0xc9, 0x96, 0x65, 0x9d, 0xf9, 0xde, 0xb3, 0xe7, 0xfd, 0x14, 0x8a, 0x02, 0xa5, 0x15, 0x9a,
+	0x4f, 0x63, 0xb6, 0xb5, 0xe1, 0xff, 0xf9, 0xe0, 0x99, 0xde, 0x83, 0x4a, 0x32, 0xc2, 0x0a, 0x85,
+	0x8d, 0x89, 0xc5, 0xb5, 0x6b, 0x63, 0x38, 0x22, 0x7a, 0xe3, 0x49, 0x48, 0x03, 0xf1, 0xe2, 0x24,
+	0x8c, 0x45, 0xe7, 0xcf, 0x5e, 0xc3, 0xf6, 0x17, 0xff, 0xf6, 0x61, 0x35, 0xf3, 0x9f, 0x1f, 0x56,
+	0x33, 0xff, 0xfd, 0x61, 0x35, 0xf3, 0xe7, 0x9f, 0xb0, 0x1b, 0xf0, 0xb0, 0xb5, 0xd1, 0x76, 0xfa,
+	0x8f, 0x5d, 0xa3, 0x7d, 0x74, 0xda, 0xa1, 0x5e, 0xf2, 0xc9, 0xf7, 0xda, 0x8f, 0x07, 0xff, 0xe2,
+	0x6f, 0x15, 0x70, 0xb8, 0xa7, 0xff, 0x3f, 0x00, 0xf1, 0x63, 0x69, 0x1c, 0xda, 0x3f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5850,6 +5915,7 @@ type APIClient interface {
 	InspectSecret(ctx context.Context, in *InspectSecretRequest, opts ...grpc.CallOption) (*SecretInfo, error)
 	// DeleteAll deletes everything
 	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
 	// An internal call that causes PPS to put itself into an auth-enabled state
 	// (all pipeline have tokens, correct permissions, etcd)
 	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
@@ -6132,6 +6198,38 @@ func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc
 	return out, nil
 }
 
+func (c *aPIClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pps.API/GetLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGetLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_GetLogsClient interface {
+	Recv() (*LogMessage, error)
+	grpc.ClientStream
+}
+
+type aPIGetLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGetLogsClient) Recv() (*LogMessage, error) {
+	m := new(LogMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
 	out := new(ActivateAuthResponse)
 	err := c.cc.Invoke(ctx, "/pps.API/ActivateAuth", in, out, opts...)
@@ -6177,6 +6275,7 @@ type APIServer interface {
 	InspectSecret(context.Context, *InspectSecretRequest) (*SecretInfo, error)
 	// DeleteAll deletes everything
 	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
+	GetLogs(*GetLogsRequest, API_GetLogsServer) error
 	// An internal call that causes PPS to put itself into an auth-enabled state
 	// (all pipeline have tokens, correct permissions, etcd)
 	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
@@ -6254,6 +6353,9 @@ func (*UnimplementedAPIServer) InspectSecret(ctx context.Context, req *InspectSe
 func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
 }
+func (*UnimplementedAPIServer) GetLogs(req *GetLogsRequest, srv API_GetLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
 func (*UnimplementedAPIServer) ActivateAuth(ctx context.Context, req *ActivateAuthRequest) (*ActivateAuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
 }
@@ -6670,6 +6772,27 @@ func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).GetLogs(m, &aPIGetLogsServer{stream})
+}
+
+type API_GetLogsServer interface {
+	Send(*LogMessage) error
+	grpc.ServerStream
+}
+
+type aPIGetLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetLogsServer) Send(m *LogMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ActivateAuthRequest)
 	if err := dec(in); err != nil {
@@ -6811,6 +6934,11 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_ListDatum_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetLogs",
+			Handler:       _API_GetLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "client/pps/pps.proto",
 }
@@ -7957,6 +8085,20 @@ func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9975,6 +10117,18 @@ func (m *ListJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.StartAfter) > 0 {
+		i -= len(m.StartAfter)
+		copy(dAtA[i:], m.StartAfter)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.StartAfter)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.JqFilter) > 0 {
 		i -= len(m.JqFilter)
 		copy(dAtA[i:], m.JqFilter)
@@ -10590,6 +10744,23 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.StartAfter) > 0 {
+		i -= len(m.StartAfter)
+		copy(dAtA[i:], m.StartAfter)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.StartAfter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Page != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Job != nil {
 		{
 			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
@@ -12327,6 +12498,12 @@ func (m *DatumInfo) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13116,6 +13293,13 @@ func (m *ListJobRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	l = len(m.StartAfter)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13361,6 +13545,16 @@ func (m *ListDatumRequest) Size() (n int) {
 		l = m.Job.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	l = len(m.StartAfter)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14001,7 +14195,10 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -14226,7 +14423,7 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
+					if skippy < 0 {
 						return ErrInvalidLengthPps
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -14633,7 +14830,10 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -14780,7 +14980,10 @@ func (m *BuildSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -14863,7 +15066,10 @@ func (m *TFJob) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -14946,7 +15152,10 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -15029,7 +15238,10 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -15190,7 +15402,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
+					if skippy < 0 {
 						return ErrInvalidLengthPps
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -15317,7 +15529,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
+					if skippy < 0 {
 						return ErrInvalidLengthPps
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -15334,7 +15546,10 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -15487,7 +15702,10 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -15626,7 +15844,10 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -16017,7 +16238,10 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -16252,7 +16476,10 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -16431,7 +16658,10 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -16726,7 +16956,10 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -16897,7 +17130,10 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -16978,7 +17214,10 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -17095,7 +17334,10 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -17214,7 +17456,10 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -17420,13 +17665,50 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &LogMessage{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -17540,7 +17822,10 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -17737,7 +18022,10 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -17968,7 +18256,10 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -18246,7 +18537,10 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -18408,7 +18702,10 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -18510,7 +18807,10 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -18978,7 +19278,10 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -20278,7 +20581,10 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -20380,7 +20686,10 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -20463,7 +20772,10 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -20651,7 +20963,7 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
+					if skippy < 0 {
 						return ErrInvalidLengthPps
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -20770,7 +21082,10 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -21062,7 +21377,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
+					if skippy < 0 {
 						return ErrInvalidLengthPps
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -22101,7 +22416,10 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -22186,7 +22504,10 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -22618,7 +22939,10 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -22781,7 +23105,10 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -23003,13 +23330,67 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.JqFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -23128,7 +23509,10 @@ func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -23215,7 +23599,10 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -23302,7 +23689,10 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -23590,7 +23980,10 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -23860,7 +24253,10 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -24181,7 +24577,10 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -24300,7 +24699,10 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -24387,7 +24789,10 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -24468,13 +24873,86 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -24563,7 +25041,10 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -24724,7 +25205,7 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
+					if skippy < 0 {
 						return ErrInvalidLengthPps
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -24773,7 +25254,10 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -25766,7 +26250,10 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -25853,7 +26340,10 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26011,7 +26501,10 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26158,7 +26651,10 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26245,7 +26741,10 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26332,7 +26831,10 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26485,7 +26987,10 @@ func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26572,7 +27077,10 @@ func (m *RunCronRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26657,7 +27165,10 @@ func (m *CreateSecretRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26744,7 +27255,10 @@ func (m *DeleteSecretRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26831,7 +27345,10 @@ func (m *InspectSecretRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -26914,7 +27431,10 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -27069,7 +27589,10 @@ func (m *SecretInfo) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -27154,7 +27677,10 @@ func (m *SecretInfos) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -27224,7 +27750,10 @@ func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -27275,7 +27804,10 @@ func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -27326,7 +27858,10 @@ func (m *ActivateAuthRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -27377,7 +27912,10 @@ func (m *ActivateAuthResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
 			if (iNdEx + skippy) > l {
@@ -11,6 +11,10 @@ import (
 	"gopkg.in/src-d/go-git.v4"
 )
 
+// DatumTotalHeader is the gRPC response header in which a paginated ListDatum
+// returns the total number of datums in the job.
+const DatumTotalHeader = "datum-total"
+
 var (
 	// format strings for state name parsing errors
 	errInvalidJobStateName      string
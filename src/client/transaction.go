@@ -404,6 +404,12 @@ func (c *pfsBuilderClient) CreateFileset(ctx context.Context, opts ...grpc.CallO
 func (c *pfsBuilderClient) RenewFileset(ctx context.Context, req *pfs.RenewFilesetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RenewFileset")
 }
+func (c *pfsBuilderClient) GarbageCollect(ctx context.Context, req *pfs.GarbageCollectRequest, opts ...grpc.CallOption) (pfs.API_GarbageCollectClient, error) {
+	return nil, unsupportedError("GarbageCollect")
+}
+func (c *pfsBuilderClient) ExplainStorageObject(ctx context.Context, req *pfs.ExplainStorageObjectRequest, opts ...grpc.CallOption) (*pfs.ExplainStorageObjectResponse, error) {
+	return nil, unsupportedError("ExplainStorageObject")
+}
 
 func (c *objectBuilderClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (pfs.ObjectAPI_PutObjectClient, error) {
 	return nil, unsupportedError("PutObject")
@@ -526,6 +532,9 @@ func (c *ppsBuilderClient) RunPipeline(ctx context.Context, req *pps.RunPipeline
 func (c *ppsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteAll")
 }
+func (c *ppsBuilderClient) GetLogs(ctx context.Context, req *pps.GetLogsRequest, opts ...grpc.CallOption) (pps.API_GetLogsClient, error) {
+	return nil, unsupportedError("GetLogs")
+}
 func (c *ppsBuilderClient) ActivateAuth(ctx context.Context, req *pps.ActivateAuthRequest, opts ...grpc.CallOption) (*pps.ActivateAuthResponse, error) {
 	return nil, unsupportedError("ActivateAuth")
 }
@@ -2022,104 +2022,104 @@ func TestInspectDatum(t *testing.T) {
 	})
 }
 
-// TODO: Make logs work with V2.
 // TestGetLogs tests that you must have READER access to all of a job's input
 // repos and READER access to its output repo to call GetLogs()
-//func TestGetLogs(t *testing.T) {
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//	tu.DeleteAll(t)
-//	defer tu.DeleteAll(t)
-//	alice, bob := tu.UniqueString("alice"), tu.UniqueString("bob")
-//	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
-//
-//	// alice creates a repo
-//	repo := tu.UniqueString(t.Name())
-//	require.NoError(t, aliceClient.CreateRepo(repo))
-//
-//	// alice creates a pipeline
-//	pipeline := tu.UniqueString("pipeline")
-//	require.NoError(t, aliceClient.CreatePipeline(
-//		pipeline,
-//		"", // default image: ubuntu:16.04
-//		[]string{"bash"},
-//		[]string{"cp /pfs/*/* /pfs/out/"},
-//		&pps.ParallelismSpec{Constant: 1},
-//		client.NewPFSInput(repo, "/*"),
-//		"", // default output branch: master
-//		false,
-//	))
-//
-//	// alice commits to the input repos, and the pipeline runs successfully
-//	err := aliceClient.PutFile(repo, "master", "/file1", strings.NewReader("test"))
-//	require.NoError(t, err)
-//	commitIter, err := aliceClient.FlushCommit(
-//		[]*pfs.Commit{client.NewCommit(repo, "master")},
-//		[]*pfs.Repo{client.NewRepo(pipeline)},
-//	)
-//	require.NoError(t, err)
-//	require.NoErrorWithinT(t, 60*time.Second, func() error {
-//		_, err := commitIter.Next()
-//		return err
-//	})
-//
-//	// bob cannot call GetLogs
-//	iter := bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
-//	require.False(t, iter.Next())
-//	require.YesError(t, iter.Err())
-//	require.True(t, auth.IsErrNotAuthorized(iter.Err()), iter.Err().Error())
-//
-//	// bob also can't call GetLogs for the master process
-//	iter = bobClient.GetLogs(pipeline, "", nil, "", true, false, 0)
-//	require.False(t, iter.Next())
-//	require.YesError(t, iter.Err())
-//	require.True(t, auth.IsErrNotAuthorized(iter.Err()), iter.Err().Error())
-//
-//	// alice adds bob to the input repo, but bob still can't call GetLogs
-//	aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
-//		Username: bob,
-//		Scope:    auth.Scope_READER,
-//		Repo:     repo,
-//	})
-//	iter = bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
-//	require.False(t, iter.Next())
-//	require.YesError(t, iter.Err())
-//	require.True(t, auth.IsErrNotAuthorized(iter.Err()), iter.Err().Error())
-//
-//	// alice removes bob from the input repo and adds bob to the output repo, but
-//	// bob still can't call GetLogs
-//	aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
-//		Username: bob,
-//		Scope:    auth.Scope_NONE,
-//		Repo:     repo,
-//	})
-//	aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
-//		Username: bob,
-//		Scope:    auth.Scope_READER,
-//		Repo:     pipeline,
-//	})
-//	iter = bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
-//	require.False(t, iter.Next())
-//	require.YesError(t, iter.Err())
-//	require.True(t, auth.IsErrNotAuthorized(iter.Err()), iter.Err().Error())
-//
-//	// alice adds bob to the output repo, and now bob can call GetLogs
-//	aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
-//		Username: bob,
-//		Scope:    auth.Scope_READER,
-//		Repo:     repo,
-//	})
-//	iter = bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
-//	iter.Next()
-//	require.NoError(t, iter.Err())
-//
-//	// bob can also call GetLogs for the master process
-//	iter = bobClient.GetLogs(pipeline, "", nil, "", true, false, 0)
-//	iter.Next()
-//	require.NoError(t, iter.Err())
-//}
-//
+func TestGetLogs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := tu.UniqueString("alice"), tu.UniqueString("bob")
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	// alice creates a repo
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+
+	// alice creates a pipeline
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, aliceClient.CreatePipeline(
+		pipeline,
+		"", // default image: ubuntu:16.04
+		[]string{"bash"},
+		[]string{"cp /pfs/*/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/*"),
+		"", // default output branch: master
+		false,
+	))
+
+	// alice commits to the input repos, and the pipeline runs successfully
+	err := aliceClient.PutFile(repo, "master", "/file1", strings.NewReader("test"))
+	require.NoError(t, err)
+	commitIter, err := aliceClient.FlushCommit(
+		[]*pfs.Commit{client.NewCommit(repo, "master")},
+		[]*pfs.Repo{client.NewRepo(pipeline)},
+	)
+	require.NoError(t, err)
+	require.NoErrorWithinT(t, 60*time.Second, func() error {
+		_, err := commitIter.Next()
+		return err
+	})
+
+	// bob cannot call GetLogs
+	iter := bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
+	require.False(t, iter.Next())
+	require.YesError(t, iter.Err())
+	require.True(t, auth.IsErrNotAuthorized(iter.Err()), iter.Err().Error())
+
+	// bob also can't call GetLogs for the master process
+	iter = bobClient.GetLogs(pipeline, "", nil, "", true, false, 0)
+	require.False(t, iter.Next())
+	require.YesError(t, iter.Err())
+	require.True(t, auth.IsErrNotAuthorized(iter.Err()), iter.Err().Error())
+
+	// alice adds bob to the input repo, but bob still can't call GetLogs
+	aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Username: bob,
+		Scope:    auth.Scope_READER,
+		Repo:     repo,
+	})
+	iter = bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
+	require.False(t, iter.Next())
+	require.YesError(t, iter.Err())
+	require.True(t, auth.IsErrNotAuthorized(iter.Err()), iter.Err().Error())
+
+	// alice removes bob from the input repo and adds bob to the output repo, but
+	// bob still can't call GetLogs
+	aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Username: bob,
+		Scope:    auth.Scope_NONE,
+		Repo:     repo,
+	})
+	aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Username: bob,
+		Scope:    auth.Scope_READER,
+		Repo:     pipeline,
+	})
+	iter = bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
+	require.False(t, iter.Next())
+	require.YesError(t, iter.Err())
+	require.True(t, auth.IsErrNotAuthorized(iter.Err()), iter.Err().Error())
+
+	// alice adds bob to the output repo, and now bob can call GetLogs
+	aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Username: bob,
+		Scope:    auth.Scope_READER,
+		Repo:     repo,
+	})
+	iter = bobClient.GetLogs(pipeline, "", nil, "", false, false, 0)
+	iter.Next()
+	require.NoError(t, iter.Err())
+
+	// bob can also call GetLogs for the master process
+	iter = bobClient.GetLogs(pipeline, "", nil, "", true, false, 0)
+	iter.Next()
+	require.NoError(t, iter.Err())
+}
+
+// TODO: Make logs from the meta commit work with V2.
 //// TestGetLogsFromStats tests that GetLogs still works even when stats are
 //// enabled
 //func TestGetLogsFromStats(t *testing.T) {
@@ -2743,9 +2743,7 @@ func TestOTPTimeoutShorterThanSessionTimeout(t *testing.T) {
 	require.True(t, auth.IsErrBadToken(err), err.Error())
 }
 
-// TODO: Make work with V2.
 func TestS3GatewayAuthRequests(t *testing.T) {
-	t.Skip("S3 gateway not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	gotls "crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"runtime/debug"
 	"runtime/pprof"
 
+	"github.com/pachyderm/pachyderm/src/client"
 	adminclient "github.com/pachyderm/pachyderm/src/client/admin"
 	authclient "github.com/pachyderm/pachyderm/src/client/auth"
 	debugclient "github.com/pachyderm/pachyderm/src/client/debug"
@@ -18,6 +20,7 @@ import (
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tls"
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	transactionclient "github.com/pachyderm/pachyderm/src/client/transaction"
@@ -28,7 +31,9 @@ import (
 	debugserver "github.com/pachyderm/pachyderm/src/server/debug/server"
 	eprsserver "github.com/pachyderm/pachyderm/src/server/enterprise/server"
 	"github.com/pachyderm/pachyderm/src/server/health"
+	pach_http "github.com/pachyderm/pachyderm/src/server/http"
 	identity_server "github.com/pachyderm/pachyderm/src/server/identity/server"
+	"github.com/pachyderm/pachyderm/src/server/pfs/s3"
 	pfs_server "github.com/pachyderm/pachyderm/src/server/pfs/server"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
@@ -37,6 +42,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/netutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	pps_server "github.com/pachyderm/pachyderm/src/server/pps/server"
@@ -54,7 +60,7 @@ var mode string
 var readiness bool
 
 func init() {
-	flag.StringVar(&mode, "mode", "full", "Pachd currently supports two modes: full and sidecar.  The former includes everything you need in a full pachd node.  The latter runs only PFS, the Auth service, and a stripped-down version of PPS.")
+	flag.StringVar(&mode, "mode", "full", "Pachd currently supports three modes: full, sidecar and reencrypt.  The first includes everything you need in a full pachd node.  The second runs only PFS, the Auth service, and a stripped-down version of PPS.  The last re-encrypts all stored chunks with the current storage encryption key and exits; it should only be run while no other pachd is running.")
 	flag.BoolVar(&readiness, "readiness", false, "Run readiness check.")
 	flag.Parse()
 }
@@ -69,6 +75,8 @@ func main() {
 		cmdutil.Main(doFullMode, &serviceenv.PachdFullConfiguration{})
 	case mode == "sidecar":
 		cmdutil.Main(doSidecarMode, &serviceenv.PachdFullConfiguration{})
+	case mode == "reencrypt":
+		cmdutil.Main(doReEncryptMode, &serviceenv.PachdFullConfiguration{})
 	default:
 		fmt.Printf("unrecognized mode: %s\n", mode)
 	}
@@ -79,6 +87,31 @@ func doReadinessCheck(config interface{}) error {
 	return env.GetPachClient(context.Background()).Health()
 }
 
+// doReEncryptMode re-encrypts the chunks in object storage that are encrypted
+// with an older key, so that the older key can be removed from the storage
+// encryption secret.
+func doReEncryptMode(config interface{}) error {
+	env := serviceenv.NewConfiguration(config)
+	objClient, err := pfs_server.NewObjClient(env)
+	if err != nil {
+		return err
+	}
+	keyring, err := env.StorageEncryptionKeyring()
+	if err != nil {
+		return err
+	}
+	var count int
+	if err := chunk.ReEncrypt(context.Background(), objClient, keyring, func(p string) error {
+		count++
+		log.Debugf("re-encrypted %v", p)
+		return nil
+	}); err != nil {
+		return err
+	}
+	log.Infof("re-encrypted %d chunks with key %q", count, keyring.CurrentID())
+	return nil
+}
+
 func doSidecarMode(config interface{}) (retErr error) {
 	defer func() {
 		if retErr != nil {
@@ -584,58 +617,52 @@ func doFullMode(config interface{}) (retErr error) {
 	go waitForError("Internal Pachd GRPC Server", errChan, true, func() error {
 		return internalServer.Wait()
 	})
-	// TODO: Make http server work with V2.
-	//go waitForError("HTTP Server", errChan, requireNoncriticalServers, func() error {
-	//	httpServer, err := pach_http.NewHTTPServer(address)
-	//	if err != nil {
-	//		return err
-	//	}
-	//	server := http.Server{
-	//		Addr:    fmt.Sprintf(":%v", env.HTTPPort),
-	//		Handler: httpServer,
-	//	}
-
-	//	certPath, keyPath, err := tls.GetCertPaths()
-	//	if err != nil {
-	//		log.Warnf("pfs-over-HTTP - TLS disabled: %v", err)
-	//		return server.ListenAndServe()
-	//	}
+	go waitForError("HTTP Server", errChan, requireNoncriticalServers, func() error {
+		httpServer, err := pach_http.NewHTTPServer(address)
+		if err != nil {
+			return err
+		}
+		server := http.Server{
+			Addr:    fmt.Sprintf(":%v", env.HTTPPort),
+			Handler: httpServer,
+		}
+		certPath, keyPath, err := tls.GetCertPaths()
+		if err != nil {
+			log.Warnf("pfs-over-HTTP - TLS disabled: %v", err)
+			return server.ListenAndServe()
+		}
 
-	//	cLoader := tls.NewCertLoader(certPath, keyPath, tls.CertCheckFrequency)
-	//	err = cLoader.LoadAndStart()
-	//	if err != nil {
-	//		return errors.Wrapf(err, "couldn't load TLS cert for pfs-over-http: %v", err)
-	//	}
+		cLoader := tls.NewCertLoader(certPath, keyPath, tls.CertCheckFrequency)
+		err = cLoader.LoadAndStart()
+		if err != nil {
+			return errors.Wrapf(err, "couldn't load TLS cert for pfs-over-http: %v", err)
+		}
 
-	//	server.TLSConfig = &gotls.Config{GetCertificate: cLoader.GetCertificate}
+		server.TLSConfig = &gotls.Config{GetCertificate: cLoader.GetCertificate}
 
-	//	return server.ListenAndServeTLS(certPath, keyPath)
-	//})
+		return server.ListenAndServeTLS(certPath, keyPath)
+	})
 	go waitForError("Githook Server", errChan, requireNoncriticalServers, func() error {
 		return githook.RunGitHookServer(address, etcdAddress, path.Join(env.EtcdPrefix, env.PPSEtcdPrefix))
 	})
-	// TODO: Make s3 server work with V2.
-	//go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
-	//	server, err := s3.Server(env.S3GatewayPort, s3.NewMasterDriver(), func() (*client.APIClient, error) {
-	//		return client.NewFromAddress(fmt.Sprintf("localhost:%d", env.PeerPort))
-	//	})
-	//	if err != nil {
-	//		return err
-	//	}
-	//	certPath, keyPath, err := tls.GetCertPaths()
-	//	if err != nil {
-	//		log.Warnf("s3gateway TLS disabled: %v", err)
-	//		return server.ListenAndServe()
-	//	}
-	//	cLoader := tls.NewCertLoader(certPath, keyPath, tls.CertCheckFrequency)
-	//	// Read TLS cert and key
-	//	err = cLoader.LoadAndStart()
-	//	if err != nil {
-	//		return errors.Wrapf(err, "couldn't load TLS cert for s3gateway: %v", err)
-	//	}
-	//	server.TLSConfig = &gotls.Config{GetCertificate: cLoader.GetCertificate}
-	//	return server.ListenAndServeTLS(certPath, keyPath)
-	//})
+	go waitForError("S3 Server", errChan, requireNoncriticalServers, func() error {
+		server := s3.Server(env.S3GatewayPort, func() (*client.APIClient, error) {
+			return client.NewFromAddress(fmt.Sprintf("localhost:%d", env.PeerPort))
+		})
+		certPath, keyPath, err := tls.GetCertPaths()
+		if err != nil {
+			log.Warnf("s3gateway TLS disabled: %v", err)
+			return server.ListenAndServe()
+		}
+		cLoader := tls.NewCertLoader(certPath, keyPath, tls.CertCheckFrequency)
+		// Read TLS cert and key
+		err = cLoader.LoadAndStart()
+		if err != nil {
+			return errors.Wrapf(err, "couldn't load TLS cert for s3gateway: %v", err)
+		}
+		server.TLSConfig = &gotls.Config{GetCertificate: cLoader.GetCertificate}
+		return server.ListenAndServeTLS(certPath, keyPath)
+	})
 	go waitForError("Prometheus Server", errChan, requireNoncriticalServers, func() error {
 		http.Handle("/metrics", promhttp.Handler())
 		return http.ListenAndServe(fmt.Sprintf(":%v", assets.PrometheusPort), nil)
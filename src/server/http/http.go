@@ -1,144 +1,276 @@
 package http
 
-// TODO: Make http server work with V2.
-//// HTTPPort specifies the port the server will listen on
-//const apiVersion = "v1"
-//
-//func versionPath(p string) string {
-//	return path.Join("/", apiVersion, p)
-//}
-//
-//var (
-//	getFilePath = versionPath("pfs/repos/:repoName/commits/:commitID/files/*filePath")
-//	servicePath = versionPath("pps/services/:serviceName/*path")
-//	loginPath   = versionPath("auth/login")
-//	logoutPath  = versionPath("auth/logout")
-//)
-//
-//type router = *httprouter.Router
-//
-//type server struct {
-//	router
-//	address        string
-//	pachClient     *client.APIClient
-//	pachClientOnce sync.Once
-//	httpClient     *http.Client
-//}
-//
-//// NewHTTPServer returns a Pachyderm HTTP server.
-//func NewHTTPServer(address string) (http.Handler, error) {
-//	router := httprouter.New()
-//	s := &server{
-//		router:     router,
-//		address:    address,
-//		httpClient: &http.Client{},
-//	}
-//
-//	router.GET(getFilePath, s.getFileHandler)
-//	router.GET(servicePath, s.serviceHandler)
-//
-//	router.POST(loginPath, s.authLoginHandler)
-//	router.POST(logoutPath, s.authLogoutHandler)
-//	router.POST(servicePath, s.serviceHandler)
-//
-//	router.NotFound = http.HandlerFunc(notFound)
-//	return s, nil
-//}
-//
-//func (s *server) getFileHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-//	filePaths := strings.Split(ps.ByName("filePath"), "/")
-//	fileName := filePaths[len(filePaths)-1]
-//	ctx := context.Background()
-//	for _, cookie := range r.Cookies() {
-//		if cookie.Name == auth.ContextTokenKey {
-//			ctx = metadata.NewIncomingContext(
-//				ctx,
-//				metadata.Pairs(auth.ContextTokenKey, cookie.Value),
-//			)
-//		}
-//	}
-//	downloadValues := r.URL.Query()["download"]
-//	if len(downloadValues) == 1 && downloadValues[0] == "true" {
-//		w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=\"%v\"", fileName))
-//	}
-//	c := s.getPachClient().WithCtx(ctx)
-//	commitInfo, err := c.InspectCommit(ps.ByName("repoName"), ps.ByName("commitID"))
-//	if err != nil {
-//		httpError(w, err)
-//		return
-//	}
-//	content, err := c.GetFileReadSeeker(ps.ByName("repoName"), ps.ByName("commitID"), ps.ByName("filePath"))
-//	if err != nil {
-//		httpError(w, err)
-//		return
-//	}
-//	modtime, err := types.TimestampFromProto(commitInfo.Finished)
-//	if err != nil {
-//		httpError(w, err)
-//		return
-//	}
-//	http.ServeContent(w, r, fileName, modtime, content)
-//}
-//
-//func (s *server) serviceHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-//	c := s.getPachClient()
-//	serviceName := ps.ByName("serviceName")
-//	pipelineInfo, err := c.InspectPipeline(serviceName)
-//	if err != nil {
-//		httpError(w, err)
-//		return
-//	}
-//	URL, err := url.Parse(fmt.Sprintf("http://%s:%d", pipelineInfo.Service.IP, pipelineInfo.Service.ExternalPort))
-//	if err != nil {
-//		http.Error(w, err.Error(), http.StatusInternalServerError)
-//		return
-//	}
-//	proxy := httputil.NewSingleHostReverseProxy(URL)
-//	director := proxy.Director
-//	proxy.Director = func(req *http.Request) {
-//		director(req)
-//		req.URL.Path = strings.TrimPrefix(req.URL.Path, path.Join(path.Dir(path.Dir(servicePath)), serviceName))
-//	}
-//	proxy.ServeHTTP(w, r)
-//}
-//
-//func (s *server) authLoginHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-//	token := r.FormValue("Token")
-//	if token == "" {
-//		http.Error(w, "empty token provided", http.StatusInternalServerError)
-//		return
-//	}
-//	w.Header().Add("Set-Cookie", fmt.Sprintf("%v=%v;path=/", auth.ContextTokenKey,
-//		token))
-//	w.Header().Add("Access-Control-Allow-Origin", "*")
-//	w.WriteHeader(http.StatusOK)
-//}
-//
-//func (s *server) authLogoutHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-//	w.Header().Add("Set-Cookie", fmt.Sprintf("%v=;path=/", auth.ContextTokenKey))
-//	w.Header().Add("Access-Control-Allow-Origin", "*")
-//	w.WriteHeader(http.StatusOK)
-//}
-//
-//func notFound(w http.ResponseWriter, r *http.Request) {
-//	http.Error(w, "route not found", http.StatusNotFound)
-//}
-//
-//func httpError(w http.ResponseWriter, err error) {
-//	if errutil.IsNotFoundError(err) {
-//		http.Error(w, err.Error(), http.StatusNotFound)
-//	} else {
-//		http.Error(w, err.Error(), http.StatusInternalServerError)
-//	}
-//}
-//
-//func (s *server) getPachClient() *client.APIClient {
-//	s.pachClientOnce.Do(func() {
-//		var err error
-//		s.pachClient, err = client.NewFromAddress(s.address)
-//		if err != nil {
-//			panic(fmt.Sprintf("http server failed to initialize pach client: %v", err))
-//		}
-//	})
-//	return s.pachClient
-//}
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/gorilla/mux"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+const apiVersion = "v1"
+
+func versionPath(p string) string {
+	return path.Join("/", apiVersion, p)
+}
+
+var (
+	getFilePath = versionPath("pfs/repos/{repoName}/commits/{commitID}/files/{filePath:.*}")
+	servicePath = versionPath("pps/services/{serviceName}/{path:.*}")
+	loginPath   = versionPath("auth/login")
+	logoutPath  = versionPath("auth/logout")
+)
+
+type server struct {
+	*mux.Router
+	address        string
+	pachClient     *client.APIClient
+	pachClientErr  error
+	pachClientOnce sync.Once
+}
+
+// NewHTTPServer returns a Pachyderm HTTP server.
+func NewHTTPServer(address string) (http.Handler, error) {
+	router := mux.NewRouter()
+	s := &server{
+		Router:  router,
+		address: address,
+	}
+
+	router.HandleFunc(getFilePath, s.getFileHandler).Methods(http.MethodGet, http.MethodHead)
+	router.HandleFunc(servicePath, s.serviceHandler).Methods(http.MethodGet, http.MethodPost)
+
+	router.HandleFunc(loginPath, s.authLoginHandler).Methods(http.MethodPost)
+	router.HandleFunc(logoutPath, s.authLogoutHandler).Methods(http.MethodPost)
+
+	router.NotFoundHandler = http.HandlerFunc(notFound)
+	return s, nil
+}
+
+func (s *server) getFileHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	repo, commit, filePath := vars["repoName"], vars["commitID"], "/"+vars["filePath"]
+	c, err := s.getPachClient(r)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	fileInfo, err := c.InspectFile(repo, commit, filePath)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	if fileInfo.FileType == pfs.FileType_DIR {
+		listDir(w, c, repo, commit, filePath)
+		return
+	}
+	fileName := path.Base(filePath)
+	if r.URL.Query().Get("download") == "true" {
+		w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=\"%v\"", fileName))
+	}
+	modtime, err := types.TimestampFromProto(fileInfo.Committed)
+	if err != nil {
+		modtime = time.Time{}
+	}
+	content := newFileReadSeeker(c, repo, commit, filePath, int64(fileInfo.SizeBytes))
+	defer content.Close()
+	// ServeContent detects the content type from the file name (or the first
+	// bytes of the content) and handles range requests.
+	http.ServeContent(w, r, fileName, modtime, content)
+}
+
+// listDir writes the file infos of the children of a directory as a JSON
+// array.
+func listDir(w http.ResponseWriter, c *client.APIClient, repo, commit, dir string) {
+	var fileInfos []json.RawMessage
+	marshaler := &jsonpb.Marshaler{}
+	if err := c.ListFile(repo, commit, dir, func(fi *pfs.FileInfo) error {
+		data, err := marshaler.MarshalToString(fi)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		fileInfos = append(fileInfos, json.RawMessage(data))
+		return nil
+	}); err != nil {
+		httpError(w, err)
+		return
+	}
+	if fileInfos == nil {
+		fileInfos = []json.RawMessage{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(fileInfos); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *server) serviceHandler(w http.ResponseWriter, r *http.Request) {
+	c, err := s.getPachClient(r)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	serviceName := mux.Vars(r)["serviceName"]
+	pipelineInfo, err := c.InspectPipeline(serviceName)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	if pipelineInfo.Service == nil {
+		http.Error(w, fmt.Sprintf("pipeline %v is not a service", serviceName), http.StatusNotFound)
+		return
+	}
+	URL, err := url.Parse(fmt.Sprintf("http://%s:%d", pipelineInfo.Service.IP, pipelineInfo.Service.ExternalPort))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	proxy := httputil.NewSingleHostReverseProxy(URL)
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.URL.Path = strings.TrimPrefix(req.URL.Path, path.Join(versionPath("pps/services"), serviceName))
+	}
+	proxy.ServeHTTP(w, r)
+}
+
+func (s *server) authLoginHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.FormValue("Token")
+	if token == "" {
+		http.Error(w, "empty token provided", http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, authCookie(r, token, 0))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *server) authLogoutHandler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, authCookie(r, "", -1))
+	w.WriteHeader(http.StatusOK)
+}
+
+// authCookie returns the cookie that holds the auth token. The cookie can't be
+// read by scripts, is only sent cross-site when following links (so that
+// links to files work), and is only sent over TLS if the request was.
+func authCookie(r *http.Request, token string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     auth.ContextTokenKey,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func notFound(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, "route not found", http.StatusNotFound)
+}
+
+func httpError(w http.ResponseWriter, err error) {
+	switch {
+	case auth.IsErrNotSignedIn(err), auth.IsErrBadToken(err), auth.IsErrExpiredToken(err):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case auth.IsErrNotAuthorized(err):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errutil.IsNotFoundError(err):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// getPachClient returns a client for the request, authenticated with the
+// token in the request's auth cookie (if any).
+func (s *server) getPachClient(r *http.Request) (*client.APIClient, error) {
+	s.pachClientOnce.Do(func() {
+		s.pachClient, s.pachClientErr = client.NewFromAddress(s.address)
+	})
+	if s.pachClientErr != nil {
+		return nil, errors.Wrapf(s.pachClientErr, "http server failed to initialize pach client")
+	}
+	c := s.pachClient.WithCtx(r.Context())
+	if cookie, err := r.Cookie(auth.ContextTokenKey); err == nil {
+		c.SetAuthToken(cookie.Value)
+	}
+	return c, nil
+}
+
+// fileReadSeeker is an io.ReadSeeker over a file in PFS. Reads are streamed
+// from the current offset, and seeking to a different offset starts a new
+// stream from that offset, which is enough for the access pattern of
+// http.ServeContent.
+type fileReadSeeker struct {
+	c                  *client.APIClient
+	repo, commit, path string
+	size, offset       int64
+	r                  *io.PipeReader
+}
+
+func newFileReadSeeker(c *client.APIClient, repo, commit, path string, size int64) *fileReadSeeker {
+	return &fileReadSeeker{
+		c:      c,
+		repo:   repo,
+		commit: commit,
+		path:   path,
+		size:   size,
+	}
+}
+
+func (f *fileReadSeeker) Read(data []byte) (int, error) {
+	if f.offset >= f.size {
+		return 0, io.EOF
+	}
+	if f.r == nil {
+		pr, pw := io.Pipe()
+		go func(offset int64) {
+			pw.CloseWithError(f.c.GetFile(f.repo, f.commit, f.path, pw, client.WithOffset(offset)))
+		}(f.offset)
+		f.r = pr
+	}
+	n, err := f.r.Read(data)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *fileReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.size
+	}
+	if offset < 0 {
+		return 0, errors.Errorf("invalid offset %v", offset)
+	}
+	if offset != f.offset {
+		f.Close()
+		f.offset = offset
+	}
+	return offset, nil
+}
+
+// Close stops the current stream, if there is one.
+func (f *fileReadSeeker) Close() error {
+	if f.r != nil {
+		// Closing the reader unblocks the goroutine writing to the pipe.
+		f.r.Close()
+		f.r = nil
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package http
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
+)
+
+func withServer(t *testing.T, cb func(*client.APIClient, *httptest.Server)) {
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		h, err := NewHTTPServer("")
+		require.NoError(t, err)
+		// Use the test environment's client rather than dialing an address.
+		s := h.(*server)
+		s.pachClientOnce.Do(func() {
+			s.pachClient = env.PachClient
+		})
+		ts := httptest.NewServer(s)
+		defer ts.Close()
+		cb(env.PachClient, ts)
+		return nil
+	}))
+}
+
+func TestAuthCookie(t *testing.T) {
+	h, err := NewHTTPServer("")
+	require.NoError(t, err)
+
+	// Login sets the token in a cookie that scripts can't read.
+	form := url.Values{}
+	form.Add("Token", "token")
+	req := httptest.NewRequest(http.MethodPost, loginPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "", resp.Header.Get("Access-Control-Allow-Origin"))
+	require.Equal(t, 1, len(resp.Cookies()))
+	cookie := resp.Cookies()[0]
+	require.Equal(t, auth.ContextTokenKey, cookie.Name)
+	require.Equal(t, "token", cookie.Value)
+	require.True(t, cookie.HttpOnly)
+	require.Equal(t, http.SameSiteLaxMode, cookie.SameSite)
+	require.False(t, cookie.Secure)
+
+	// The cookie is only sent over TLS if the login was.
+	req = httptest.NewRequest(http.MethodPost, "https://pachd"+loginPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, 1, len(w.Result().Cookies()))
+	require.True(t, w.Result().Cookies()[0].Secure)
+
+	// Logging in without a token fails.
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, loginPath, nil))
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	require.Equal(t, 0, len(w.Result().Cookies()))
+
+	// Logout unsets the cookie.
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, logoutPath, nil))
+	resp = w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 1, len(resp.Cookies()))
+	cookie = resp.Cookies()[0]
+	require.Equal(t, auth.ContextTokenKey, cookie.Name)
+	require.Equal(t, "", cookie.Value)
+	require.True(t, cookie.MaxAge < 0)
+	require.True(t, cookie.HttpOnly)
+
+	// Unknown routes are not found.
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, versionPath("auth/logoutzz"), nil))
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestGetFile(t *testing.T) {
+	t.Parallel()
+	withServer(t, func(c *client.APIClient, ts *httptest.Server) {
+		repo := tu.UniqueString("TestGetFile")
+		require.NoError(t, c.CreateRepo(repo))
+		require.NoError(t, c.PutFile(repo, "master", "file", strings.NewReader("content")))
+		fileURL := ts.URL + versionPath("pfs/repos/"+repo+"/commits/master/files/file")
+
+		get := func(rangeHeader string) (*http.Response, string) {
+			req, err := http.NewRequest(http.MethodGet, fileURL, nil)
+			require.NoError(t, err)
+			if rangeHeader != "" {
+				req.Header.Set("Range", rangeHeader)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return resp, string(data)
+		}
+		resp, data := get("")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "content", data)
+		resp, data = get("bytes=1-3")
+		require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+		require.Equal(t, "ont", data)
+		resp, data = get("bytes=-3")
+		require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+		require.Equal(t, "ent", data)
+
+		resp, err := http.Get(ts.URL + versionPath("pfs/repos/"+repo+"/commits/master/files/missing"))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestFileReadSeeker(t *testing.T) {
+	t.Parallel()
+	withServer(t, func(c *client.APIClient, _ *httptest.Server) {
+		repo := tu.UniqueString("TestFileReadSeeker")
+		require.NoError(t, c.CreateRepo(repo))
+		require.NoError(t, c.PutFile(repo, "master", "file", strings.NewReader("0123456789")))
+		f := newFileReadSeeker(c, repo, "master", "/file", 10)
+		defer f.Close()
+
+		buf := make([]byte, 3)
+		_, err := io.ReadFull(f, buf)
+		require.NoError(t, err)
+		require.Equal(t, "012", string(buf))
+		// Seeking starts a new stream at the offset.
+		offset, err := f.Seek(5, io.SeekStart)
+		require.NoError(t, err)
+		require.Equal(t, int64(5), offset)
+		data, err := ioutil.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, "56789", string(data))
+		offset, err = f.Seek(-2, io.SeekEnd)
+		require.NoError(t, err)
+		require.Equal(t, int64(8), offset)
+		data, err = ioutil.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, "89", string(data))
+		_, err = f.Seek(-11, io.SeekEnd)
+		require.YesError(t, err)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -12,6 +14,7 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"reflect"
@@ -38,6 +41,7 @@ import (
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	ppspretty "github.com/pachyderm/pachyderm/src/server/pps/pretty"
+	"github.com/pachyderm/pachyderm/src/server/pps/server/githook"
 
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
@@ -1314,8 +1318,6 @@ func TestPipelineErrorHandling(t *testing.T) {
 }
 
 func TestEgressFailure(t *testing.T) {
-	// TODO: Implement egress.
-	t.Skip("Egress not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -1364,8 +1366,6 @@ func TestEgressFailure(t *testing.T) {
 }
 
 func TestLazyPipelinePropagation(t *testing.T) {
-	// TODO: Implement lazy files.
-	t.Skip("Lazy files not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -1424,8 +1424,6 @@ func TestLazyPipelinePropagation(t *testing.T) {
 }
 
 func TestLazyPipeline(t *testing.T) {
-	// TODO: Implement lazy files.
-	t.Skip("Lazy files not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -1476,8 +1474,6 @@ func TestLazyPipeline(t *testing.T) {
 }
 
 func TestEmptyFiles(t *testing.T) {
-	// TODO: Implement empty files.
-	t.Skip("Empty files not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -1534,8 +1530,6 @@ func TestEmptyFiles(t *testing.T) {
 // We've updated the code such that we are able to detect if the files we
 // are uploading are pipes, and make the job fail in that case.
 func TestLazyPipelineCPPipes(t *testing.T) {
-	// TODO: Implement lazy files.
-	t.Skip("Lazy files not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -3573,98 +3567,76 @@ func TestPipelineWithExistingInputCommits(t *testing.T) {
 	require.Equal(t, 1, len(commitInfos))
 }
 
-// TODO: Implement symlinks.
-//func TestPipelineThatSymlinks(t *testing.T) {
-//	t.Skip("Symlinks not implemented in V2")
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	// create repos
-//	dataRepo := tu.UniqueString("TestPipeline_data")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//
-//	// create pipeline
-//	pipelineName := tu.UniqueString("pipeline")
-//	require.NoError(t, c.CreatePipeline(
-//		pipelineName,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			// Symlinks to input files
-//			fmt.Sprintf("ln -s /pfs/%s/foo /pfs/out/foo", dataRepo),
-//			fmt.Sprintf("ln -s /pfs/%s/dir1/bar /pfs/out/bar", dataRepo),
-//			"mkdir /pfs/out/dir",
-//			fmt.Sprintf("ln -s /pfs/%s/dir2 /pfs/out/dir/dir2", dataRepo),
-//			// Symlinks to external files
-//			"echo buzz > /tmp/buzz",
-//			"ln -s /tmp/buzz /pfs/out/buzz",
-//		},
-//		&pps.ParallelismSpec{
-//			Constant: 1,
-//		},
-//		client.NewPFSInput(dataRepo, "/"),
-//		"",
-//		false,
-//	))
-//
-//	// Do first commit to repo
-//	commit, err := c.StartCommit(dataRepo, "master")
-//	require.NoError(t, err)
-//	require.NoError(t, c.PutFile(dataRepo, commit.ID, "foo", strings.NewReader("foo")))
-//	require.NoError(t, c.PutFile(dataRepo, commit.ID, "dir1/bar", strings.NewReader("bar")))
-//	require.NoError(t, c.PutFile(dataRepo, commit.ID, "dir2/foo", strings.NewReader("foo")))
-//	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
-//
-//	commitInfoIter, err := c.FlushCommit([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
-//	require.NoError(t, err)
-//	commitInfos := collectCommitInfos(t, commitInfoIter)
-//	require.Equal(t, 1, len(commitInfos))
-//
-//	// Check that the output files are identical to the input files.
-//	buffer := bytes.Buffer{}
-//	require.NoError(t, c.GetFile(commitInfos[0].Commit.Repo.Name, commitInfos[0].Commit.ID, "foo", &buffer))
-//	require.Equal(t, "foo", buffer.String())
-//	buffer.Reset()
-//	require.NoError(t, c.GetFile(commitInfos[0].Commit.Repo.Name, commitInfos[0].Commit.ID, "bar", &buffer))
-//	require.Equal(t, "bar", buffer.String())
-//	buffer.Reset()
-//	require.NoError(t, c.GetFile(commitInfos[0].Commit.Repo.Name, commitInfos[0].Commit.ID, "dir/dir2/foo", &buffer))
-//	require.Equal(t, "foo", buffer.String())
-//	buffer.Reset()
-//	require.NoError(t, c.GetFile(commitInfos[0].Commit.Repo.Name, commitInfos[0].Commit.ID, "buzz", &buffer))
-//	require.Equal(t, "buzz\n", buffer.String())
-//
-//	// Make sure that we skipped the upload by checking that the input file
-//	// and the output file have the same object refs.
-//	inputFooFileInfo, err := c.InspectFile(dataRepo, commit.ID, "foo")
-//	require.NoError(t, err)
-//	outputFooFileInfo, err := c.InspectFile(pipelineName, commitInfos[0].Commit.ID, "foo")
-//	require.NoError(t, err)
-//	for i, object := range inputFooFileInfo.Objects {
-//		info, err := c.InspectObject(object.Hash)
-//		require.NoError(t, err)
-//		require.Equal(t, info.BlockRef, outputFooFileInfo.BlockRefs[i])
-//	}
-//	inputFooFileInfo, err = c.InspectFile(dataRepo, commit.ID, "dir1/bar")
-//	require.NoError(t, err)
-//	outputFooFileInfo, err = c.InspectFile(pipelineName, commitInfos[0].Commit.ID, "bar")
-//	require.NoError(t, err)
-//	for i, object := range inputFooFileInfo.Objects {
-//		info, err := c.InspectObject(object.Hash)
-//		require.NoError(t, err)
-//		require.Equal(t, info.BlockRef, outputFooFileInfo.BlockRefs[i])
-//	}
-//	inputFooFileInfo, err = c.InspectFile(dataRepo, commit.ID, "dir2/foo")
-//	require.NoError(t, err)
-//	outputFooFileInfo, err = c.InspectFile(pipelineName, commitInfos[0].Commit.ID, "dir/dir2/foo")
-//	require.NoError(t, err)
-//	for i, object := range inputFooFileInfo.Objects {
-//		info, err := c.InspectObject(object.Hash)
-//		require.NoError(t, err)
-//		require.Equal(t, info.BlockRef, outputFooFileInfo.BlockRefs[i])
-//	}
-//}
+func TestPipelineThatSymlinks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	// create repos
+	dataRepo := tu.UniqueString("TestPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	// create pipeline
+	pipelineName := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"bash"},
+		[]string{
+			// Symlinks to input files
+			fmt.Sprintf("ln -s /pfs/%s/foo /pfs/out/foo", dataRepo),
+			fmt.Sprintf("ln -s /pfs/%s/dir1/bar /pfs/out/bar", dataRepo),
+			"mkdir /pfs/out/dir",
+			fmt.Sprintf("ln -s /pfs/%s/dir2 /pfs/out/dir/dir2", dataRepo),
+			// Symlinks to external files
+			"echo buzz > /tmp/buzz",
+			"ln -s /tmp/buzz /pfs/out/buzz",
+		},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewPFSInput(dataRepo, "/"),
+		"",
+		false,
+	))
+
+	// Do first commit to repo
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(dataRepo, commit.ID, "foo", strings.NewReader("foo")))
+	require.NoError(t, c.PutFile(dataRepo, commit.ID, "dir1/bar", strings.NewReader("bar")))
+	require.NoError(t, c.PutFile(dataRepo, commit.ID, "dir2/foo", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	commitInfoIter, err := c.FlushCommit([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitInfoIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	// Check that the output files are identical to the input files.
+	buffer := bytes.Buffer{}
+	require.NoError(t, c.GetFile(commitInfos[0].Commit.Repo.Name, commitInfos[0].Commit.ID, "foo", &buffer))
+	require.Equal(t, "foo", buffer.String())
+	buffer.Reset()
+	require.NoError(t, c.GetFile(commitInfos[0].Commit.Repo.Name, commitInfos[0].Commit.ID, "bar", &buffer))
+	require.Equal(t, "bar", buffer.String())
+	buffer.Reset()
+	require.NoError(t, c.GetFile(commitInfos[0].Commit.Repo.Name, commitInfos[0].Commit.ID, "dir/dir2/foo", &buffer))
+	require.Equal(t, "foo", buffer.String())
+	buffer.Reset()
+	require.NoError(t, c.GetFile(commitInfos[0].Commit.Repo.Name, commitInfos[0].Commit.ID, "buzz", &buffer))
+	require.Equal(t, "buzz\n", buffer.String())
+
+	// Make sure that we skipped the upload of the symlinked input files, only
+	// the external file should have been uploaded.
+	jobInfos, err := c.ListJob(pipelineName, nil, nil, -1, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, uint64(len("buzz\n")), jobInfos[0].Stats.UploadBytes)
+}
 
 // TestChainedPipelines tracks https://github.com/pachyderm/pachyderm/issues/797
 func TestChainedPipelines(t *testing.T) {
@@ -3956,362 +3928,355 @@ func TestStopJob(t *testing.T) {
 	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
 }
 
-// TODO: Implement logs.
-//func TestGetLogs(t *testing.T) {
-//	testGetLogs(t, false)
-//}
-//
-//func TestGetLogsWithStats(t *testing.T) {
-//	testGetLogs(t, true)
-//}
-//
-//func testGetLogs(t *testing.T, enableStats bool) {
-//	t.Skip("Logs not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//	iter := c.GetLogs("", "", nil, "", false, false, 0)
-//	for iter.Next() {
-//	}
-//	require.NoError(t, iter.Err())
-//	// create repos
-//	dataRepo := tu.UniqueString("data")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//	// create pipeline
-//	pipelineName := tu.UniqueString("pipeline")
-//	_, err := c.PpsAPIClient.CreatePipeline(context.Background(),
-//		&pps.CreatePipelineRequest{
-//			Pipeline: client.NewPipeline(pipelineName),
-//			Transform: &pps.Transform{
-//				Cmd: []string{"sh"},
-//				Stdin: []string{
-//					fmt.Sprintf("cp /pfs/%s/file /pfs/out/file", dataRepo),
-//					"echo foo",
-//					"echo %s", // %s tests a formatting bug we had (#2729)
-//				},
-//			},
-//			Input:       client.NewPFSInput(dataRepo, "/*"),
-//			EnableStats: enableStats,
-//			ParallelismSpec: &pps.ParallelismSpec{
-//				Constant: 4,
-//			},
-//		})
-//	require.NoError(t, err)
-//
-//	// Commit data to repo and flush commit
-//	commit, err := c.StartCommit(dataRepo, "master")
-//	require.NoError(t, err)
-//	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo\n")))
-//	require.NoError(t, c.FinishCommit(dataRepo, "master"))
-//	_, err = c.FlushJobAll([]*pfs.Commit{commit}, nil)
-//	require.NoError(t, err)
-//
-//	// Get logs from pipeline, using a pipeline that doesn't exist. There should
-//	// be an error
-//	iter = c.GetLogs("__DOES_NOT_EXIST__", "", nil, "", false, false, 0)
-//	require.False(t, iter.Next())
-//	require.YesError(t, iter.Err())
-//	require.Matches(t, "could not get", iter.Err().Error())
-//
-//	// Get logs from pipeline, using a job that doesn't exist. There should
-//	// be an error
-//	iter = c.GetLogs("", "__DOES_NOT_EXIST__", nil, "", false, false, 0)
-//	require.False(t, iter.Next())
-//	require.YesError(t, iter.Err())
-//	require.Matches(t, "could not get", iter.Err().Error())
-//
-//	// This is put in a backoff because there's the possibility that pod was
-//	// evicted from k8s and is being re-initialized, in which case `GetLogs`
-//	// will appropriately fail. With the loki logging backend enabled the
-//	// eviction worry goes away, but is replaced with there being a window when
-//	// Loki hasn't scraped the logs yet so they don't show up.
-//	require.NoError(t, backoff.Retry(func() error {
-//		// Get logs from pipeline, using pipeline
-//		iter = c.GetLogs(pipelineName, "", nil, "", false, false, 0)
-//		var numLogs int
-//		for iter.Next() {
-//			if !iter.Message().User {
-//				continue
-//			}
-//			numLogs++
-//			require.True(t, iter.Message().Message != "")
-//			require.False(t, strings.Contains(iter.Message().Message, "MISSING"), iter.Message().Message)
-//		}
-//		if numLogs < 2 {
-//			return fmt.Errorf("didn't get enough log lines")
-//		}
-//		if err := iter.Err(); err != nil {
-//			return err
-//		}
-//
-//		// Get logs from pipeline, using pipeline (tailing the last two log lines)
-//		iter = c.GetLogs(pipelineName, "", nil, "", true, false, 2)
-//		numLogs = 0
-//		for iter.Next() {
-//			numLogs++
-//			require.True(t, iter.Message().Message != "")
-//		}
-//		if numLogs < 2 {
-//			return fmt.Errorf("didn't get enough log lines")
-//		}
-//		if err := iter.Err(); err != nil {
-//			return err
-//		}
-//
-//		// Get logs from pipeline, using job
-//		// (1) Get job ID, from pipeline that just ran
-//		jobInfos, err := c.ListJob(pipelineName, nil, nil, -1, true)
-//		if err != nil {
-//			return err
-//		}
-//		require.True(t, len(jobInfos) == 1)
-//		// (2) Get logs using extracted job ID
-//		// wait for logs to be collected
-//		time.Sleep(10 * time.Second)
-//		iter = c.GetLogs("", jobInfos[0].Job.ID, nil, "", false, false, 0)
-//		numLogs = 0
-//		for iter.Next() {
-//			numLogs++
-//			require.True(t, iter.Message().Message != "")
-//		}
-//		// Make sure that we've seen some logs
-//		if err = iter.Err(); err != nil {
-//			return err
-//		}
-//		require.True(t, numLogs > 0)
-//
-//		// Get logs for datums but don't specify pipeline or job. These should error
-//		iter = c.GetLogs("", "", []string{"/foo"}, "", false, false, 0)
-//		require.False(t, iter.Next())
-//		require.YesError(t, iter.Err())
-//
-//		resp, err := c.ListDatum(jobInfos[0].Job.ID, 0, 0)
-//		if err != nil {
-//			return err
-//		}
-//		require.True(t, len(resp.DatumInfos) > 0)
-//		iter = c.GetLogs("", "", nil, resp.DatumInfos[0].Datum.ID, false, false, 0)
-//		require.False(t, iter.Next())
-//		require.YesError(t, iter.Err())
-//
-//		// Filter logs based on input (using file that exists). Get logs using file
-//		// path, hex hash, and base64 hash, and make sure you get the same log lines
-//		fileInfo, err := c.InspectFile(dataRepo, commit.ID, "/file")
-//		if err != nil {
-//			return err
-//		}
-//
-//		pathLog := c.GetLogs("", jobInfos[0].Job.ID, []string{"/file"}, "", false, false, 0)
-//
-//		base64Hash := "Gf31e9+etalgK/qcDm3X7Tg1+P1DHZFQA+qCdHcHvmY="
-//		require.Equal(t, base64Hash, base64.StdEncoding.EncodeToString(fileInfo.Hash))
-//		base64Log := c.GetLogs("", jobInfos[0].Job.ID, []string{base64Hash}, "", false, false, 0)
-//
-//		numLogs = 0
-//		for {
-//			havePathLog, haveBase64Log := pathLog.Next(), base64Log.Next()
-//			if havePathLog != haveBase64Log {
-//				return errors.Errorf("Unequal log lengths")
-//			}
-//			if !havePathLog {
-//				break
-//			}
-//			numLogs++
-//			if pathLog.Message().Message != base64Log.Message().Message {
-//				return errors.Errorf(
-//					"unequal logs, pathLogs: \"%s\" base64Log: \"%s\"",
-//					pathLog.Message().Message,
-//					base64Log.Message().Message)
-//			}
-//		}
-//		for _, logsiter := range []*client.LogsIter{pathLog, base64Log} {
-//			if logsiter.Err() != nil {
-//				return logsiter.Err()
-//			}
-//		}
-//		if numLogs == 0 {
-//			return errors.Errorf("no logs found")
-//		}
-//
-//		// Filter logs based on input (using file that doesn't exist). There should
-//		// be no logs
-//		iter = c.GetLogs("", jobInfos[0].Job.ID, []string{"__DOES_NOT_EXIST__"}, "", false, false, 0)
-//		require.False(t, iter.Next())
-//		if err = iter.Err(); err != nil {
-//			return err
-//		}
-//
-//		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-//		defer cancel()
-//		iter = c.WithCtx(ctx).GetLogs(pipelineName, "", nil, "", false, false, 0)
-//		numLogs = 0
-//		for iter.Next() {
-//			numLogs++
-//			if numLogs == 8 {
-//				// Do another commit so there's logs to receive with follow
-//				_, err = c.StartCommit(dataRepo, "master")
-//				if err != nil {
-//					return err
-//				}
-//				if err := c.PutFile(dataRepo, "master", "file", strings.NewReader("bar\n")); err != nil {
-//					return err
-//				}
-//				if err = c.FinishCommit(dataRepo, "master"); err != nil {
-//					return err
-//				}
-//			}
-//			require.True(t, iter.Message().Message != "")
-//			if numLogs == 16 {
-//				break
-//			}
-//		}
-//
-//		return iter.Err()
-//	}, backoff.NewTestingBackOff()))
-//}
-//
-//func TestManyLogs(t *testing.T) {
-//	// TODO: Implement logs.
-//	t.Skip("Logs not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//	// create repos
-//	dataRepo := tu.UniqueString("data")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo\n")))
-//	// create pipeline
-//	numLogs := 10000
-//	pipelineName := tu.UniqueString("pipeline")
-//	_, err = c.PpsAPIClient.CreatePipeline(context.Background(),
-//		&pps.CreatePipelineRequest{
-//			Pipeline: client.NewPipeline(pipelineName),
-//			Transform: &pps.Transform{
-//				Cmd: []string{"sh"},
-//				Stdin: []string{
-//					"i=0",
-//					fmt.Sprintf("while [ \"$i\" -lt %d ]", numLogs),
-//					"do",
-//					"	echo $i",
-//					"	i=`expr $i + 1`",
-//					"done",
-//				},
-//			},
-//			Input: client.NewPFSInput(dataRepo, "/*"),
-//		})
-//	require.NoError(t, err)
-//	jis, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(jis))
-//	require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
-//		iter := c.GetLogs("", jis[0].Job.ID, nil, "", false, false, 0)
-//		logsReceived := 0
-//		for iter.Next() {
-//			if iter.Message().User {
-//				logsReceived++
-//			}
-//		}
-//		if iter.Err() != nil {
-//			return iter.Err()
-//		}
-//		if numLogs != logsReceived {
-//			return fmt.Errorf("received: %d log lines, expected: %d", logsReceived, numLogs)
-//		}
-//		return nil
-//	})
-//}
-//
-//func TestLokiLogs(t *testing.T) {
-//	// TODO: Implement logs.
-//	t.Skip("Logs not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//	// create repos
-//	dataRepo := tu.UniqueString("data")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo\n")))
-//	// create pipeline
-//	pipelineName := tu.UniqueString("pipeline")
-//	_, err = c.PpsAPIClient.CreatePipeline(context.Background(),
-//		&pps.CreatePipelineRequest{
-//			Pipeline: client.NewPipeline(pipelineName),
-//			Transform: &pps.Transform{
-//				Cmd:   []string{"sh"},
-//				Stdin: []string{"echo", "foo"},
-//			},
-//			Input: client.NewPFSInput(dataRepo, "/*"),
-//		})
-//	require.NoError(t, err)
-//	jis, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(jis))
-//	require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
-//		iter := c.GetLogsLoki("", jis[0].Job.ID, nil, "", false, false, 0)
-//		foundFoo := false
-//		for iter.Next() {
-//			if strings.Contains(iter.Message().Message, "foo") {
-//				foundFoo = true
-//				break
-//			}
-//		}
-//		if iter.Err() != nil {
-//			return iter.Err()
-//		}
-//		if !foundFoo {
-//			return fmt.Errorf("did not recieve a log line containing foo")
-//		}
-//		return nil
-//	})
-//}
+func TestGetLogs(t *testing.T) {
+	testGetLogs(t, false)
+}
 
-func TestAllDatumsAreProcessed(t *testing.T) {
+func TestGetLogsWithStats(t *testing.T) {
+	testGetLogs(t, true)
+}
+
+func testGetLogs(t *testing.T, enableStats bool) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
 
 	c := tu.GetPachClient(t)
 	require.NoError(t, c.DeleteAll())
-
-	dataRepo1 := tu.UniqueString("TestAllDatumsAreProcessed_data1")
-	require.NoError(t, c.CreateRepo(dataRepo1))
-	dataRepo2 := tu.UniqueString("TestAllDatumsAreProcessed_data2")
-	require.NoError(t, c.CreateRepo(dataRepo2))
-
-	commit1, err := c.StartCommit(dataRepo1, "master")
+	iter := c.GetLogs("", "", nil, "", false, false, 0)
+	for iter.Next() {
+	}
+	require.NoError(t, iter.Err())
+	// create repos
+	dataRepo := tu.UniqueString("data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	// create pipeline
+	pipelineName := tu.UniqueString("pipeline")
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipelineName),
+			Transform: &pps.Transform{
+				Cmd: []string{"sh"},
+				Stdin: []string{
+					fmt.Sprintf("cp /pfs/%s/file /pfs/out/file", dataRepo),
+					"echo foo",
+					"echo %s", // %s tests a formatting bug we had (#2729)
+				},
+			},
+			Input:       client.NewPFSInput(dataRepo, "/*"),
+			EnableStats: enableStats,
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant: 4,
+			},
+		})
 	require.NoError(t, err)
-	require.NoError(t, c.PutFile(dataRepo1, "master", "file1", strings.NewReader("foo\n")))
-	require.NoError(t, c.PutFile(dataRepo1, "master", "file2", strings.NewReader("foo\n")))
-	require.NoError(t, c.FinishCommit(dataRepo1, "master"))
 
-	commit2, err := c.StartCommit(dataRepo2, "master")
+	// Commit data to repo and flush commit
+	commit, err := c.StartCommit(dataRepo, "master")
 	require.NoError(t, err)
-	require.NoError(t, c.PutFile(dataRepo2, "master", "file1", strings.NewReader("foo\n")))
-	require.NoError(t, c.PutFile(dataRepo2, "master", "file2", strings.NewReader("foo\n")))
-	require.NoError(t, c.FinishCommit(dataRepo2, "master"))
-
-	require.NoError(t, c.CreatePipeline(
-		tu.UniqueString("TestAllDatumsAreProcessed_pipelines"),
-		"",
-		[]string{"bash"},
-		[]string{
-			fmt.Sprintf("cat /pfs/%s/* /pfs/%s/* > /pfs/out/file", dataRepo1, dataRepo2),
-		},
-		nil,
-		client.NewCrossInput(
-			client.NewPFSInput(dataRepo1, "/*"),
-			client.NewPFSInput(dataRepo2, "/*"),
-		),
-		"",
-		false,
-	))
+	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo\n")))
+	require.NoError(t, c.FinishCommit(dataRepo, "master"))
+	_, err = c.FlushJobAll([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+
+	// Get logs from pipeline, using a pipeline that doesn't exist. There should
+	// be an error
+	iter = c.GetLogs("__DOES_NOT_EXIST__", "", nil, "", false, false, 0)
+	require.False(t, iter.Next())
+	require.YesError(t, iter.Err())
+	require.Matches(t, "could not get", iter.Err().Error())
+
+	// Get logs from pipeline, using a job that doesn't exist. There should
+	// be an error
+	iter = c.GetLogs("", "__DOES_NOT_EXIST__", nil, "", false, false, 0)
+	require.False(t, iter.Next())
+	require.YesError(t, iter.Err())
+	require.Matches(t, "could not get", iter.Err().Error())
+
+	// This is put in a backoff because there's the possibility that pod was
+	// evicted from k8s and is being re-initialized, in which case `GetLogs`
+	// will appropriately fail. With the loki logging backend enabled the
+	// eviction worry goes away, but is replaced with there being a window when
+	// Loki hasn't scraped the logs yet so they don't show up.
+	require.NoError(t, backoff.Retry(func() error {
+		// Get logs from pipeline, using pipeline
+		iter = c.GetLogs(pipelineName, "", nil, "", false, false, 0)
+		var numLogs int
+		for iter.Next() {
+			if !iter.Message().User {
+				continue
+			}
+			numLogs++
+			require.True(t, iter.Message().Message != "")
+			require.False(t, strings.Contains(iter.Message().Message, "MISSING"), iter.Message().Message)
+		}
+		if numLogs < 2 {
+			return fmt.Errorf("didn't get enough log lines")
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+
+		// Get logs from pipeline, using pipeline (tailing the last two log lines)
+		iter = c.GetLogs(pipelineName, "", nil, "", true, false, 2)
+		numLogs = 0
+		for iter.Next() {
+			numLogs++
+			require.True(t, iter.Message().Message != "")
+		}
+		if numLogs < 2 {
+			return fmt.Errorf("didn't get enough log lines")
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+
+		// Get logs from pipeline, using job
+		// (1) Get job ID, from pipeline that just ran
+		jobInfos, err := c.ListJob(pipelineName, nil, nil, -1, true)
+		if err != nil {
+			return err
+		}
+		require.True(t, len(jobInfos) == 1)
+		// (2) Get logs using extracted job ID
+		// wait for logs to be collected
+		time.Sleep(10 * time.Second)
+		iter = c.GetLogs("", jobInfos[0].Job.ID, nil, "", false, false, 0)
+		numLogs = 0
+		for iter.Next() {
+			numLogs++
+			require.True(t, iter.Message().Message != "")
+		}
+		// Make sure that we've seen some logs
+		if err = iter.Err(); err != nil {
+			return err
+		}
+		require.True(t, numLogs > 0)
+
+		// Get logs for datums but don't specify pipeline or job. These should error
+		iter = c.GetLogs("", "", []string{"/foo"}, "", false, false, 0)
+		require.False(t, iter.Next())
+		require.YesError(t, iter.Err())
+
+		dis, err := c.ListDatumAll(jobInfos[0].Job.ID)
+		if err != nil {
+			return err
+		}
+		require.True(t, len(dis) > 0)
+		iter = c.GetLogs("", "", nil, dis[0].Datum.ID, false, false, 0)
+		require.False(t, iter.Next())
+		require.YesError(t, iter.Err())
+
+		// Filter logs based on input (using file that exists). Get logs using file
+		// path, hex hash, and base64 hash, and make sure you get the same log lines
+		fileInfo, err := c.InspectFile(dataRepo, commit.ID, "/file")
+		if err != nil {
+			return err
+		}
+
+		pathLog := c.GetLogs("", jobInfos[0].Job.ID, []string{"/file"}, "", false, false, 0)
+
+		base64Hash := base64.StdEncoding.EncodeToString(fileInfo.Hash)
+		base64Log := c.GetLogs("", jobInfos[0].Job.ID, []string{base64Hash}, "", false, false, 0)
+
+		numLogs = 0
+		for {
+			havePathLog, haveBase64Log := pathLog.Next(), base64Log.Next()
+			if havePathLog != haveBase64Log {
+				return errors.Errorf("Unequal log lengths")
+			}
+			if !havePathLog {
+				break
+			}
+			numLogs++
+			if pathLog.Message().Message != base64Log.Message().Message {
+				return errors.Errorf(
+					"unequal logs, pathLogs: \"%s\" base64Log: \"%s\"",
+					pathLog.Message().Message,
+					base64Log.Message().Message)
+			}
+		}
+		for _, logsiter := range []*client.LogsIter{pathLog, base64Log} {
+			if logsiter.Err() != nil {
+				return logsiter.Err()
+			}
+		}
+		if numLogs == 0 {
+			return errors.Errorf("no logs found")
+		}
+
+		// Filter logs based on input (using file that doesn't exist). There should
+		// be no logs
+		iter = c.GetLogs("", jobInfos[0].Job.ID, []string{"__DOES_NOT_EXIST__"}, "", false, false, 0)
+		require.False(t, iter.Next())
+		if err = iter.Err(); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		iter = c.WithCtx(ctx).GetLogs(pipelineName, "", nil, "", false, false, 0)
+		numLogs = 0
+		for iter.Next() {
+			numLogs++
+			if numLogs == 8 {
+				// Do another commit so there's logs to receive with follow
+				_, err = c.StartCommit(dataRepo, "master")
+				if err != nil {
+					return err
+				}
+				if err := c.PutFile(dataRepo, "master", "file", strings.NewReader("bar\n")); err != nil {
+					return err
+				}
+				if err = c.FinishCommit(dataRepo, "master"); err != nil {
+					return err
+				}
+			}
+			require.True(t, iter.Message().Message != "")
+			if numLogs == 16 {
+				break
+			}
+		}
+
+		return iter.Err()
+	}, backoff.NewTestingBackOff()))
+}
+
+func TestManyLogs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	// create repos
+	dataRepo := tu.UniqueString("data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo\n")))
+	// create pipeline
+	numLogs := 10000
+	pipelineName := tu.UniqueString("pipeline")
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipelineName),
+			Transform: &pps.Transform{
+				Cmd: []string{"sh"},
+				Stdin: []string{
+					"i=0",
+					fmt.Sprintf("while [ \"$i\" -lt %d ]", numLogs),
+					"do",
+					"	echo $i",
+					"	i=`expr $i + 1`",
+					"done",
+				},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+		})
+	require.NoError(t, err)
+	jis, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jis))
+	require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
+		iter := c.GetLogs("", jis[0].Job.ID, nil, "", false, false, 0)
+		logsReceived := 0
+		for iter.Next() {
+			if iter.Message().User {
+				logsReceived++
+			}
+		}
+		if iter.Err() != nil {
+			return iter.Err()
+		}
+		if numLogs != logsReceived {
+			return fmt.Errorf("received: %d log lines, expected: %d", logsReceived, numLogs)
+		}
+		return nil
+	})
+}
+
+func TestLokiLogs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	// create repos
+	dataRepo := tu.UniqueString("data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo\n")))
+	// create pipeline
+	pipelineName := tu.UniqueString("pipeline")
+	_, err := c.PpsAPIClient.CreatePipeline(context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipelineName),
+			Transform: &pps.Transform{
+				Cmd:   []string{"sh"},
+				Stdin: []string{"echo", "foo"},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+		})
+	require.NoError(t, err)
+	jis, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jis))
+	require.NoErrorWithinTRetry(t, 30*time.Second, func() error {
+		iter := c.GetLogsLoki("", jis[0].Job.ID, nil, "", false, false, 0)
+		foundFoo := false
+		for iter.Next() {
+			if strings.Contains(iter.Message().Message, "foo") {
+				foundFoo = true
+				break
+			}
+		}
+		if iter.Err() != nil {
+			return iter.Err()
+		}
+		if !foundFoo {
+			return fmt.Errorf("did not recieve a log line containing foo")
+		}
+		return nil
+	})
+}
+
+func TestAllDatumsAreProcessed(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo1 := tu.UniqueString("TestAllDatumsAreProcessed_data1")
+	require.NoError(t, c.CreateRepo(dataRepo1))
+	dataRepo2 := tu.UniqueString("TestAllDatumsAreProcessed_data2")
+	require.NoError(t, c.CreateRepo(dataRepo2))
+
+	commit1, err := c.StartCommit(dataRepo1, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(dataRepo1, "master", "file1", strings.NewReader("foo\n")))
+	require.NoError(t, c.PutFile(dataRepo1, "master", "file2", strings.NewReader("foo\n")))
+	require.NoError(t, c.FinishCommit(dataRepo1, "master"))
+
+	commit2, err := c.StartCommit(dataRepo2, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(dataRepo2, "master", "file1", strings.NewReader("foo\n")))
+	require.NoError(t, c.PutFile(dataRepo2, "master", "file2", strings.NewReader("foo\n")))
+	require.NoError(t, c.FinishCommit(dataRepo2, "master"))
+
+	require.NoError(t, c.CreatePipeline(
+		tu.UniqueString("TestAllDatumsAreProcessed_pipelines"),
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/%s/* /pfs/%s/* > /pfs/out/file", dataRepo1, dataRepo2),
+		},
+		nil,
+		client.NewCrossInput(
+			client.NewPFSInput(dataRepo1, "/*"),
+			client.NewPFSInput(dataRepo2, "/*"),
+		),
+		"",
+		false,
+	))
 
 	commitIter, err := c.FlushCommit([]*pfs.Commit{commit1, commit2}, nil)
 	require.NoError(t, err)
@@ -4995,8 +4960,6 @@ func TestPipelineLargeOutput(t *testing.T) {
 }
 
 func TestJoinInput(t *testing.T) {
-	// TODO: Implement join inputs.
-	t.Skip("Join inputs not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -5055,320 +5018,306 @@ func TestJoinInput(t *testing.T) {
 	}
 }
 
-// TODO: Make work with V2.
-//func TestGroupInput(t *testing.T) {
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	t.Run("Basic", func(t *testing.T) {
-//		repo := tu.UniqueString("TestGroupInput")
-//		require.NoError(t, c.CreateRepo(repo))
-//		numFiles := 16
-//		for i := 0; i < numFiles; i++ {
-//			require.NoError(t, c.PutFile(repo, "master", fmt.Sprintf("file.%4b", i), strings.NewReader(fmt.Sprintf("%d\n", i))))
-//			require.NoError(t, err)
-//		}
-//
-//		pipeline := "group-pipeline"
-//		require.NoError(t, c.CreatePipeline(
-//			pipeline,
-//			"",
-//			[]string{"bash"},
-//			[]string{},
-//			&pps.ParallelismSpec{
-//				Constant: 1,
-//			},
-//			client.NewGroupInput(
-//				client.NewPFSInputOpts("", repo, "", "/file.(?)(?)(?)(?)", "", "$3", false, false, nil),
-//			),
-//			"",
-//			false,
-//		))
-//
-//		jobs, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(repo, "master")}, nil)
-//		require.NoError(t, err)
-//		require.Equal(t, 1, len(jobs))
-//
-//		// We're grouping by the third digit in the filename
-//		// for 0 and 1, this is just a space
-//		// then we should see the 8 files with a one there, and the 6 files with a zero there
-//		expected := [][]string{
-//			{"/file.   0",
-//				"/file.   1"},
-//
-//			{"/file.  10",
-//				"/file.  11",
-//				"/file. 110",
-//				"/file. 111",
-//				"/file.1010",
-//				"/file.1011",
-//				"/file.1110",
-//				"/file.1111"},
-//
-//			{"/file. 100",
-//				"/file. 101",
-//				"/file.1000",
-//				"/file.1001",
-//				"/file.1100",
-//				"/file.1101"}}
-//		actual := make([][]string, 0, 3)
-//		resp, err := c.ListDatum(jobs[0].Job.ID, 0, 0)
-//		require.NoError(t, err)
-//		// these don't come in a consistent order because group inputs use maps
-//		sort.Slice(resp.DatumInfos, func(i, j int) bool {
-//			return resp.DatumInfos[i].Data[0].File.Path < resp.DatumInfos[j].Data[0].File.Path
-//		})
-//		for _, di := range resp.DatumInfos {
-//			sort.Slice(di.Data, func(i, j int) bool { return di.Data[i].File.Path < di.Data[j].File.Path })
-//			datumFiles := make([]string, 0)
-//			for _, fi := range di.Data {
-//				datumFiles = append(datumFiles, fi.File.Path)
-//			}
-//			actual = append(actual, datumFiles)
-//		}
-//		require.Equal(t, expected, actual)
-//	})
-//
-//	t.Run("MultiInput", func(t *testing.T) {
-//		var repos []string
-//		for i := 0; i < 2; i++ {
-//			repos = append(repos, tu.UniqueString(fmt.Sprintf("TestGroupInput%v", i)))
-//			require.NoError(t, c.CreateRepo(repos[i]))
-//		}
-//
-//		numFiles := 16
-//		for r, repo := range repos {
-//			for i := 0; i < numFiles; i++ {
-//				require.NoError(t, c.PutFile(repo, "master", fmt.Sprintf("file-%v.%4b", r, i), strings.NewReader(fmt.Sprintf("%d\n", i))))
-//				require.NoError(t, err)
-//			}
-//		}
-//
-//		pipeline := "group-pipeline-multi-input"
-//		require.NoError(t, c.CreatePipeline(
-//			pipeline,
-//			"",
-//			[]string{"bash"},
-//			[]string{},
-//			&pps.ParallelismSpec{
-//				Constant: 1,
-//			},
-//			client.NewGroupInput(
-//				client.NewPFSInputOpts("", repos[0], "", "/file-?.(?)(?)(?)(?)", "", "$3", false, false, nil),
-//				client.NewPFSInputOpts("", repos[1], "", "/file-?.(?)(?)(?)(?)", "", "$2", false, false, nil),
-//			),
-//			"",
-//			false,
-//		))
-//
-//		jobs, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(repos[0], "master")}, nil)
-//		require.NoError(t, err)
-//		require.Equal(t, 1, len(jobs))
-//
-//		// this time, we are grouping by the third digit in the 0 repo, and the second digit in the 1 repo
-//		// so the first group should have all the things from the 0 repo with a space in the third digit
-//		// and all the things from the 1 repo with a space in the second digit
-//		//
-//		// similarly for the second and third groups
-//		expected := [][]string{
-//			{"/file-0.   0",
-//				"/file-0.   1",
-//				"/file-1.   0",
-//				"/file-1.   1",
-//				"/file-1.  10",
-//				"/file-1.  11"},
-//
-//			{"/file-0.  10",
-//				"/file-0.  11",
-//				"/file-0. 110",
-//				"/file-0. 111",
-//				"/file-0.1010",
-//				"/file-0.1011",
-//				"/file-0.1110",
-//				"/file-0.1111",
-//				"/file-1. 100",
-//				"/file-1. 101",
-//				"/file-1. 110",
-//				"/file-1. 111",
-//				"/file-1.1100",
-//				"/file-1.1101",
-//				"/file-1.1110",
-//				"/file-1.1111"},
-//
-//			{"/file-0. 100",
-//				"/file-0. 101",
-//				"/file-0.1000",
-//				"/file-0.1001",
-//				"/file-0.1100",
-//				"/file-0.1101",
-//				"/file-1.1000",
-//				"/file-1.1001",
-//				"/file-1.1010",
-//				"/file-1.1011"}}
-//		actual := make([][]string, 0, 3)
-//		resp, err := c.ListDatum(jobs[0].Job.ID, 0, 0)
-//		require.NoError(t, err)
-//		// these don't come in a consistent order because group inputs use maps
-//		sort.Slice(resp.DatumInfos, func(i, j int) bool {
-//			return resp.DatumInfos[i].Data[0].File.Path < resp.DatumInfos[j].Data[0].File.Path
-//		})
-//		for _, di := range resp.DatumInfos {
-//			sort.Slice(di.Data, func(i, j int) bool { return di.Data[i].File.Path < di.Data[j].File.Path })
-//			datumFiles := make([]string, 0)
-//			for _, fi := range di.Data {
-//				datumFiles = append(datumFiles, fi.File.Path)
-//			}
-//			actual = append(actual, datumFiles)
-//		}
-//		require.Equal(t, expected, actual)
-//	})
-//
-//	t.Run("GroupJoinCombo", func(t *testing.T) {
-//		var repos []string
-//		for i := 0; i < 2; i++ {
-//			repos = append(repos, tu.UniqueString(fmt.Sprintf("TestGroupInput%v", i)))
-//			require.NoError(t, c.CreateRepo(repos[i]))
-//		}
-//
-//		numFiles := 16
-//		for r, repo := range repos {
-//			for i := 0; i < numFiles; i++ {
-//				require.NoError(t, c.PutFile(repo, "master", fmt.Sprintf("file-%v.%4b", r, i), strings.NewReader(fmt.Sprintf("%d\n", i))))
-//				require.NoError(t, err)
-//			}
-//		}
-//
-//		pipeline := "group-join-pipeline"
-//		require.NoError(t, c.CreatePipeline(
-//			pipeline,
-//			"",
-//			[]string{"bash"},
-//			[]string{},
-//			&pps.ParallelismSpec{
-//				Constant: 1,
-//			},
-//			client.NewGroupInput(
-//				client.NewJoinInput(
-//					client.NewPFSInputOpts("", repos[0], "", "/file-?.(?)(?)(?)(?)", "$1$2$3$4", "$3", false, false, nil),
-//					client.NewPFSInputOpts("", repos[1], "", "/file-?.(?)(?)(?)(?)", "$4$3$2$1", "$2", false, false, nil),
-//				),
-//			),
-//			"",
-//			false,
-//		))
-//
-//		jobs, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(repos[0], "master")}, nil)
-//		require.NoError(t, err)
-//		require.Equal(t, 1, len(jobs))
-//
-//		// here, we're first doing a join to get pairs of files (one from each repo) that have the reverse numbers
-//		// we should see four pairs
-//		// then, we're grouping the files in these pairs by the third digit/second digit as before
-//		// this should regroup things into two groups of four
-//		expected := [][]string{
-//			{"/file-0.1011",
-//				"/file-0.1111",
-//				"/file-1.1101",
-//				"/file-1.1111"},
-//
-//			{"/file-0.1001",
-//				"/file-0.1101",
-//				"/file-1.1001",
-//				"/file-1.1011"}}
-//		actual := make([][]string, 0, 2)
-//		resp, err := c.ListDatum(jobs[0].Job.ID, 0, 0)
-//		require.NoError(t, err)
-//		// these don't come in a consistent order because group inputs use maps
-//		sort.Slice(resp.DatumInfos, func(i, j int) bool {
-//			return resp.DatumInfos[i].Data[0].File.Path < resp.DatumInfos[j].Data[0].File.Path
-//		})
-//		for _, di := range resp.DatumInfos {
-//			sort.Slice(di.Data, func(i, j int) bool { return di.Data[i].File.Path < di.Data[j].File.Path })
-//			datumFiles := make([]string, 0)
-//			for _, fi := range di.Data {
-//				datumFiles = append(datumFiles, fi.File.Path)
-//			}
-//			actual = append(actual, datumFiles)
-//		}
-//		require.Equal(t, expected, actual)
-//	})
-//	t.Run("Symlink", func(t *testing.T) {
-//		// Fix for the bug exhibited here: https://github.com/pachyderm/pachyderm/tree/example-groupby/examples/group
-//		repo := tu.UniqueString("TestGroupInputSymlink")
-//		require.NoError(t, c.CreateRepo(repo))
-//
-//		require.NoError(t, c.PutFile(repo, "master", "/T1606707557-LIPID-PATID1-CLIA24D9871327.txt", strings.NewReader("")))
-//		require.NoError(t, err)
-//		require.NoError(t, c.PutFile(repo, "master", "/T1606331395-LIPID-PATID2-CLIA24D9871327.txt", strings.NewReader("")))
-//		require.NoError(t, err)
-//		require.NoError(t, c.PutFile(repo, "master", "/T1606707579-LIPID-PATID3-CLIA24D9871327.txt", strings.NewReader("")))
-//		require.NoError(t, err)
-//		require.NoError(t, c.PutFile(repo, "master", "/T1606707597-LIPID-PATID4-CLIA24D9871327.txt", strings.NewReader("")))
-//		require.NoError(t, err)
-//		require.NoError(t, c.PutFile(repo, "master", "/T1606707613-LIPID-PATID1-CLIA24D9871328.txt", strings.NewReader("")))
-//		require.NoError(t, err)
-//		require.NoError(t, c.PutFile(repo, "master", "/T1606707635-LIPID-PATID3-CLIA24D9871328.txt", strings.NewReader("")))
-//		require.NoError(t, err)
-//
-//		pipeline := "group-pipeline-symlink"
-//		_, err = c.PpsAPIClient.CreatePipeline(context.Background(),
-//			&pps.CreatePipelineRequest{
-//				Pipeline: client.NewPipeline(pipeline),
-//				Transform: &pps.Transform{
-//					Cmd: []string{"bash"},
-//					Stdin: []string{"PATTERN=.*-PATID\\(.*\\)-.*.txt",
-//						fmt.Sprintf("FILES=/pfs/%v/*", repo),
-//						"for f in $FILES",
-//						"do",
-//						"[[ $(basename $f) =~ $PATTERN ]]",
-//						"mkdir -p /pfs/out/${BASH_REMATCH[1]}/",
-//						"cp $f /pfs/out/${BASH_REMATCH[1]}/",
-//						"done"},
-//				},
-//				Input: client.NewGroupInput(
-//					client.NewPFSInputOpts("", repo, "master", "/*-PATID(*)-*.txt", "", "$1", false, false, nil),
-//				),
-//				EnableStats: true,
-//				ParallelismSpec: &pps.ParallelismSpec{
-//					Constant: 1,
-//				},
-//			})
-//		require.NoError(t, err)
-//
-//		jobs, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(repo, "master")}, nil)
-//		require.NoError(t, err)
-//		require.Equal(t, 1, len(jobs))
-//
-//		require.Equal(t, "JOB_SUCCESS", jobs[0].State.String())
-//
-//		expected := [][]string{
-//			[]string{"/T1606331395-LIPID-PATID2-CLIA24D9871327.txt"},
-//			[]string{"/T1606707557-LIPID-PATID1-CLIA24D9871327.txt", "/T1606707613-LIPID-PATID1-CLIA24D9871328.txt"},
-//			[]string{"/T1606707579-LIPID-PATID3-CLIA24D9871327.txt", "/T1606707635-LIPID-PATID3-CLIA24D9871328.txt"},
-//			[]string{"/T1606707597-LIPID-PATID4-CLIA24D9871327.txt"}}
-//		actual := make([][]string, 0, 3)
-//		resp, err := c.ListDatum(jobs[0].Job.ID, 0, 0)
-//		require.NoError(t, err)
-//		// these don't come in a consistent order because group inputs use maps
-//		sort.Slice(resp.DatumInfos, func(i, j int) bool {
-//			return resp.DatumInfos[i].Data[0].File.Path < resp.DatumInfos[j].Data[0].File.Path
-//		})
-//		for _, di := range resp.DatumInfos {
-//			sort.Slice(di.Data, func(i, j int) bool { return di.Data[i].File.Path < di.Data[j].File.Path })
-//			datumFiles := make([]string, 0)
-//			for _, fi := range di.Data {
-//				datumFiles = append(datumFiles, fi.File.Path)
-//			}
-//			actual = append(actual, datumFiles)
-//		}
-//		require.Equal(t, expected, actual)
-//	})
-//}
-
+func TestGroupInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	t.Run("Basic", func(t *testing.T) {
+		repo := tu.UniqueString("TestGroupInput")
+		require.NoError(t, c.CreateRepo(repo))
+		numFiles := 16
+		for i := 0; i < numFiles; i++ {
+			require.NoError(t, c.PutFile(repo, "master", fmt.Sprintf("file.%4b", i), strings.NewReader(fmt.Sprintf("%d\n", i))))
+		}
+
+		pipeline := "group-pipeline"
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewGroupInput(
+				client.NewPFSInputOpts("", repo, "", "/file.(?)(?)(?)(?)", "", "$3", false, false, nil),
+			),
+			"",
+			false,
+		))
+
+		jobs, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(repo, "master")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(jobs))
+
+		// We're grouping by the third digit in the filename
+		// for 0 and 1, this is just a space
+		// then we should see the 8 files with a one there, and the 6 files with a zero there
+		expected := [][]string{
+			{"/file.   0",
+				"/file.   1"},
+
+			{"/file.  10",
+				"/file.  11",
+				"/file. 110",
+				"/file. 111",
+				"/file.1010",
+				"/file.1011",
+				"/file.1110",
+				"/file.1111"},
+
+			{"/file. 100",
+				"/file. 101",
+				"/file.1000",
+				"/file.1001",
+				"/file.1100",
+				"/file.1101"}}
+		actual := make([][]string, 0, 3)
+		dis, err := c.ListDatumAll(jobs[0].Job.ID)
+		require.NoError(t, err)
+		sort.Slice(dis, func(i, j int) bool {
+			return dis[i].Data[0].File.Path < dis[j].Data[0].File.Path
+		})
+		for _, di := range dis {
+			sort.Slice(di.Data, func(i, j int) bool { return di.Data[i].File.Path < di.Data[j].File.Path })
+			datumFiles := make([]string, 0)
+			for _, fi := range di.Data {
+				datumFiles = append(datumFiles, fi.File.Path)
+			}
+			actual = append(actual, datumFiles)
+		}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("MultiInput", func(t *testing.T) {
+		var repos []string
+		for i := 0; i < 2; i++ {
+			repos = append(repos, tu.UniqueString(fmt.Sprintf("TestGroupInput%v", i)))
+			require.NoError(t, c.CreateRepo(repos[i]))
+		}
+
+		numFiles := 16
+		for r, repo := range repos {
+			for i := 0; i < numFiles; i++ {
+				require.NoError(t, c.PutFile(repo, "master", fmt.Sprintf("file-%v.%4b", r, i), strings.NewReader(fmt.Sprintf("%d\n", i))))
+			}
+		}
+
+		pipeline := "group-pipeline-multi-input"
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewGroupInput(
+				client.NewPFSInputOpts("", repos[0], "", "/file-?.(?)(?)(?)(?)", "", "$3", false, false, nil),
+				client.NewPFSInputOpts("", repos[1], "", "/file-?.(?)(?)(?)(?)", "", "$2", false, false, nil),
+			),
+			"",
+			false,
+		))
+
+		jobs, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(repos[0], "master")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(jobs))
+
+		// this time, we are grouping by the third digit in the 0 repo, and the second digit in the 1 repo
+		// so the first group should have all the things from the 0 repo with a space in the third digit
+		// and all the things from the 1 repo with a space in the second digit
+		//
+		// similarly for the second and third groups
+		expected := [][]string{
+			{"/file-0.   0",
+				"/file-0.   1",
+				"/file-1.   0",
+				"/file-1.   1",
+				"/file-1.  10",
+				"/file-1.  11"},
+
+			{"/file-0.  10",
+				"/file-0.  11",
+				"/file-0. 110",
+				"/file-0. 111",
+				"/file-0.1010",
+				"/file-0.1011",
+				"/file-0.1110",
+				"/file-0.1111",
+				"/file-1. 100",
+				"/file-1. 101",
+				"/file-1. 110",
+				"/file-1. 111",
+				"/file-1.1100",
+				"/file-1.1101",
+				"/file-1.1110",
+				"/file-1.1111"},
+
+			{"/file-0. 100",
+				"/file-0. 101",
+				"/file-0.1000",
+				"/file-0.1001",
+				"/file-0.1100",
+				"/file-0.1101",
+				"/file-1.1000",
+				"/file-1.1001",
+				"/file-1.1010",
+				"/file-1.1011"}}
+		actual := make([][]string, 0, 3)
+		dis, err := c.ListDatumAll(jobs[0].Job.ID)
+		require.NoError(t, err)
+		sort.Slice(dis, func(i, j int) bool {
+			return dis[i].Data[0].File.Path < dis[j].Data[0].File.Path
+		})
+		for _, di := range dis {
+			sort.Slice(di.Data, func(i, j int) bool { return di.Data[i].File.Path < di.Data[j].File.Path })
+			datumFiles := make([]string, 0)
+			for _, fi := range di.Data {
+				datumFiles = append(datumFiles, fi.File.Path)
+			}
+			actual = append(actual, datumFiles)
+		}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("GroupJoinCombo", func(t *testing.T) {
+		var repos []string
+		for i := 0; i < 2; i++ {
+			repos = append(repos, tu.UniqueString(fmt.Sprintf("TestGroupInput%v", i)))
+			require.NoError(t, c.CreateRepo(repos[i]))
+		}
+
+		numFiles := 16
+		for r, repo := range repos {
+			for i := 0; i < numFiles; i++ {
+				require.NoError(t, c.PutFile(repo, "master", fmt.Sprintf("file-%v.%4b", r, i), strings.NewReader(fmt.Sprintf("%d\n", i))))
+			}
+		}
+
+		pipeline := "group-join-pipeline"
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewGroupInput(
+				client.NewJoinInput(
+					client.NewPFSInputOpts("", repos[0], "", "/file-?.(?)(?)(?)(?)", "$1$2$3$4", "$3", false, false, nil),
+					client.NewPFSInputOpts("", repos[1], "", "/file-?.(?)(?)(?)(?)", "$4$3$2$1", "$2", false, false, nil),
+				),
+			),
+			"",
+			false,
+		))
+
+		jobs, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(repos[0], "master")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(jobs))
+
+		// here, we're first doing a join to get pairs of files (one from each repo) that have the reverse numbers
+		// we should see four pairs
+		// then, we're grouping the files in these pairs by the third digit/second digit as before
+		// this should regroup things into two groups of four
+		expected := [][]string{
+			{"/file-0.1011",
+				"/file-0.1111",
+				"/file-1.1101",
+				"/file-1.1111"},
+
+			{"/file-0.1001",
+				"/file-0.1101",
+				"/file-1.1001",
+				"/file-1.1011"}}
+		actual := make([][]string, 0, 2)
+		dis, err := c.ListDatumAll(jobs[0].Job.ID)
+		require.NoError(t, err)
+		sort.Slice(dis, func(i, j int) bool {
+			return dis[i].Data[0].File.Path < dis[j].Data[0].File.Path
+		})
+		for _, di := range dis {
+			sort.Slice(di.Data, func(i, j int) bool { return di.Data[i].File.Path < di.Data[j].File.Path })
+			datumFiles := make([]string, 0)
+			for _, fi := range di.Data {
+				datumFiles = append(datumFiles, fi.File.Path)
+			}
+			actual = append(actual, datumFiles)
+		}
+		require.Equal(t, expected, actual)
+	})
+	t.Run("Symlink", func(t *testing.T) {
+		// Fix for the bug exhibited here: https://github.com/pachyderm/pachyderm/tree/example-groupby/examples/group
+		repo := tu.UniqueString("TestGroupInputSymlink")
+		require.NoError(t, c.CreateRepo(repo))
+
+		require.NoError(t, c.PutFile(repo, "master", "/T1606707557-LIPID-PATID1-CLIA24D9871327.txt", strings.NewReader("")))
+		require.NoError(t, c.PutFile(repo, "master", "/T1606331395-LIPID-PATID2-CLIA24D9871327.txt", strings.NewReader("")))
+		require.NoError(t, c.PutFile(repo, "master", "/T1606707579-LIPID-PATID3-CLIA24D9871327.txt", strings.NewReader("")))
+		require.NoError(t, c.PutFile(repo, "master", "/T1606707597-LIPID-PATID4-CLIA24D9871327.txt", strings.NewReader("")))
+		require.NoError(t, c.PutFile(repo, "master", "/T1606707613-LIPID-PATID1-CLIA24D9871328.txt", strings.NewReader("")))
+		require.NoError(t, c.PutFile(repo, "master", "/T1606707635-LIPID-PATID3-CLIA24D9871328.txt", strings.NewReader("")))
+
+		pipeline := "group-pipeline-symlink"
+		_, err := c.PpsAPIClient.CreatePipeline(context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipeline),
+				Transform: &pps.Transform{
+					Cmd: []string{"bash"},
+					Stdin: []string{"PATTERN=.*-PATID\\(.*\\)-.*.txt",
+						fmt.Sprintf("FILES=/pfs/%v/*", repo),
+						"for f in $FILES",
+						"do",
+						"[[ $(basename $f) =~ $PATTERN ]]",
+						"mkdir -p /pfs/out/${BASH_REMATCH[1]}/",
+						"cp $f /pfs/out/${BASH_REMATCH[1]}/",
+						"done"},
+				},
+				Input: client.NewGroupInput(
+					client.NewPFSInputOpts("", repo, "master", "/*-PATID(*)-*.txt", "", "$1", false, false, nil),
+				),
+				EnableStats: true,
+				ParallelismSpec: &pps.ParallelismSpec{
+					Constant: 1,
+				},
+			})
+		require.NoError(t, err)
+
+		jobs, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(repo, "master")}, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(jobs))
+
+		require.Equal(t, "JOB_SUCCESS", jobs[0].State.String())
+
+		expected := [][]string{
+			[]string{"/T1606331395-LIPID-PATID2-CLIA24D9871327.txt"},
+			[]string{"/T1606707557-LIPID-PATID1-CLIA24D9871327.txt", "/T1606707613-LIPID-PATID1-CLIA24D9871328.txt"},
+			[]string{"/T1606707579-LIPID-PATID3-CLIA24D9871327.txt", "/T1606707635-LIPID-PATID3-CLIA24D9871328.txt"},
+			[]string{"/T1606707597-LIPID-PATID4-CLIA24D9871327.txt"}}
+		actual := make([][]string, 0, 3)
+		dis, err := c.ListDatumAll(jobs[0].Job.ID)
+		require.NoError(t, err)
+		sort.Slice(dis, func(i, j int) bool {
+			return dis[i].Data[0].File.Path < dis[j].Data[0].File.Path
+		})
+		for _, di := range dis {
+			sort.Slice(di.Data, func(i, j int) bool { return di.Data[i].File.Path < di.Data[j].File.Path })
+			datumFiles := make([]string, 0)
+			for _, fi := range di.Data {
+				datumFiles = append(datumFiles, fi.File.Path)
+			}
+			actual = append(actual, datumFiles)
+		}
+		require.Equal(t, expected, actual)
+	})
+}
+
 // TODO: Make work with V2?
 // Need to decide how we want to handle duplicate datum ids.
 //func TestUnionRegression4688(t *testing.T) {
@@ -5949,88 +5898,105 @@ func TestUnionInput(t *testing.T) {
 //	require.NoError(t, err)
 //	require.Equal(t, pps.DatumState_FAILED, datum.State)
 //}
-//
-//func TestPipelineWithStatsPaginated(t *testing.T) {
-//	// TODO: Implement pagination?.
-//	t.Skip("Pagination not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	dataRepo := tu.UniqueString("TestPipelineWithStatsPaginated_data")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//
-//	numPages := int64(2)
-//	pageSize := int64(10)
-//	numFiles := int(numPages * pageSize)
-//	commit1, err := c.StartCommit(dataRepo, "master")
-//	require.NoError(t, err)
-//	for i := 0; i < numFiles; i++ {
-//		require.NoError(t, c.PutFile(dataRepo, commit1.ID, fmt.Sprintf("file-%d", i), strings.NewReader(strings.Repeat("foo\n", 100))))
-//	}
-//	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
-//
-//	pipeline := tu.UniqueString("pipeline")
-//	_, err = c.PpsAPIClient.CreatePipeline(context.Background(),
-//		&pps.CreatePipelineRequest{
-//			Pipeline: client.NewPipeline(pipeline),
-//			Transform: &pps.Transform{
-//				Cmd: []string{"bash"},
-//				Stdin: []string{
-//					fmt.Sprintf("if [ -f /pfs/%s/file-5 ]; then exit 1; fi", dataRepo),
-//					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
-//				},
-//			},
-//			Input:       client.NewPFSInput(dataRepo, "/*"),
-//			EnableStats: true,
-//			ParallelismSpec: &pps.ParallelismSpec{
-//				Constant: 4,
-//			},
-//		})
-//
-//	commitIter, err := c.FlushCommit([]*pfs.Commit{commit1}, nil)
-//	require.NoError(t, err)
-//	commitInfos := collectCommitInfos(t, commitIter)
-//	require.Equal(t, 2, len(commitInfos))
-//
-//	var jobs []*pps.JobInfo
-//	require.NoError(t, backoff.Retry(func() error {
-//		jobs, err = c.ListJob(pipeline, nil, nil, -1, true)
-//		require.NoError(t, err)
-//		if len(jobs) != 1 {
-//			return errors.Errorf("expected 1 jobs, got %d", len(jobs))
-//		}
-//		return nil
-//	}, backoff.NewTestingBackOff()))
-//
-//	// Block on the job being complete before we call ListDatum
-//	_, err = c.InspectJob(jobs[0].Job.ID, true)
-//	require.NoError(t, err)
-//
-//	resp, err := c.ListDatumAll(jobs[0].Job.ID, pageSize, 0)
-//	require.NoError(t, err)
-//	require.Equal(t, pageSize, int64(len(resp.DatumInfos)))
-//	require.Equal(t, int64(numFiles)/pageSize, resp.TotalPages)
-//
-//	// First entry should be failed
-//	require.Equal(t, pps.DatumState_FAILED, resp.DatumInfos[0].State)
-//
-//	resp, err = c.ListDatumAll(jobs[0].Job.ID, pageSize, int64(numPages-1))
-//	require.NoError(t, err)
-//	require.Equal(t, pageSize, int64(len(resp.DatumInfos)))
-//	require.Equal(t, int64(int64(numFiles)/pageSize-1), resp.Page)
-//
-//	// Last entry should be success
-//	require.Equal(t, pps.DatumState_SUCCESS, resp.DatumInfos[len(resp.DatumInfos)-1].State)
-//
-//	// Make sure we get error when requesting pages too high
-//	_, err = c.ListDatumAll(jobs[0].Job.ID, pageSize, int64(numPages))
-//	require.YesError(t, err)
-//}
-//
+
+func TestPipelineWithStatsPaginated(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineWithStatsPaginated_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	numPages := int64(2)
+	pageSize := int64(10)
+	numFiles := int(numPages * pageSize)
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, c.PutFile(dataRepo, commit1.ID, fmt.Sprintf("file-%d", i), strings.NewReader(strings.Repeat("foo\n", 100))))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		&pps.ParallelismSpec{
+			Constant: 4,
+		},
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	jobs, err := c.FlushJobAll([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobs))
+
+	listDatumPage := func(page int64, startAfter string) ([]string, int64) {
+		var ids []string
+		total, err := c.ListDatumPage(jobs[0].Job.ID, pageSize, page, startAfter, func(di *pps.DatumInfo) error {
+			ids = append(ids, di.Datum.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		return ids, total
+	}
+	var ids []string
+	for page := int64(0); page < numPages; page++ {
+		pageIDs, total := listDatumPage(page, "")
+		require.Equal(t, int64(numFiles), total)
+		require.Equal(t, int(pageSize), len(pageIDs))
+		ids = append(ids, pageIDs...)
+	}
+	require.True(t, sort.StringsAreSorted(ids))
+	dis, err := c.ListDatumAll(jobs[0].Job.ID)
+	require.NoError(t, err)
+	var allIDs []string
+	for _, di := range dis {
+		allIDs = append(allIDs, di.Datum.ID)
+	}
+	require.ElementsEqual(t, allIDs, ids)
+
+	// Paging with the cursor returns the same pages.
+	pageIDs, _ := listDatumPage(0, ids[pageSize-1])
+	require.Equal(t, ids[pageSize:], pageIDs)
+	pageIDs, _ = listDatumPage(0, ids[len(ids)-1])
+	require.Equal(t, 0, len(pageIDs))
+
+	// Make sure we get error when requesting pages too high
+	_, err = c.ListDatumPage(jobs[0].Job.ID, pageSize, numPages, "", func(*pps.DatumInfo) error { return nil })
+	require.YesError(t, err)
+
+	// Page through the jobs, from newest to oldest.
+	require.NoError(t, c.PutFile(dataRepo, "master", "file-new", strings.NewReader("foo\n")))
+	_, err = c.FlushJobAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	var jobIDs []string
+	require.NoError(t, c.ListJobPageF(pipeline, 1, "", func(ji *pps.JobInfo) error {
+		jobIDs = append(jobIDs, ji.Job.ID)
+		return nil
+	}))
+	require.Equal(t, 1, len(jobIDs))
+	require.NoError(t, c.ListJobPageF(pipeline, 1, jobIDs[0], func(ji *pps.JobInfo) error {
+		jobIDs = append(jobIDs, ji.Job.ID)
+		return nil
+	}))
+	require.Equal(t, []string{jobIDs[0], jobs[0].Job.ID}, jobIDs)
+	// A cursor that isn't one of the pipeline's jobs is an error, rather than
+	// an empty page.
+	err = c.ListJobPageF(pipeline, 1, "nonexistent", func(ji *pps.JobInfo) error { return nil })
+	require.YesError(t, err)
+	require.Matches(t, "not found", err.Error())
+}
+
 //func TestPipelineWithStatsAcrossJobs(t *testing.T) {
 //	// TODO: Change semantics of test.
 //	t.Skip("Stats semantics different in V2")
@@ -6936,8 +6902,6 @@ func TestPipelineEnvVarAlias(t *testing.T) {
 }
 
 func TestMaxQueueSize(t *testing.T) {
-	// TODO: Implement max queue size.
-	t.Skip("Max queue size not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -7007,116 +6971,141 @@ func TestMaxQueueSize(t *testing.T) {
 	}
 }
 
-// TODO: Make work with V2.
-//func TestHTTPAuth(t *testing.T) {
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//	c := tu.GetPachClient(t)
-//
-//	clientAddr := c.GetAddress()
-//	host, _, err := net.SplitHostPort(clientAddr)
-//	require.NoError(t, err)
-//	port, ok := os.LookupEnv("PACHD_SERVICE_PORT_API_HTTP_PORT")
-//	if !ok {
-//		port = "30652" // default NodePort port for Pachd's HTTP API
-//	}
-//	httpAPIAddr := net.JoinHostPort(host, port)
-//
-//	// Try to login
-//	token := "abbazabbadoo"
-//	form := url.Values{}
-//	form.Add("Token", token)
-//	req, err := http.NewRequest("POST", fmt.Sprintf("http://%s/v1/auth/login", httpAPIAddr), strings.NewReader(form.Encode()))
-//	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-//	require.NoError(t, err)
-//	httpClient := &http.Client{}
-//	resp, err := httpClient.Do(req)
-//	require.NoError(t, err)
-//	defer resp.Body.Close()
-//	require.Equal(t, 1, len(resp.Cookies()))
-//	require.Equal(t, auth.ContextTokenKey, resp.Cookies()[0].Name)
-//	require.Equal(t, "*", resp.Header.Get("Access-Control-Allow-Origin"))
-//	require.Equal(t, token, resp.Cookies()[0].Value)
-//
-//	// Try to logout
-//	req, err = http.NewRequest("POST", fmt.Sprintf("http://%s/v1/auth/logout", httpAPIAddr), nil)
-//	require.NoError(t, err)
-//	resp, err = httpClient.Do(req)
-//	require.NoError(t, err)
-//	defer resp.Body.Close()
-//	require.Equal(t, 1, len(resp.Cookies()))
-//	require.Equal(t, auth.ContextTokenKey, resp.Cookies()[0].Name)
-//	require.Equal(t, "*", resp.Header.Get("Access-Control-Allow-Origin"))
-//	// The cookie should be unset now
-//	require.Equal(t, "", resp.Cookies()[0].Value)
-//
-//	// Make sure we get 404s for non existent routes
-//	req, err = http.NewRequest("POST", fmt.Sprintf("http://%s/v1/auth/logoutzz", httpAPIAddr), nil)
-//	require.NoError(t, err)
-//	resp, err = httpClient.Do(req)
-//	require.NoError(t, err)
-//	require.Equal(t, 404, resp.StatusCode)
-//}
-//
-//func TestHTTPGetFile(t *testing.T) {
-//	// TODO: Check if this runs in CI.
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//	c := tu.GetPachClient(t)
-//
-//	dataRepo := tu.UniqueString("TestHTTPGetFile_data")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//
-//	commit1, err := c.StartCommit(dataRepo, "master")
-//	require.NoError(t, err)
-//	require.NoError(t, c.PutFile(dataRepo, commit1.ID, "file", strings.NewReader("foo")))
-//	f, err := os.Open("../../etc/testing/artifacts/giphy.gif")
-//	require.NoError(t, err)
-//	require.NoError(t, c.PutFile(dataRepo, commit1.ID, "giphy.gif", f))
-//	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
-//
-//	clientAddr := c.GetAddress()
-//	host, _, err := net.SplitHostPort(clientAddr)
-//	require.NoError(t, err)
-//	port, ok := os.LookupEnv("PACHD_SERVICE_PORT_API_HTTP_PORT")
-//	if !ok {
-//		port = "30652" // default NodePort port for Pachd's HTTP API
-//	}
-//	httpAPIAddr := net.JoinHostPort(host, port)
-//
-//	// Try to get raw contents
-//	resp, err := http.Get(fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/file", httpAPIAddr, dataRepo, commit1.ID))
-//	require.NoError(t, err)
-//	defer resp.Body.Close()
-//	contents, err := ioutil.ReadAll(resp.Body)
-//	require.NoError(t, err)
-//	require.Equal(t, "foo", string(contents))
-//	contentDisposition := resp.Header.Get("Content-Disposition")
-//	require.Equal(t, "", contentDisposition)
-//
-//	// Try to get file for downloading
-//	resp, err = http.Get(fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/file?download=true", httpAPIAddr, dataRepo, commit1.ID))
-//	require.NoError(t, err)
-//	defer resp.Body.Close()
-//	contents, err = ioutil.ReadAll(resp.Body)
-//	require.NoError(t, err)
-//	require.Equal(t, "foo", string(contents))
-//	contentDisposition = resp.Header.Get("Content-Disposition")
-//	require.Equal(t, "attachment; filename=\"file\"", contentDisposition)
-//
-//	// Make sure MIME type is set
-//	resp, err = http.Get(fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/giphy.gif", httpAPIAddr, dataRepo, commit1.ID))
-//	require.NoError(t, err)
-//	defer resp.Body.Close()
-//	contentDisposition = resp.Header.Get("Content-Type")
-//	require.Equal(t, "image/gif", contentDisposition)
-//}
-
-func TestService(t *testing.T) {
-	// TODO: Implement services.
-	t.Skip("Services not implemented in V2")
+func TestHTTPAuth(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+
+	clientAddr := c.GetAddress()
+	host, _, err := net.SplitHostPort(clientAddr)
+	require.NoError(t, err)
+	port, ok := os.LookupEnv("PACHD_SERVICE_PORT_API_HTTP_PORT")
+	if !ok {
+		port = "30652" // default NodePort port for Pachd's HTTP API
+	}
+	httpAPIAddr := net.JoinHostPort(host, port)
+
+	// Try to login
+	token := "abbazabbadoo"
+	form := url.Values{}
+	form.Add("Token", token)
+	req, err := http.NewRequest("POST", fmt.Sprintf("http://%s/v1/auth/login", httpAPIAddr), strings.NewReader(form.Encode()))
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	require.NoError(t, err)
+	httpClient := &http.Client{}
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, 1, len(resp.Cookies()))
+	require.Equal(t, auth.ContextTokenKey, resp.Cookies()[0].Name)
+	require.Equal(t, token, resp.Cookies()[0].Value)
+	require.True(t, resp.Cookies()[0].HttpOnly)
+	require.Equal(t, http.SameSiteLaxMode, resp.Cookies()[0].SameSite)
+
+	// Try to logout
+	req, err = http.NewRequest("POST", fmt.Sprintf("http://%s/v1/auth/logout", httpAPIAddr), nil)
+	require.NoError(t, err)
+	resp, err = httpClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, 1, len(resp.Cookies()))
+	require.Equal(t, auth.ContextTokenKey, resp.Cookies()[0].Name)
+	// The cookie should be unset now
+	require.Equal(t, "", resp.Cookies()[0].Value)
+	require.True(t, resp.Cookies()[0].MaxAge < 0)
+
+	// Make sure we get 404s for non existent routes
+	req, err = http.NewRequest("POST", fmt.Sprintf("http://%s/v1/auth/logoutzz", httpAPIAddr), nil)
+	require.NoError(t, err)
+	resp, err = httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, 404, resp.StatusCode)
+}
+
+func TestHTTPGetFile(t *testing.T) {
+	// TODO: Check if this runs in CI.
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+
+	dataRepo := tu.UniqueString("TestHTTPGetFile_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(dataRepo, commit1.ID, "file", strings.NewReader("foo")))
+	f, err := os.Open("../../etc/testing/artifacts/giphy.gif")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(dataRepo, commit1.ID, "giphy.gif", f))
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	clientAddr := c.GetAddress()
+	host, _, err := net.SplitHostPort(clientAddr)
+	require.NoError(t, err)
+	port, ok := os.LookupEnv("PACHD_SERVICE_PORT_API_HTTP_PORT")
+	if !ok {
+		port = "30652" // default NodePort port for Pachd's HTTP API
+	}
+	httpAPIAddr := net.JoinHostPort(host, port)
+
+	// Try to get raw contents
+	resp, err := http.Get(fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/file", httpAPIAddr, dataRepo, commit1.ID))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	contents, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(contents))
+	contentDisposition := resp.Header.Get("Content-Disposition")
+	require.Equal(t, "", contentDisposition)
+
+	// Try to get file for downloading
+	resp, err = http.Get(fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/file?download=true", httpAPIAddr, dataRepo, commit1.ID))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	contents, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(contents))
+	contentDisposition = resp.Header.Get("Content-Disposition")
+	require.Equal(t, "attachment; filename=\"file\"", contentDisposition)
+
+	// Make sure MIME type is set
+	resp, err = http.Get(fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/giphy.gif", httpAPIAddr, dataRepo, commit1.ID))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	contentDisposition = resp.Header.Get("Content-Type")
+	require.Equal(t, "image/gif", contentDisposition)
+
+	// Try to get a range of the file
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/file", httpAPIAddr, dataRepo, commit1.ID), nil)
+	require.NoError(t, err)
+	req.Header.Set("Range", "bytes=1-")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+	contents, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "oo", string(contents))
+
+	// Directories are listed as JSON
+	resp, err = http.Get(fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/", httpAPIAddr, dataRepo, commit1.ID))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	var fileInfos []map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&fileInfos))
+	require.Equal(t, 2, len(fileInfos))
+
+	// Missing files are 404s
+	resp, err = http.Get(fmt.Sprintf("http://%s/v1/pfs/repos/%v/commits/%v/files/missing", httpAPIAddr, dataRepo, commit1.ID))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestService(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -7272,108 +7261,107 @@ func TestService(t *testing.T) {
 	}, backoff.NewTestingBackOff()))
 }
 
-// TODO: Make work with V2.
-//func TestServiceEnvVars(t *testing.T) {
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	dataRepo := tu.UniqueString(t.Name() + "-input")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//
-//	require.NoError(t, c.PutFile(dataRepo, "master", "file1", strings.NewReader("foo")))
-//
-//	pipeline := tu.UniqueString("pipelineservice")
-//	_, err := c.PpsAPIClient.CreatePipeline(
-//		c.Ctx(),
-//		&pps.CreatePipelineRequest{
-//			Pipeline: client.NewPipeline(pipeline),
-//			Transform: &pps.Transform{
-//				Image: "trinitronx/python-simplehttpserver",
-//				Cmd:   []string{"sh"},
-//				Stdin: []string{
-//					"echo ${CUSTOM_ENV_VAR} >/pfs/custom_env_var",
-//					"cd /pfs",
-//					"exec python -m SimpleHTTPServer 8000",
-//				},
-//				Env: map[string]string{
-//					"CUSTOM_ENV_VAR": "custom-value",
-//				},
-//			},
-//			ParallelismSpec: &pps.ParallelismSpec{
-//				Constant: 1,
-//			},
-//			Input:  client.NewPFSInput(dataRepo, "/"),
-//			Update: false,
-//			Service: &pps.Service{
-//				InternalPort: 8000,
-//				ExternalPort: 31800,
-//			},
-//		})
-//	require.NoError(t, err)
-//
-//	// Lookup the address for 'pipelineservice' (different inside vs outside k8s)
-//	serviceAddr := func() string {
-//		// Hack: detect if running inside the cluster by looking for this env var
-//		if _, ok := os.LookupEnv("KUBERNETES_PORT"); !ok {
-//			// Outside cluster: Re-use external IP and external port defined above
-//			clientAddr := c.GetAddress()
-//			host, _, err := net.SplitHostPort(clientAddr)
-//			require.NoError(t, err)
-//			return net.JoinHostPort(host, "31800")
-//		}
-//		// Get k8s service corresponding to pachyderm service above--must access
-//		// via internal cluster IP, but we don't know what that is
-//		var address string
-//		kubeClient := tu.GetKubeClient(t)
-//		backoff.Retry(func() error {
-//			svcs, err := kubeClient.CoreV1().Services("default").List(metav1.ListOptions{})
-//			require.NoError(t, err)
-//			for _, svc := range svcs.Items {
-//				// Pachyderm actually generates two services for pipelineservice: one
-//				// for pachyderm (a ClusterIP service) and one for the user container
-//				// (a NodePort service, which is the one we want)
-//				rightName := strings.Contains(svc.Name, "pipelineservice")
-//				rightType := svc.Spec.Type == v1.ServiceTypeNodePort
-//				if !rightName || !rightType {
-//					continue
-//				}
-//				host := svc.Spec.ClusterIP
-//				port := fmt.Sprintf("%d", svc.Spec.Ports[0].Port)
-//				address = net.JoinHostPort(host, port)
-//				return nil
-//			}
-//			return fmt.Errorf("no matching k8s service found")
-//		}, backoff.NewTestingBackOff())
-//
-//		require.NotEqual(t, "", address)
-//		return address
-//	}()
-//
-//	var envValue []byte
-//	require.NoErrorWithinTRetry(t, 2*time.Minute, func() error {
-//		httpC := http.Client{
-//			Timeout: 3 * time.Second, // fail fast
-//		}
-//		resp, err := httpC.Get(fmt.Sprintf("http://%s/custom_env_var", serviceAddr))
-//		if err != nil {
-//			// sleep => don't spam retries. Seems to make test less flaky
-//			time.Sleep(time.Second)
-//			return err
-//		}
-//		if resp.StatusCode != 200 {
-//			return fmt.Errorf("GET returned %d", resp.StatusCode)
-//		}
-//		envValue, err = ioutil.ReadAll(resp.Body)
-//		if err != nil {
-//			return err
-//		}
-//		return nil
-//	})
-//	require.Equal(t, "custom-value", strings.TrimSpace(string(envValue)))
-//}
+func TestServiceEnvVars(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString(t.Name() + "-input")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	require.NoError(t, c.PutFile(dataRepo, "master", "file1", strings.NewReader("foo")))
+
+	pipeline := tu.UniqueString("pipelineservice")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		c.Ctx(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Image: "trinitronx/python-simplehttpserver",
+				Cmd:   []string{"sh"},
+				Stdin: []string{
+					"echo ${CUSTOM_ENV_VAR} >/pfs/custom_env_var",
+					"cd /pfs",
+					"exec python -m SimpleHTTPServer 8000",
+				},
+				Env: map[string]string{
+					"CUSTOM_ENV_VAR": "custom-value",
+				},
+			},
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant: 1,
+			},
+			Input:  client.NewPFSInput(dataRepo, "/"),
+			Update: false,
+			Service: &pps.Service{
+				InternalPort: 8000,
+				ExternalPort: 31800,
+			},
+		})
+	require.NoError(t, err)
+
+	// Lookup the address for 'pipelineservice' (different inside vs outside k8s)
+	serviceAddr := func() string {
+		// Hack: detect if running inside the cluster by looking for this env var
+		if _, ok := os.LookupEnv("KUBERNETES_PORT"); !ok {
+			// Outside cluster: Re-use external IP and external port defined above
+			clientAddr := c.GetAddress()
+			host, _, err := net.SplitHostPort(clientAddr)
+			require.NoError(t, err)
+			return net.JoinHostPort(host, "31800")
+		}
+		// Get k8s service corresponding to pachyderm service above--must access
+		// via internal cluster IP, but we don't know what that is
+		var address string
+		kubeClient := tu.GetKubeClient(t)
+		backoff.Retry(func() error {
+			svcs, err := kubeClient.CoreV1().Services("default").List(metav1.ListOptions{})
+			require.NoError(t, err)
+			for _, svc := range svcs.Items {
+				// Pachyderm actually generates two services for pipelineservice: one
+				// for pachyderm (a ClusterIP service) and one for the user container
+				// (a NodePort service, which is the one we want)
+				rightName := strings.Contains(svc.Name, "pipelineservice")
+				rightType := svc.Spec.Type == v1.ServiceTypeNodePort
+				if !rightName || !rightType {
+					continue
+				}
+				host := svc.Spec.ClusterIP
+				port := fmt.Sprintf("%d", svc.Spec.Ports[0].Port)
+				address = net.JoinHostPort(host, port)
+				return nil
+			}
+			return errors.Errorf("no matching k8s service found")
+		}, backoff.NewTestingBackOff())
+
+		require.NotEqual(t, "", address)
+		return address
+	}()
+
+	var envValue []byte
+	require.NoErrorWithinTRetry(t, 2*time.Minute, func() error {
+		httpC := http.Client{
+			Timeout: 3 * time.Second, // fail fast
+		}
+		resp, err := httpC.Get(fmt.Sprintf("http://%s/custom_env_var", serviceAddr))
+		if err != nil {
+			// sleep => don't spam retries. Seems to make test less flaky
+			time.Sleep(time.Second)
+			return err
+		}
+		if resp.StatusCode != 200 {
+			return errors.Errorf("GET returned %d", resp.StatusCode)
+		}
+		envValue, err = ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	require.Equal(t, "custom-value", strings.TrimSpace(string(envValue)))
+}
 
 func TestChunkSpec(t *testing.T) {
 	if testing.Short() {
@@ -7421,8 +7409,6 @@ func TestChunkSpec(t *testing.T) {
 		}
 	})
 	t.Run("size", func(t *testing.T) {
-		// TODO: Implement size.
-		t.Skip("Chunk spec size not implemented in V2")
 		pipeline := tu.UniqueString("TestChunkSpec")
 		c.PpsAPIClient.CreatePipeline(context.Background(),
 			&pps.CreatePipelineRequest{
@@ -7440,7 +7426,7 @@ func TestChunkSpec(t *testing.T) {
 		commitIter, err := c.FlushCommit([]*pfs.Commit{commit1}, []*pfs.Repo{client.NewRepo(pipeline)})
 		require.NoError(t, err)
 		commitInfos := collectCommitInfos(t, commitIter)
-		require.Equal(t, 1, len(commitInfos))
+		require.Equal(t, 2, len(commitInfos))
 
 		for i := 0; i < numFiles; i++ {
 			var buf bytes.Buffer
@@ -7499,8 +7485,6 @@ func TestLongDatums(t *testing.T) {
 }
 
 func TestPipelineWithGitInputInvalidURLs(t *testing.T) {
-	// TODO: Implement git input.
-	t.Skip("Git input not implemented in V2")
 	if os.Getenv("RUN_BAD_TESTS") == "" {
 		t.Skip("Skipping because RUN_BAD_TESTS was empty")
 	}
@@ -7569,616 +7553,600 @@ func TestPipelineWithGitInputInvalidURLs(t *testing.T) {
 	))
 }
 
-// TODO: Implement git input.
-//func simulateGitPush(t *testing.T, pathToPayload string) {
-//	payload, err := ioutil.ReadFile(pathToPayload)
-//	require.NoError(t, err)
-//	req, err := http.NewRequest(
-//		"POST",
-//		fmt.Sprintf("http://127.0.0.1:%v/v1/handle/push", githook.GitHookPort+30000),
-//		bytes.NewBuffer(payload),
-//	)
-//	require.NoError(t, err)
-//	req.Header.Set("X-Github-Delivery", "2984f5d0-c032-11e7-82d7-ed3ee54be25d")
-//	req.Header.Set("User-Agent", "GitHub-Hookshot/c1d08eb")
-//	req.Header.Set("X-Github-Event", "push")
-//	req.Header.Set("Content-Type", "application/json")
-//
-//	client := &http.Client{}
-//	resp, err := client.Do(req)
-//	require.NoError(t, err)
-//	defer resp.Body.Close()
-//
-//	require.Equal(t, 200, resp.StatusCode)
-//}
-//
-//func TestPipelineWithGitInputPrivateGHRepo(t *testing.T) {
-//	// TODO: Implement git input.
-//	t.Skip("Git input not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	outputFilename := "commitSHA"
-//	pipeline := tu.UniqueString("github_pipeline")
-//	repoName := "pachyderm-dummy"
-//	require.NoError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("cat /pfs/%v/.git/HEAD > /pfs/out/%v", repoName, outputFilename),
-//		},
-//		nil,
-//		&pps.Input{
-//			Git: &pps.GitInput{
-//				URL: fmt.Sprintf("https://github.com/pachyderm/%v.git", repoName),
-//			},
-//		},
-//		"",
-//		false,
-//	))
-//	// There should be a pachyderm repo created w no commits:
-//	repos, err := c.ListRepo()
-//	require.NoError(t, err)
-//	found := false
-//	for _, repo := range repos {
-//		if repo.Repo.Name == repoName {
-//			found = true
-//		}
-//	}
-//	require.Equal(t, true, found)
-//
-//	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/private.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(2 * time.Second)
-//
-//	// Now there should NOT be a new commit on the pachyderm repo
-//	commits, err := c.ListCommit(repoName, "master", "", 0)
-//	require.NoError(t, err)
-//	require.Equal(t, 0, len(commits))
-//
-//	// We should see that the pipeline has failed
-//	pipelineInfo, err := c.InspectPipeline(pipeline)
-//	require.NoError(t, err)
-//	require.Equal(t, pps.PipelineState_PIPELINE_FAILURE, pipelineInfo.State)
-//	require.Equal(t, fmt.Sprintf("unable to clone private github repo (https://github.com/pachyderm/%v.git)", repoName), pipelineInfo.Reason)
-//}
-//
-//func TestPipelineWithGitInputDuplicateNames(t *testing.T) {
-//	t.Skip("Git input not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	outputFilename := "commitSHA"
-//	pipeline := tu.UniqueString("github_pipeline")
-//	//Test same name on one pipeline
-//	require.YesError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("cat /pfs/pachyderm/.git/HEAD > /pfs/out/%v", outputFilename),
-//		},
-//		nil,
-//		&pps.Input{
-//			Cross: []*pps.Input{
-//				&pps.Input{
-//					Git: &pps.GitInput{
-//						URL:  "https://github.com/pachyderm/test-artifacts.git",
-//						Name: "foo",
-//					},
-//				},
-//				&pps.Input{
-//					Git: &pps.GitInput{
-//						URL:  "https://github.com/pachyderm/test-artifacts.git",
-//						Name: "foo",
-//					},
-//				},
-//			},
-//		},
-//		"",
-//		false,
-//	))
-//	//Test same URL on one pipeline
-//	require.YesError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("cat /pfs/pachyderm/.git/HEAD > /pfs/out/%v", outputFilename),
-//		},
-//		nil,
-//		&pps.Input{
-//			Cross: []*pps.Input{
-//				&pps.Input{
-//					Git: &pps.GitInput{
-//						URL: "https://github.com/pachyderm/test-artifacts.git",
-//					},
-//				},
-//				&pps.Input{
-//					Git: &pps.GitInput{
-//						URL: "https://github.com/pachyderm/test-artifacts.git",
-//					},
-//				},
-//			},
-//		},
-//		"",
-//		false,
-//	))
-//	// Test same URL but different names
-//	require.NoError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("cat /pfs/pachyderm/.git/HEAD > /pfs/out/%v", outputFilename),
-//		},
-//		nil,
-//		&pps.Input{
-//			Cross: []*pps.Input{
-//				&pps.Input{
-//					Git: &pps.GitInput{
-//						URL:  "https://github.com/pachyderm/test-artifacts.git",
-//						Name: "foo",
-//					},
-//				},
-//				&pps.Input{
-//					Git: &pps.GitInput{
-//						URL: "https://github.com/pachyderm/test-artifacts.git",
-//					},
-//				},
-//			},
-//		},
-//		"",
-//		false,
-//	))
-//}
-//
-//func TestPipelineWithGitInput(t *testing.T) {
-//	// TODO: Implement git input.
-//	t.Skip("Git input not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	outputFilename := "commitSHA"
-//	pipeline := tu.UniqueString("github_pipeline")
-//	require.NoError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("cat /pfs/test-artifacts/.git/HEAD > /pfs/out/%v", outputFilename),
-//		},
-//		nil,
-//		&pps.Input{
-//			Git: &pps.GitInput{
-//				URL: "https://github.com/pachyderm/test-artifacts.git",
-//			},
-//		},
-//		"",
-//		false,
-//	))
-//	// There should be a pachyderm repo created w no commits:
-//	_, err := c.InspectRepo("test-artifacts")
-//	require.NoError(t, err)
-//
-//	commits, err := c.ListCommit("test-artifacts", "master", "", 0)
-//	require.NoError(t, err)
-//	require.Equal(t, 0, len(commits))
-//
-//	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(2 * time.Second)
-//
-//	// Now there should be a new commit on the pachyderm repo / master branch
-//	branches, err := c.ListBranch("test-artifacts")
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(branches))
-//	require.Equal(t, "master", branches[0].Name)
-//	commit := branches[0].Head
-//
-//	// Now wait for the pipeline complete as normal
-//	outputRepo := client.NewRepo(pipeline)
-//	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{outputRepo})
-//	require.NoError(t, err)
-//	commitInfos := collectCommitInfos(t, commitIter)
-//	require.Equal(t, 1, len(commitInfos))
-//
-//	commit = commitInfos[0].Commit
-//
-//	var buf bytes.Buffer
-//
-//	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
-//	require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
-//}
-//
-//func TestPipelineWithGitInputSequentialPushes(t *testing.T) {
-//	// TODO: Implement git input.
-//	t.Skip("Git input not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	outputFilename := "commitSHA"
-//	pipeline := tu.UniqueString("github_pipeline")
-//	require.NoError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("cat /pfs/test-artifacts/.git/HEAD > /pfs/out/%v", outputFilename),
-//		},
-//		nil,
-//		&pps.Input{
-//			Git: &pps.GitInput{
-//				URL: "https://github.com/pachyderm/test-artifacts.git",
-//			},
-//		},
-//		"",
-//		false,
-//	))
-//	// There should be a pachyderm repo created w no commits:
-//	_, err := c.InspectRepo("test-artifacts")
-//	require.NoError(t, err)
-//
-//	commits, err := c.ListCommit("test-artifacts", "master", "", 0)
-//	require.NoError(t, err)
-//	require.Equal(t, 0, len(commits))
-//
-//	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(2 * time.Second)
-//
-//	// Now there should be a new commit on the pachyderm repo / master branch
-//	branches, err := c.ListBranch("test-artifacts")
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(branches))
-//	require.Equal(t, "master", branches[0].Name)
-//	commit := branches[0].Head
-//
-//	// Now wait for the pipeline complete as normal
-//	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
-//	require.NoError(t, err)
-//	commitInfos := collectCommitInfos(t, commitIter)
-//	require.Equal(t, 1, len(commitInfos))
-//
-//	commit = commitInfos[0].Commit
-//
-//	var buf bytes.Buffer
-//
-//	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
-//	require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
-//
-//	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master-2.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(2 * time.Second)
-//
-//	// Now there should be a new commit on the pachyderm repo / master branch
-//	branches, err = c.ListBranch("test-artifacts")
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(branches))
-//	require.Equal(t, "master", branches[0].Name)
-//	commit = branches[0].Head
-//
-//	// Now wait for the pipeline complete as normal
-//	commitIter, err = c.FlushCommit([]*pfs.Commit{commit}, nil)
-//	require.NoError(t, err)
-//	commitInfos = collectCommitInfos(t, commitIter)
-//	require.Equal(t, 1, len(commitInfos))
-//
-//	commit = commitInfos[0].Commit
-//
-//	buf.Reset()
-//	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
-//	require.Equal(t, "162963b4adf00cd378488abdedc085ba08e21674", strings.TrimSpace(buf.String()))
-//}
-//
-//func TestPipelineWithGitInputCustomName(t *testing.T) {
-//	// TODO: Implement git input.
-//	t.Skip("Git input not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	outputFilename := "commitSHA"
-//	pipeline := tu.UniqueString("github_pipeline")
-//	repoName := "foo"
-//	require.NoError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("cat /pfs/%v/.git/HEAD > /pfs/out/%v", repoName, outputFilename),
-//		},
-//		nil,
-//		&pps.Input{
-//			Git: &pps.GitInput{
-//				URL:  "https://github.com/pachyderm/test-artifacts.git",
-//				Name: repoName,
-//			},
-//		},
-//		"",
-//		false,
-//	))
-//	// There should be a pachyderm repo created w no commits:
-//	_, err := c.InspectRepo(repoName)
-//	require.NoError(t, err)
-//
-//	commits, err := c.ListCommit(repoName, "", "", 0)
-//	require.NoError(t, err)
-//	require.Equal(t, 0, len(commits))
-//
-//	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(2 * time.Second)
-//
-//	// Now there should be a new commit on the pachyderm repo / master branch
-//	branches, err := c.ListBranch(repoName)
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(branches))
-//	require.Equal(t, "master", branches[0].Name)
-//	commit := branches[0].Head
-//
-//	// Now wait for the pipeline complete as normal
-//	outputRepo := client.NewRepo(pipeline)
-//	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{outputRepo})
-//	require.NoError(t, err)
-//	commitInfos := collectCommitInfos(t, commitIter)
-//	require.Equal(t, 1, len(commitInfos))
-//
-//	commit = commitInfos[0].Commit
-//
-//	var buf bytes.Buffer
-//
-//	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
-//	require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
-//}
-//
-//func TestPipelineWithGitInputMultiPipelineSeparateInputs(t *testing.T) {
-//	// TODO: Implement git input.
-//	t.Skip("Git input not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	outputFilename := "commitSHA"
-//	repos := []string{"pachyderm", "foo"}
-//	pipelines := []string{
-//		tu.UniqueString("github_pipeline_a_"),
-//		tu.UniqueString("github_pipeline_b_"),
-//	}
-//	for i, repoName := range repos {
-//		require.NoError(t, c.CreatePipeline(
-//			pipelines[i],
-//			"",
-//			[]string{"bash"},
-//			[]string{
-//				fmt.Sprintf("cat /pfs/%v/.git/HEAD > /pfs/out/%v", repoName, outputFilename),
-//			},
-//			nil,
-//			&pps.Input{
-//				Git: &pps.GitInput{
-//					URL:  "https://github.com/pachyderm/test-artifacts.git",
-//					Name: repoName,
-//				},
-//			},
-//			"",
-//			false,
-//		))
-//		// There should be a pachyderm repo created w no commits:
-//		repos, err := c.ListRepo()
-//		require.NoError(t, err)
-//		found := false
-//		for _, repo := range repos {
-//			if repo.Repo.Name == repoName {
-//				found = true
-//			}
-//		}
-//		require.Equal(t, true, found)
-//
-//		commits, err := c.ListCommit(repoName, "", "", 0)
-//		require.NoError(t, err)
-//		require.Equal(t, 0, len(commits))
-//	}
-//
-//	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(2 * time.Second)
-//
-//	for i, repoName := range repos {
-//		// Now there should be a new commit on the pachyderm repo / master branch
-//		branches, err := c.ListBranch(repoName)
-//		require.NoError(t, err)
-//		require.Equal(t, 1, len(branches))
-//		require.Equal(t, "master", branches[0].Name)
-//		commit := branches[0].Head
-//
-//		// Now wait for the pipeline complete as normal
-//		outputRepo := client.NewRepo(pipelines[i])
-//		commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{outputRepo})
-//		require.NoError(t, err)
-//		commitInfos := collectCommitInfos(t, commitIter)
-//		require.Equal(t, 1, len(commitInfos))
-//
-//		commit = commitInfos[0].Commit
-//
-//		var buf bytes.Buffer
-//
-//		require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
-//		require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
-//	}
-//}
-//
-//func TestPipelineWithGitInputMultiPipelineSameInput(t *testing.T) {
-//	// TODO: Implement git input.
-//	t.Skip("Git input not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	outputFilename := "commitSHA"
-//	repos := []string{"test-artifacts", "test-artifacts"}
-//	pipelines := []string{
-//		tu.UniqueString("github_pipeline_a_"),
-//		tu.UniqueString("github_pipeline_b_"),
-//	}
-//	for i, repoName := range repos {
-//		require.NoError(t, c.CreatePipeline(
-//			pipelines[i],
-//			"",
-//			[]string{"bash"},
-//			[]string{
-//				fmt.Sprintf("cat /pfs/%v/.git/HEAD > /pfs/out/%v", repoName, outputFilename),
-//			},
-//			nil,
-//			&pps.Input{
-//				Git: &pps.GitInput{
-//					URL: "https://github.com/pachyderm/test-artifacts.git",
-//				},
-//			},
-//			"",
-//			false,
-//		))
-//		// There should be a pachyderm repo created w no commits:
-//		repos, err := c.ListRepo()
-//		require.NoError(t, err)
-//		found := false
-//		for _, repo := range repos {
-//			if repo.Repo.Name == repoName {
-//				found = true
-//			}
-//		}
-//		require.Equal(t, true, found)
-//
-//		commits, err := c.ListCommit(repoName, "", "", 0)
-//		require.NoError(t, err)
-//		require.Equal(t, 0, len(commits))
-//	}
-//
-//	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(2 * time.Second)
-//
-//	// Now there should be a new commit on the pachyderm repo / master branch
-//	branches, err := c.ListBranch(repos[0])
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(branches))
-//	require.Equal(t, "master", branches[0].Name)
-//	commit := branches[0].Head
-//
-//	// Now wait for the pipeline complete as normal
-//	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
-//	require.NoError(t, err)
-//	commitInfos := collectCommitInfos(t, commitIter)
-//	require.Equal(t, 2, len(commitInfos))
-//
-//	for _, commitInfo := range commitInfos {
-//		commit = commitInfo.Commit
-//		var buf bytes.Buffer
-//		require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
-//		require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
-//	}
-//}
-//
-//func TestPipelineWithGitInputAndBranch(t *testing.T) {
-//	// TODO: Implement git input.
-//	t.Skip("Git input not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	branchName := "foo"
-//	outputFilename := "commitSHA"
-//	pipeline := tu.UniqueString("github_pipeline")
-//	require.NoError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("cat /pfs/test-artifacts/.git/HEAD > /pfs/out/%v", outputFilename),
-//		},
-//		nil,
-//		&pps.Input{
-//			Git: &pps.GitInput{
-//				URL:    "https://github.com/pachyderm/test-artifacts.git",
-//				Branch: branchName,
-//			},
-//		},
-//		"",
-//		false,
-//	))
-//	// There should be a pachyderm repo created w no commits:
-//	_, err := c.InspectRepo("test-artifacts")
-//	require.NoError(t, err)
-//
-//	// Make sure a push to master does NOT trigger this pipeline
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(5 * time.Second)
-//	// Now there should be a new commit on the pachyderm repo / master branch
-//	_, err = c.InspectBranch("test-artifacts", "master")
-//	require.YesError(t, err)
-//
-//	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
-//	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/branch.json")
-//	// Need to sleep since the webhook http handler is non blocking
-//	time.Sleep(5 * time.Second)
-//	// Now there should be a new commit on the pachyderm repo / master branch
-//	branches, err := c.ListBranch("test-artifacts")
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(branches))
-//	require.Equal(t, branchName, branches[0].Name)
-//	commit := branches[0].Head
-//	require.NotNil(t, commit)
-//
-//	// Now wait for the pipeline complete as normal
-//	outputRepo := client.NewRepo(pipeline)
-//	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{outputRepo})
-//	require.NoError(t, err)
-//	commitInfos := collectCommitInfos(t, commitIter)
-//	require.Equal(t, 1, len(commitInfos))
-//
-//	commit = commitInfos[0].Commit
-//
-//	var buf bytes.Buffer
-//
-//	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
-//	require.Equal(t, "81269575dcfc6ac2e2a463ad8016163f79c97f5c", strings.TrimSpace(buf.String()))
-//}
+func simulateGitPush(t *testing.T, pathToPayload string) {
+	payload, err := ioutil.ReadFile(pathToPayload)
+	require.NoError(t, err)
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("http://127.0.0.1:%v/v1/handle/push", githook.GitHookPort+30000),
+		bytes.NewBuffer(payload),
+	)
+	require.NoError(t, err)
+	req.Header.Set("X-Github-Delivery", "2984f5d0-c032-11e7-82d7-ed3ee54be25d")
+	req.Header.Set("User-Agent", "GitHub-Hookshot/c1d08eb")
+	req.Header.Set("X-Github-Event", "push")
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, 200, resp.StatusCode)
+}
+
+func TestPipelineWithGitInputPrivateGHRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	outputFilename := "commitSHA"
+	pipeline := tu.UniqueString("github_pipeline")
+	repoName := "pachyderm-dummy"
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/%v/.git/HEAD > /pfs/out/%v", repoName, outputFilename),
+		},
+		nil,
+		&pps.Input{
+			Git: &pps.GitInput{
+				URL: fmt.Sprintf("https://github.com/pachyderm/%v.git", repoName),
+			},
+		},
+		"",
+		false,
+	))
+	// There should be a pachyderm repo created w no commits:
+	repos, err := c.ListRepo()
+	require.NoError(t, err)
+	found := false
+	for _, repo := range repos {
+		if repo.Repo.Name == repoName {
+			found = true
+		}
+	}
+	require.Equal(t, true, found)
+
+	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/private.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(2 * time.Second)
+
+	// Now there should NOT be a new commit on the pachyderm repo
+	commits, err := c.ListCommit(repoName, "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commits))
+
+	// We should see that the pipeline has failed
+	pipelineInfo, err := c.InspectPipeline(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, pps.PipelineState_PIPELINE_FAILURE, pipelineInfo.State)
+	require.Equal(t, fmt.Sprintf("unable to clone private github repo (https://github.com/pachyderm/%v.git)", repoName), pipelineInfo.Reason)
+}
+
+func TestPipelineWithGitInputDuplicateNames(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	outputFilename := "commitSHA"
+	pipeline := tu.UniqueString("github_pipeline")
+	//Test same name on one pipeline
+	require.YesError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/pachyderm/.git/HEAD > /pfs/out/%v", outputFilename),
+		},
+		nil,
+		&pps.Input{
+			Cross: []*pps.Input{
+				&pps.Input{
+					Git: &pps.GitInput{
+						URL:  "https://github.com/pachyderm/test-artifacts.git",
+						Name: "foo",
+					},
+				},
+				&pps.Input{
+					Git: &pps.GitInput{
+						URL:  "https://github.com/pachyderm/test-artifacts.git",
+						Name: "foo",
+					},
+				},
+			},
+		},
+		"",
+		false,
+	))
+	//Test same URL on one pipeline
+	require.YesError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/pachyderm/.git/HEAD > /pfs/out/%v", outputFilename),
+		},
+		nil,
+		&pps.Input{
+			Cross: []*pps.Input{
+				&pps.Input{
+					Git: &pps.GitInput{
+						URL: "https://github.com/pachyderm/test-artifacts.git",
+					},
+				},
+				&pps.Input{
+					Git: &pps.GitInput{
+						URL: "https://github.com/pachyderm/test-artifacts.git",
+					},
+				},
+			},
+		},
+		"",
+		false,
+	))
+	// Test same URL but different names
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/pachyderm/.git/HEAD > /pfs/out/%v", outputFilename),
+		},
+		nil,
+		&pps.Input{
+			Cross: []*pps.Input{
+				&pps.Input{
+					Git: &pps.GitInput{
+						URL:  "https://github.com/pachyderm/test-artifacts.git",
+						Name: "foo",
+					},
+				},
+				&pps.Input{
+					Git: &pps.GitInput{
+						URL: "https://github.com/pachyderm/test-artifacts.git",
+					},
+				},
+			},
+		},
+		"",
+		false,
+	))
+}
+
+func TestPipelineWithGitInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	outputFilename := "commitSHA"
+	pipeline := tu.UniqueString("github_pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/test-artifacts/.git/HEAD > /pfs/out/%v", outputFilename),
+		},
+		nil,
+		&pps.Input{
+			Git: &pps.GitInput{
+				URL: "https://github.com/pachyderm/test-artifacts.git",
+			},
+		},
+		"",
+		false,
+	))
+	// There should be a pachyderm repo created w no commits:
+	_, err := c.InspectRepo("test-artifacts")
+	require.NoError(t, err)
+
+	commits, err := c.ListCommit("test-artifacts", "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commits))
+
+	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(2 * time.Second)
+
+	// Now there should be a new commit on the pachyderm repo / master branch
+	branches, err := c.ListBranch("test-artifacts")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(branches))
+	require.Equal(t, "master", branches[0].Name)
+	commit := branches[0].Head
+
+	// Now wait for the pipeline complete as normal
+	outputRepo := client.NewRepo(pipeline)
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{outputRepo})
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	commit = commitInfos[0].Commit
+
+	var buf bytes.Buffer
+
+	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
+	require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
+}
+
+func TestPipelineWithGitInputSequentialPushes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	outputFilename := "commitSHA"
+	pipeline := tu.UniqueString("github_pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/test-artifacts/.git/HEAD > /pfs/out/%v", outputFilename),
+		},
+		nil,
+		&pps.Input{
+			Git: &pps.GitInput{
+				URL: "https://github.com/pachyderm/test-artifacts.git",
+			},
+		},
+		"",
+		false,
+	))
+	// There should be a pachyderm repo created w no commits:
+	_, err := c.InspectRepo("test-artifacts")
+	require.NoError(t, err)
+
+	commits, err := c.ListCommit("test-artifacts", "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commits))
+
+	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(2 * time.Second)
+
+	// Now there should be a new commit on the pachyderm repo / master branch
+	branches, err := c.ListBranch("test-artifacts")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(branches))
+	require.Equal(t, "master", branches[0].Name)
+	commit := branches[0].Head
+
+	// Now wait for the pipeline complete as normal
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	commit = commitInfos[0].Commit
+
+	var buf bytes.Buffer
+
+	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
+	require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
+
+	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master-2.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(2 * time.Second)
+
+	// Now there should be a new commit on the pachyderm repo / master branch
+	branches, err = c.ListBranch("test-artifacts")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(branches))
+	require.Equal(t, "master", branches[0].Name)
+	commit = branches[0].Head
+
+	// Now wait for the pipeline complete as normal
+	commitIter, err = c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos = collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	commit = commitInfos[0].Commit
+
+	buf.Reset()
+	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
+	require.Equal(t, "162963b4adf00cd378488abdedc085ba08e21674", strings.TrimSpace(buf.String()))
+}
+
+func TestPipelineWithGitInputCustomName(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	outputFilename := "commitSHA"
+	pipeline := tu.UniqueString("github_pipeline")
+	repoName := "foo"
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/%v/.git/HEAD > /pfs/out/%v", repoName, outputFilename),
+		},
+		nil,
+		&pps.Input{
+			Git: &pps.GitInput{
+				URL:  "https://github.com/pachyderm/test-artifacts.git",
+				Name: repoName,
+			},
+		},
+		"",
+		false,
+	))
+	// There should be a pachyderm repo created w no commits:
+	_, err := c.InspectRepo(repoName)
+	require.NoError(t, err)
+
+	commits, err := c.ListCommit(repoName, "", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(commits))
+
+	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(2 * time.Second)
+
+	// Now there should be a new commit on the pachyderm repo / master branch
+	branches, err := c.ListBranch(repoName)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(branches))
+	require.Equal(t, "master", branches[0].Name)
+	commit := branches[0].Head
+
+	// Now wait for the pipeline complete as normal
+	outputRepo := client.NewRepo(pipeline)
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{outputRepo})
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	commit = commitInfos[0].Commit
+
+	var buf bytes.Buffer
+
+	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
+	require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
+}
+
+func TestPipelineWithGitInputMultiPipelineSeparateInputs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	outputFilename := "commitSHA"
+	repos := []string{"pachyderm", "foo"}
+	pipelines := []string{
+		tu.UniqueString("github_pipeline_a_"),
+		tu.UniqueString("github_pipeline_b_"),
+	}
+	for i, repoName := range repos {
+		require.NoError(t, c.CreatePipeline(
+			pipelines[i],
+			"",
+			[]string{"bash"},
+			[]string{
+				fmt.Sprintf("cat /pfs/%v/.git/HEAD > /pfs/out/%v", repoName, outputFilename),
+			},
+			nil,
+			&pps.Input{
+				Git: &pps.GitInput{
+					URL:  "https://github.com/pachyderm/test-artifacts.git",
+					Name: repoName,
+				},
+			},
+			"",
+			false,
+		))
+		// There should be a pachyderm repo created w no commits:
+		repos, err := c.ListRepo()
+		require.NoError(t, err)
+		found := false
+		for _, repo := range repos {
+			if repo.Repo.Name == repoName {
+				found = true
+			}
+		}
+		require.Equal(t, true, found)
+
+		commits, err := c.ListCommit(repoName, "", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commits))
+	}
+
+	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(2 * time.Second)
+
+	for i, repoName := range repos {
+		// Now there should be a new commit on the pachyderm repo / master branch
+		branches, err := c.ListBranch(repoName)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(branches))
+		require.Equal(t, "master", branches[0].Name)
+		commit := branches[0].Head
+
+		// Now wait for the pipeline complete as normal
+		outputRepo := client.NewRepo(pipelines[i])
+		commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{outputRepo})
+		require.NoError(t, err)
+		commitInfos := collectCommitInfos(t, commitIter)
+		require.Equal(t, 1, len(commitInfos))
+
+		commit = commitInfos[0].Commit
+
+		var buf bytes.Buffer
+
+		require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
+		require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
+	}
+}
+
+func TestPipelineWithGitInputMultiPipelineSameInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	outputFilename := "commitSHA"
+	repos := []string{"test-artifacts", "test-artifacts"}
+	pipelines := []string{
+		tu.UniqueString("github_pipeline_a_"),
+		tu.UniqueString("github_pipeline_b_"),
+	}
+	for i, repoName := range repos {
+		require.NoError(t, c.CreatePipeline(
+			pipelines[i],
+			"",
+			[]string{"bash"},
+			[]string{
+				fmt.Sprintf("cat /pfs/%v/.git/HEAD > /pfs/out/%v", repoName, outputFilename),
+			},
+			nil,
+			&pps.Input{
+				Git: &pps.GitInput{
+					URL: "https://github.com/pachyderm/test-artifacts.git",
+				},
+			},
+			"",
+			false,
+		))
+		// There should be a pachyderm repo created w no commits:
+		repos, err := c.ListRepo()
+		require.NoError(t, err)
+		found := false
+		for _, repo := range repos {
+			if repo.Repo.Name == repoName {
+				found = true
+			}
+		}
+		require.Equal(t, true, found)
+
+		commits, err := c.ListCommit(repoName, "", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commits))
+	}
+
+	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(2 * time.Second)
+
+	// Now there should be a new commit on the pachyderm repo / master branch
+	branches, err := c.ListBranch(repos[0])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(branches))
+	require.Equal(t, "master", branches[0].Name)
+	commit := branches[0].Head
+
+	// Now wait for the pipeline complete as normal
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 2, len(commitInfos))
+
+	for _, commitInfo := range commitInfos {
+		commit = commitInfo.Commit
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
+		require.Equal(t, "9047fbfc251e7412ef3300868f743f2c24852539", strings.TrimSpace(buf.String()))
+	}
+}
+
+func TestPipelineWithGitInputAndBranch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	branchName := "foo"
+	outputFilename := "commitSHA"
+	pipeline := tu.UniqueString("github_pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cat /pfs/test-artifacts/.git/HEAD > /pfs/out/%v", outputFilename),
+		},
+		nil,
+		&pps.Input{
+			Git: &pps.GitInput{
+				URL:    "https://github.com/pachyderm/test-artifacts.git",
+				Branch: branchName,
+			},
+		},
+		"",
+		false,
+	))
+	// There should be a pachyderm repo created w no commits:
+	_, err := c.InspectRepo("test-artifacts")
+	require.NoError(t, err)
+
+	// Make sure a push to master does NOT trigger this pipeline
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/master.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(5 * time.Second)
+	// Now there should be a new commit on the pachyderm repo / master branch
+	_, err = c.InspectBranch("test-artifacts", "master")
+	require.YesError(t, err)
+
+	// To trigger the pipeline, we'll need to simulate the webhook by pushing a POST payload to the githook server
+	simulateGitPush(t, "../../etc/testing/artifacts/githook-payloads/branch.json")
+	// Need to sleep since the webhook http handler is non blocking
+	time.Sleep(5 * time.Second)
+	// Now there should be a new commit on the pachyderm repo / master branch
+	branches, err := c.ListBranch("test-artifacts")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(branches))
+	require.Equal(t, branchName, branches[0].Name)
+	commit := branches[0].Head
+	require.NotNil(t, commit)
+
+	// Now wait for the pipeline complete as normal
+	outputRepo := client.NewRepo(pipeline)
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, []*pfs.Repo{outputRepo})
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	commit = commitInfos[0].Commit
+
+	var buf bytes.Buffer
+
+	require.NoError(t, c.GetFile(commit.Repo.Name, commit.ID, outputFilename, &buf))
+	require.Equal(t, "81269575dcfc6ac2e2a463ad8016163f79c97f5c", strings.TrimSpace(buf.String()))
+}
 
 func TestPipelineWithDatumTimeout(t *testing.T) {
 	if testing.Short() {
@@ -8247,8 +8215,6 @@ func TestPipelineWithDatumTimeout(t *testing.T) {
 }
 
 func TestListDatumDuringJob(t *testing.T) {
-	// TODO: Implement list open commits.
-	t.Skip("List open commits not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -8303,6 +8269,11 @@ func TestListDatumDuringJob(t *testing.T) {
 	dis, err := c.ListDatumAll(jobInfo.Job.ID)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(dis))
+	// The datum runs until it times out, so it hasn't been processed yet.
+	require.Equal(t, pps.DatumState_STARTING, dis[0].State)
+	di, err := c.InspectDatum(jobInfo.Job.ID, dis[0].Datum.ID)
+	require.NoError(t, err)
+	require.Equal(t, pps.DatumState_STARTING, di.State)
 }
 func TestPipelineWithDatumTimeoutControl(t *testing.T) {
 	if testing.Short() {
@@ -9293,47 +9264,91 @@ func TestRapidUpdatePipelines(t *testing.T) {
 	})
 }
 
-// TODO: Implement logs.
-//func TestDatumTries(t *testing.T) {
-//	t.Skip("Logs not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	dataRepo := tu.UniqueString("TestDatumTries_data")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//
-//	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo")))
-//
-//	tries := int64(5)
-//	pipeline := tu.UniqueString("TestSimplePipeline")
-//	_, err := c.PpsAPIClient.CreatePipeline(
-//		context.Background(),
-//		&pps.CreatePipelineRequest{
-//			Pipeline: client.NewPipeline(pipeline),
-//			Transform: &pps.Transform{
-//				Cmd: []string{"unknown"}, // Cmd fails because "unknown" isn't a known command.
-//			},
-//			Input:      client.NewPFSInput(dataRepo, "/"),
-//			DatumTries: tries,
-//		})
-//	require.NoError(t, err)
-//	jobInfos, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
-//	require.NoError(t, err)
-//	require.Equal(t, 1, len(jobInfos))
-//
-//	iter := c.GetLogs("", jobInfos[0].Job.ID, nil, "", false, false, 0)
-//	var observedTries int64
-//	for iter.Next() {
-//		if strings.Contains(iter.Message().Message, "errored running user code after") {
-//			observedTries++
-//		}
-//	}
-//	require.Equal(t, tries, observedTries)
-//}
+func TestDatumTries(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestDatumTries_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo")))
+
+	tries := int64(5)
+	pipeline := tu.UniqueString("TestSimplePipeline")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"unknown"}, // Cmd fails because "unknown" isn't a known command.
+			},
+			Input:      client.NewPFSInput(dataRepo, "/"),
+			DatumTries: tries,
+		})
+	require.NoError(t, err)
+	jobInfos, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+
+	iter := c.GetLogs("", jobInfos[0].Job.ID, nil, "", false, false, 0)
+	var observedTries int64
+	for iter.Next() {
+		if strings.Contains(iter.Message().Message, "errored running user code after") {
+			observedTries++
+		}
+	}
+	require.Equal(t, tries, observedTries)
+}
+
+func TestInspectDatumLogs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestInspectDatumLogs_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader("foo")))
+
+	pipeline := tu.UniqueString("TestInspectDatumLogs")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			"echo foo",
+			"echo bar >&2",
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	jobInfos, err := c.FlushJobAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+
+	dis, err := c.ListDatumAll(jobInfos[0].Job.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dis))
+	datum, err := c.InspectDatum(jobInfos[0].Job.ID, dis[0].Datum.ID)
+	require.NoError(t, err)
+	var userMessages []string
+	for _, msg := range datum.Logs {
+		require.Equal(t, dis[0].Datum.ID, msg.DatumID)
+		if msg.User {
+			userMessages = append(userMessages, msg.Message)
+		}
+	}
+	require.ElementsEqual(t, []string{"foo", "bar"}, userMessages)
+}
 
 func TestInspectJob(t *testing.T) {
 	if testing.Short() {
@@ -9397,72 +9412,71 @@ func TestPipelineVersions(t *testing.T) {
 	}
 }
 
-// TODO: Implement split?
 // TestSplitFileHeader tests putting data in Pachyderm with delimiter == SQL,
 // and makes sure that every pipeline worker gets a copy of the file header. As
 // well, adding more data with the same header should not change the contents of
 // existing data.
-//func TestSplitFileHeader(t *testing.T) {
-//	t.Skip("Split file header not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	// put a SQL file w/ header
-//	repo := tu.UniqueString("TestSplitFileHeader")
-//	require.NoError(t, c.CreateRepo(repo))
-//	require.NoError(t, c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false, strings.NewReader(tu.TestPGDump)))
-//
-//	// Create a pipeline that roughly validates the header
-//	pipeline := tu.UniqueString("TestSplitFileHeaderPipeline")
-//	require.NoError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"/bin/bash"},
-//		[]string{
-//			`ls /pfs/*/d/*`, // for debugging
-//			`cars_tables="$(grep "CREATE TABLE public.cars" /pfs/*/d/* | sort -u  | wc -l)"`,
-//			`(( cars_tables == 1 )) && exit 0 || exit 1`,
-//		},
-//		&pps.ParallelismSpec{Constant: 1},
-//		client.NewPFSInput(repo, "/d/*"),
-//		"",
-//		false,
-//	))
-//
-//	// wait for job to run & check that all rows were processed
-//	var jobCount int
-//	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
-//		func(jobInfo *pps.JobInfo) error {
-//			jobCount++
-//			require.Equal(t, 1, jobCount)
-//			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
-//			require.Equal(t, int64(5), jobInfo.DataProcessed)
-//			require.Equal(t, int64(0), jobInfo.DataSkipped)
-//			return nil
-//		})
-//
-//	// Add new rows with same header data
-//	require.NoError(t, c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false, strings.NewReader(tu.TestPGDumpNewRows)))
-//
-//	// old data should be skipped, even though header was uploaded twice (new
-//	// header shouldn't append or change the hash or anything)
-//	jobCount = 0
-//	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
-//		func(jobInfo *pps.JobInfo) error {
-//			jobCount++
-//			require.Equal(t, 1, jobCount)
-//			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
-//			require.Equal(t, int64(3), jobInfo.DataProcessed) // added 3 new rows
-//			require.Equal(t, int64(5), jobInfo.DataSkipped)
-//			return nil
-//		})
-//}
+func TestSplitFileHeader(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	// put a SQL file w/ header
+	repo := tu.UniqueString("TestSplitFileHeader")
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false, strings.NewReader(tu.TestPGDump)))
+
+	// Create a pipeline that roughly validates the header
+	pipeline := tu.UniqueString("TestSplitFileHeaderPipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"/bin/bash"},
+		[]string{
+			`ls /pfs/*/d/*`, // for debugging
+			`cars_tables="$(grep "CREATE TABLE public.cars" /pfs/*/d/* | sort -u  | wc -l)"`,
+			`(( cars_tables == 1 )) && exit 0 || exit 1`,
+		},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/d/*"),
+		"",
+		false,
+	))
+
+	// wait for job to run & check that all rows were processed
+	var jobCount int
+	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
+		func(jobInfo *pps.JobInfo) error {
+			jobCount++
+			require.Equal(t, 1, jobCount)
+			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+			require.Equal(t, int64(5), jobInfo.DataProcessed)
+			require.Equal(t, int64(0), jobInfo.DataSkipped)
+			return nil
+		})
+
+	// Add new rows with same header data
+	require.NoError(t, c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false, strings.NewReader(tu.TestPGDumpNewRows)))
+
+	// old data should be skipped, even though header was uploaded twice (new
+	// header shouldn't append or change the hash or anything)
+	jobCount = 0
+	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
+		func(jobInfo *pps.JobInfo) error {
+			jobCount++
+			require.Equal(t, 1, jobCount)
+			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+			require.Equal(t, int64(3), jobInfo.DataProcessed) // added 3 new rows
+			require.Equal(t, int64(5), jobInfo.DataSkipped)
+			return nil
+		})
+}
 
-// TODO: Implement split?
+// TODO: Split files store their own copy of the header in V2, so a new header
+// only applies to the files that are written with it.
 //func TestNewHeaderCausesReprocess(t *testing.T) {
 //	t.Skip("Split file header not implemented in V2")
 //	if testing.Short() {
@@ -9909,60 +9923,58 @@ func TestPipelineHistory(t *testing.T) {
 //	require.Equal(t, 0, len(pis))
 //}
 
-// TODO: Implement file history?
-//func TestFileHistory(t *testing.T) {
-//	t.Skip("File history not implemented in V2")
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	dataRepo1 := tu.UniqueString("TestFileHistory_data1")
-//	require.NoError(t, c.CreateRepo(dataRepo1))
-//	dataRepo2 := tu.UniqueString("TestFileHistory_data2")
-//	require.NoError(t, c.CreateRepo(dataRepo2))
-//
-//	pipeline := tu.UniqueString("TestFileHistory")
-//	require.NoError(t, c.CreatePipeline(
-//		pipeline,
-//		"",
-//		[]string{"bash"},
-//		[]string{
-//			fmt.Sprintf("for a in /pfs/%s/*", dataRepo1),
-//			"do",
-//			fmt.Sprintf("for b in /pfs/%s/*", dataRepo2),
-//			"do",
-//			"touch /pfs/out/$(basename $a)_$(basename $b)",
-//			"done",
-//			"done",
-//		},
-//		&pps.ParallelismSpec{
-//			Constant: 1,
-//		},
-//		client.NewCrossInput(
-//			client.NewPFSInput(dataRepo1, "/*"),
-//			client.NewPFSInput(dataRepo2, "/*"),
-//		),
-//		"",
-//		false,
-//	))
-//
-//	require.NoError(t, c.PutFile(dataRepo1, "master", "A1", strings.NewReader("")))
-//	require.NoError(t, c.PutFile(dataRepo2, "master", "B1", strings.NewReader("")))
-//
-//	require.NoError(t, c.PutFile(dataRepo1, "master", "A2", strings.NewReader("")))
-//	require.NoError(t, c.PutFile(dataRepo1, "master", "A3", strings.NewReader("")))
-//	require.NoError(t, c.PutFile(dataRepo2, "master", "B2", strings.NewReader("")))
-//	require.NoError(t, c.PutFile(dataRepo2, "master", "B3", strings.NewReader("")))
-//
-//	_, err := c.FlushCommitAll([]*pfs.Commit{client.NewCommit(dataRepo1, "master"), client.NewCommit(dataRepo2, "master")}, nil)
-//	require.NoError(t, err)
-//
-//	_, err = c.ListFileHistory(pipeline, "master", "", -1)
-//	require.NoError(t, err)
-//}
+func TestFileHistory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo1 := tu.UniqueString("TestFileHistory_data1")
+	require.NoError(t, c.CreateRepo(dataRepo1))
+	dataRepo2 := tu.UniqueString("TestFileHistory_data2")
+	require.NoError(t, c.CreateRepo(dataRepo2))
+
+	pipeline := tu.UniqueString("TestFileHistory")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("for a in /pfs/%s/*", dataRepo1),
+			"do",
+			fmt.Sprintf("for b in /pfs/%s/*", dataRepo2),
+			"do",
+			"touch /pfs/out/$(basename $a)_$(basename $b)",
+			"done",
+			"done",
+		},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewCrossInput(
+			client.NewPFSInput(dataRepo1, "/*"),
+			client.NewPFSInput(dataRepo2, "/*"),
+		),
+		"",
+		false,
+	))
+
+	require.NoError(t, c.PutFile(dataRepo1, "master", "A1", strings.NewReader("")))
+	require.NoError(t, c.PutFile(dataRepo2, "master", "B1", strings.NewReader("")))
+
+	require.NoError(t, c.PutFile(dataRepo1, "master", "A2", strings.NewReader("")))
+	require.NoError(t, c.PutFile(dataRepo1, "master", "A3", strings.NewReader("")))
+	require.NoError(t, c.PutFile(dataRepo2, "master", "B2", strings.NewReader("")))
+	require.NoError(t, c.PutFile(dataRepo2, "master", "B3", strings.NewReader("")))
+
+	_, err := c.FlushCommitAll([]*pfs.Commit{client.NewCommit(dataRepo1, "master"), client.NewCommit(dataRepo2, "master")}, nil)
+	require.NoError(t, err)
+
+	_, err = c.ListFileHistory(pipeline, "master", "", -1)
+	require.NoError(t, err)
+}
 
 // TestNoOutputRepoDoesntCrashPPSMaster creates a pipeline, then deletes its
 // output repo while it's running (failing the pipeline and preventing the PPS
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	prompt "github.com/c-bata/go-prompt"
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -28,6 +29,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/progress"
 	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tarutil"
 	txncmds "github.com/pachyderm/pachyderm/src/server/transaction/cmds"
 
 	"github.com/spf13/cobra"
@@ -745,6 +747,10 @@ from commits with 'get file'.`,
 	var parallelism int
 	var overwrite bool
 	var compress bool
+	var split string
+	var targetFileDatums uint
+	var targetFileBytes uint
+	var headerRecords uint
 	putFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/to/file>]",
 		Short: "Put a file into the filesystem.",
@@ -780,6 +786,17 @@ $ {{alias}} repo@branch -f http://host/path
 # Put the data from an S3 bucket as repo/branch/s3_object:
 $ {{alias}} repo@branch -r -f s3://my_bucket
 
+# Put a CSV file as files containing one record each in repo/branch/path/:
+$ {{alias}} repo@branch:/path -f file.csv --split csv
+
+# Put a CSV file as files containing 100 records each (plus the header row,
+# which is the first record) in repo/branch/path/:
+$ {{alias}} repo@branch:/path -f file.csv --split csv --target-file-datums 100 --header-records 1
+
+# Put a pg_dump file as files of about 1MB, each of which includes the SQL
+# header and footer, in repo/branch/path/:
+$ {{alias}} repo@branch:/path -f dump.sql --split sql --target-file-bytes 1000000
+
 # Put several files or URLs that are listed in file.
 # Files and URLs should be newline delimited.
 $ {{alias}} repo@branch -i file
@@ -793,6 +810,10 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if err != nil {
 				return err
 			}
+			so, err := parseSplitOptions(split, targetFileDatums, targetFileBytes, headerRecords)
+			if err != nil {
+				return err
+			}
 			opts := []client.Option{client.WithMaxConcurrentStreams(parallelism)}
 			if compress {
 				opts = append(opts, client.WithGZIPCompression())
@@ -865,19 +886,19 @@ $ {{alias}} repo@branch -i http://host/path`,
 						return errors.Errorf("must specify filename when reading data from stdin")
 					}
 					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths("", source), source, recursive, overwrite, limiter)
+						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths("", source), source, recursive, overwrite, so, limiter)
 					})
 				} else if len(sources) == 1 {
 					// We have a single source and the user has specified a path,
 					// we use the path and ignore source (in terms of naming the file).
 					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, file.Path, source, recursive, overwrite, limiter)
+						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, file.Path, source, recursive, overwrite, so, limiter)
 					})
 				} else {
 					// We have multiple sources and the user has specified a path,
 					// we use that path as a prefix for the filepaths.
 					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths(file.Path, source), source, recursive, overwrite, limiter)
+						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths(file.Path, source), source, recursive, overwrite, so, limiter)
 					})
 				}
 			}
@@ -890,6 +911,10 @@ $ {{alias}} repo@branch -i http://host/path`,
 	putFile.Flags().BoolVarP(&compress, "compress", "", false, "Compress data during upload. This parameter might help you upload your uncompressed data, such as CSV files, to Pachyderm faster. Use 'compress' with caution, because if your data is already compressed, this parameter might slow down the upload speed instead of increasing.")
 	putFile.Flags().IntVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be uploaded in parallel.")
 	putFile.Flags().BoolVarP(&overwrite, "overwrite", "o", false, "Overwrite the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
+	putFile.Flags().StringVar(&split, "split", "", "Split the input file into smaller files, subject to the constraints of --target-file-datums and --target-file-bytes. Permissible values are `line`, `json`, `sql` and `csv`.")
+	putFile.Flags().UintVar(&targetFileDatums, "target-file-datums", 0, "The upper bound of the number of datums that each file contains, the last file will contain fewer if the datums don't divide evenly; needs to be used with --split.")
+	putFile.Flags().UintVar(&targetFileBytes, "target-file-bytes", 0, "The target upper bound of the number of bytes that each file contains; needs to be used with --split.")
+	putFile.Flags().UintVar(&headerRecords, "header-records", 0, "The number of records that will be converted to a header, and prepended to every file; needs to be used with --split.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 			if flag == "-f" || flag == "--file" || flag == "-i" || flag == "input-file" {
@@ -948,7 +973,11 @@ $ {{alias}} foo@master^:XXX
 
 # get file "XXX" in the grandparent of the current head of branch "master"
 # in repo "foo"
-$ {{alias}} foo@master^2:XXX`,
+$ {{alias}} foo@master^2:XXX
+
+# get directory "XXX" on branch "master" in repo "foo" and write its
+# contents into the local directory "dir"
+$ {{alias}} -r foo@master:XXX -o dir`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			file, err := cmdutil.ParseFile(args[0])
 			if err != nil {
@@ -959,6 +988,16 @@ $ {{alias}} foo@master^2:XXX`,
 				return err
 			}
 			defer c.Close()
+			if recursive {
+				if outputPath == "" {
+					return errors.Errorf("an output path needs to be specified when using the --recursive flag")
+				}
+				r, err := c.GetTarFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+				if err != nil {
+					return err
+				}
+				return tarutil.Import(outputPath, r)
+			}
 			var w io.Writer
 			// If an output path is given, print the output to stdout
 			if outputPath == "" {
@@ -974,38 +1013,84 @@ $ {{alias}} foo@master^2:XXX`,
 			return c.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, w)
 		}),
 	}
+	getFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively download the files in a directory.")
 	getFile.Flags().StringVarP(&outputPath, "output", "o", "", "The path where data will be downloaded.")
 	shell.RegisterCompletionFunc(getFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(getFile, "get file"))
 
+	var inspectHistory string
 	inspectFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return info about a file.",
 		Long:  "Return info about a file.",
+		Example: `
+# inspect file "foo" on branch "master" in repo "bar"
+$ {{alias}} bar@master:foo
+
+# inspect the last n versions of file "foo", and the commits that wrote them
+$ {{alias}} bar@master:foo --history n
+
+# inspect all versions of file "foo"
+$ {{alias}} bar@master:foo --history all`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			file, err := cmdutil.ParseFile(args[0])
 			if err != nil {
 				return err
 			}
-			c, err := client.NewOnUserMachine("user")
+			history, err := cmdutil.ParseHistory(inspectHistory)
 			if err != nil {
-				return err
+				return errors.Wrapf(err, "error parsing history flag")
 			}
-			defer c.Close()
-			fileInfo, err := c.InspectFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
-			if fileInfo == nil {
-				return errors.Errorf("file %s not found", file.Path)
+			defer c.Close()
+			if history == 0 {
+				fileInfo, err := c.InspectFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+				if err != nil {
+					return err
+				}
+				if fileInfo == nil {
+					return errors.Errorf("file %s not found", file.Path)
+				}
+				if raw {
+					return marshaller.Marshal(os.Stdout, fileInfo)
+				}
+				return pretty.PrintDetailedFileInfo(fileInfo)
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, fileInfo)
+			// Each version is the latest version as of the parent of the
+			// commit that wrote the version before it.
+			commit := file.Commit
+			for n := int64(0); history < 0 || n < history; n++ {
+				fileInfo, err := c.InspectFileHistory(commit.Repo.Name, commit.ID, file.Path, 1)
+				if err != nil {
+					if n > 0 && errutil.IsNotFoundError(err) {
+						return nil
+					}
+					return err
+				}
+				if raw {
+					if err := marshaller.Marshal(os.Stdout, fileInfo); err != nil {
+						return err
+					}
+				} else if err := pretty.PrintDetailedFileInfo(fileInfo); err != nil {
+					return err
+				}
+				commitInfo, err := c.InspectCommit(fileInfo.File.Commit.Repo.Name, fileInfo.File.Commit.ID)
+				if err != nil {
+					return err
+				}
+				if commitInfo.ParentCommit == nil {
+					return nil
+				}
+				commit = commitInfo.ParentCommit
 			}
-			return pretty.PrintDetailedFileInfo(fileInfo)
+			return nil
 		}),
 	}
 	inspectFile.Flags().AddFlagSet(rawFlags)
+	inspectFile.Flags().StringVar(&inspectHistory, "history", "none", "Return the revision history of the file, either a number of versions or 'all'.")
 	shell.RegisterCompletionFunc(inspectFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectFile, "inspect file"))
 
@@ -1049,7 +1134,7 @@ $ {{alias}} foo@master --history all`,
 			}
 			defer c.Close()
 			if raw {
-				return c.ListFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfsclient.FileInfo) error {
+				return c.ListFileHistoryF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, func(fi *pfsclient.FileInfo) error {
 					return marshaller.Marshal(os.Stdout, fi)
 				})
 			}
@@ -1058,7 +1143,7 @@ $ {{alias}} foo@master --history all`,
 				header = pretty.FileHeaderWithCommit
 			}
 			writer := tabwriter.NewWriter(os.Stdout, header)
-			if err := c.ListFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfsclient.FileInfo) error {
+			if err := c.ListFileHistoryF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, func(fi *pfsclient.FileInfo) error {
 				pretty.PrintFileInfo(writer, fi, fullTimestamps, history != 0)
 				return nil
 			}); err != nil {
@@ -1322,6 +1407,80 @@ Tags are a low-level resource and should not be accessed directly by most users.
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
+	storageDocs := &cobra.Command{
+		Short: "Docs for the storage layer.",
+		Long: `The storage layer stores the data in Pachyderm as chunks, which are referenced by filesets.
+
+Temporary filesets and the chunks that are no longer referenced by any fileset
+are deleted by garbage collection once they expire.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(storageDocs, "storage", " storage$"))
+
+	var dryRun bool
+	storageGC := &cobra.Command{
+		Short: "Run garbage collection on the storage layer.",
+		Long:  "Run garbage collection on the storage layer, deleting the expired filesets and chunks that are no longer referenced. With --dry-run, report what would be deleted, and how much space would be reclaimed, without deleting anything.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var count, sizeBytes int64
+			writer := tabwriter.NewWriter(os.Stdout, pretty.StorageObjectHeader)
+			if err := c.GarbageCollectStorage(dryRun, func(resp *pfsclient.GarbageCollectResponse) error {
+				count++
+				sizeBytes += resp.SizeBytes
+				if raw {
+					return marshaller.Marshal(os.Stdout, resp)
+				}
+				pretty.PrintStorageObject(writer, resp)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if raw {
+				return nil
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			verb := "Deleted"
+			if dryRun {
+				verb = "Would delete"
+			}
+			fmt.Printf("%s %d objects (%s).\n", verb, count, units.BytesSize(float64(sizeBytes)))
+			return nil
+		}),
+	}
+	storageGC.Flags().BoolVar(&dryRun, "dry-run", false, "Report the objects that would be deleted without deleting them.")
+	storageGC.Flags().AddFlagSet(rawFlags)
+	commands = append(commands, cmdutil.CreateAlias(storageGC, "storage gc"))
+
+	explainStorageObject := &cobra.Command{
+		Use:   "{{alias}} <object-id>",
+		Short: "Explain why a storage object has not been garbage collected.",
+		Long:  "Explain why a storage object (e.g. chunk/<hash> or fileset/<path>) has not been garbage collected, by listing the chains of references to it from the filesets (and the commits they belong to) that keep it alive.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			explanation, err := c.ExplainStorageObject(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, explanation)
+			}
+			pretty.PrintDetailedStorageObjectExplanation(os.Stdout, explanation)
+			return nil
+		}),
+	}
+	explainStorageObject.Flags().AddFlagSet(rawFlags)
+	commands = append(commands, cmdutil.CreateAlias(explainStorageObject, "storage explain"))
+
 	// Add the mount commands (which aren't available on Windows, so they're in
 	// their own file)
 	commands = append(commands, mountCmds()...)
@@ -1329,7 +1488,34 @@ Tags are a low-level resource and should not be accessed directly by most users.
 	return commands
 }
 
-func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit, path, source string, recursive, overwrite bool, limiter limit.ConcurrencyLimiter) (retErr error) {
+// splitOptions are the options for splitting a file with 'put file --split'.
+type splitOptions struct {
+	delimiter        pfsclient.Delimiter
+	targetFileDatums int64
+	targetFileBytes  int64
+	headerRecords    int64
+}
+
+func parseSplitOptions(split string, targetFileDatums, targetFileBytes, headerRecords uint) (*splitOptions, error) {
+	if split == "" {
+		if targetFileDatums != 0 || targetFileBytes != 0 || headerRecords != 0 {
+			return nil, errors.Errorf("--target-file-datums, --target-file-bytes and --header-records can only be used with --split")
+		}
+		return nil, nil
+	}
+	delimiter, ok := pfsclient.Delimiter_value[strings.ToUpper(split)]
+	if !ok || pfsclient.Delimiter(delimiter) == pfsclient.Delimiter_NONE {
+		return nil, errors.Errorf("unrecognized delimiter '%s'; only accepts one of 'json', 'line', 'sql' or 'csv'", split)
+	}
+	return &splitOptions{
+		delimiter:        pfsclient.Delimiter(delimiter),
+		targetFileDatums: int64(targetFileDatums),
+		targetFileBytes:  int64(targetFileBytes),
+		headerRecords:    int64(headerRecords),
+	}, nil
+}
+
+func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit, path, source string, recursive, overwrite bool, so *splitOptions, limiter limit.ConcurrencyLimiter) (retErr error) {
 	// Resolve the path, then trim any prefixed '../' to avoid sending bad paths
 	// to the server, and convert to unix path in case we're on windows.
 	path = filepath.ToSlash(filepath.Clean(path))
@@ -1337,6 +1523,9 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit,
 		path = strings.TrimPrefix(path, "../")
 	}
 	putFile := func(r io.Reader) error {
+		if so != nil {
+			return pfc.PutFileSplit(repo, commit, path, so.delimiter, so.targetFileDatums, so.targetFileBytes, so.headerRecords, overwrite, r)
+		}
 		if overwrite {
 			return pfc.PutFileOverwrite(repo, commit, path, r)
 		}
@@ -1367,7 +1556,7 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient, repo, commit,
 				// don't do a second recursive 'put file', just put the one file at
 				// filePath into childDest, and then this walk loop will go on to the
 				// next one
-				return putFileHelper(c, pfc, repo, commit, childDest, filePath, false, overwrite, limiter)
+				return putFileHelper(c, pfc, repo, commit, childDest, filePath, false, overwrite, so, limiter)
 			})
 			return nil
 		}); err != nil {
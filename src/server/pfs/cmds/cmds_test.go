@@ -39,9 +39,7 @@ func TestCommit(t *testing.T) {
 	).Run())
 }
 
-// TODO: Make work with V2?
 func TestPutFileSplit(t *testing.T) {
-	t.Skip("Split not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -79,6 +77,39 @@ func TestPutFileSplit(t *testing.T) {
 	).Run())
 }
 
+func TestStorageGC(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	require.NoError(t, tu.BashCmd(`
+		pachctl create repo {{.repo}}
+		commit=$(pachctl start commit {{.repo}}@master)
+		echo -n "{{.content}}" | pachctl put file {{.repo}}@${commit}:/file -f -
+		pachctl finish commit {{.repo}}@${commit}
+
+		# A dry run doesn't delete anything, so a second dry run would delete
+		# the same objects.
+		before=$(pachctl storage gc --dry-run | match "Would delete [0-9]+ objects" | tail -n 1)
+		after=$(pachctl storage gc --dry-run | match "Would delete [0-9]+ objects" | tail -n 1)
+		test "${before}" = "${after}"
+		pachctl storage gc \
+		  | match "Deleted [0-9]+ objects"
+
+		# The file is small enough to be stored in a single chunk, whose ID is
+		# the (truncated) SHA-512 of its content. The chunk survives garbage
+		# collection, as the commit references it.
+		chunk=chunk/$(echo -n "{{.content}}" | sha512sum | cut -c1-64)
+		pachctl storage explain ${chunk} \
+		  | match "Referenced by:" \
+		  | match "commit {{.repo}}@${commit}"
+		pachctl storage explain chunk/0000 \
+		  && exit 1 || true
+		`,
+		"repo", tu.UniqueString("TestStorageGC-repo"),
+		"content", tu.UniqueString("TestStorageGC-content"),
+	).Run())
+}
+
 func TestMountParsing(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
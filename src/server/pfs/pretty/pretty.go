@@ -9,6 +9,7 @@ import (
 
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 )
@@ -28,6 +29,8 @@ const (
 	FileHeaderWithCommit = "COMMIT\tNAME\tTYPE\tCOMMITTED\tSIZE\t\n"
 	// DiffFileHeader is the header for files produced by diff file.
 	DiffFileHeader = "OP\t" + FileHeader
+	// StorageObjectHeader is the header for storage objects.
+	StorageObjectHeader = "ID\tSIZE\t\n"
 )
 
 // PrintRepoInfo pretty-prints repo info.
@@ -253,6 +256,46 @@ Size: {{prettySize .SizeBytes}}
 	return template.Execute(os.Stdout, fileInfo)
 }
 
+// PrintStorageObject pretty-prints a storage object deleted (or, in a dry run,
+// that would be deleted) by garbage collection.
+func PrintStorageObject(w io.Writer, object *pfs.GarbageCollectResponse) {
+	fmt.Fprintf(w, "%s\t", object.Id)
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(object.SizeBytes)))
+	fmt.Fprintln(w)
+}
+
+// PrintDetailedStorageObjectExplanation pretty-prints why a storage object has
+// not been garbage collected.
+func PrintDetailedStorageObjectExplanation(w io.Writer, explanation *pfs.ExplainStorageObjectResponse) {
+	fmt.Fprintf(w, "Object: %s\n", explanation.Object.Id)
+	fmt.Fprintf(w, "Status: %s\n", storageObjectStatus(explanation.Object))
+	if len(explanation.References) == 0 {
+		fmt.Fprintf(w, "Referenced by: nothing\n")
+		return
+	}
+	fmt.Fprintf(w, "Referenced by:\n")
+	for _, ref := range explanation.References {
+		fmt.Fprintf(w, "  %s (%s)", ref.Root.Id, storageObjectStatus(ref.Root))
+		if ref.Commit != nil {
+			fmt.Fprintf(w, " commit %s", CompactPrintCommit(ref.Commit))
+		}
+		fmt.Fprintf(w, "\n    %s\n", strings.Join(ref.Path, " -> "))
+	}
+}
+
+func storageObjectStatus(object *pfs.StorageObjectInfo) string {
+	switch {
+	case object.Tombstone:
+		return "being deleted"
+	case object.Expired:
+		return "expired"
+	case object.ExpiresAt != nil:
+		return fmt.Sprintf("expires %s", types.TimestampString(object.ExpiresAt))
+	default:
+		return "never expires"
+	}
+}
+
 func fileType(fileType pfs.FileType) string {
 	if fileType == pfs.FileType_FILE {
 		return "file"
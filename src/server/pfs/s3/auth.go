@@ -0,0 +1,323 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+const (
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+	sigV4DateFormat = "20060102T150405Z"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// maxClockSkew is how far the time a request was signed may be from the
+	// gateway's clock.
+	maxClockSkew = 15 * time.Minute
+	// maxPresignExpiry is the longest that a presigned URL may be valid for.
+	maxPresignExpiry = 7 * 24 * time.Hour
+)
+
+// verifySignature verifies the signature of a request that is signed with an
+// access key, using the access key (the Pachyderm auth token) as the secret
+// key. Signature V4 and V2 are supported, both in the Authorization header and
+// in presigned URLs. The payload of a request is not verified against its
+// signed hash, and neither are the chunk signatures of streamed payloads.
+func verifySignature(r *http.Request, secretKey string, now time.Time) error {
+	if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+		switch {
+		case strings.HasPrefix(authHeader, sigV4Algorithm+" "):
+			return verifyV4Header(r, strings.TrimPrefix(authHeader, sigV4Algorithm+" "), secretKey, now)
+		case strings.HasPrefix(authHeader, "AWS "):
+			return verifyV2Header(r, strings.TrimPrefix(authHeader, "AWS "), secretKey, now)
+		}
+		return authorizationHeaderMalformedError("unsupported authorization type")
+	}
+	q := r.URL.Query()
+	if q.Get("X-Amz-Credential") != "" {
+		return verifyV4Query(r, secretKey, now)
+	}
+	if q.Get("AWSAccessKeyId") != "" {
+		return verifyV2Query(r, secretKey, now)
+	}
+	return nil
+}
+
+func verifyV4Header(r *http.Request, authHeader, secretKey string, now time.Time) error {
+	fields := make(map[string]string)
+	for _, field := range strings.Split(authHeader, ",") {
+		kv := strings.SplitN(strings.TrimSpace(field), "=", 2)
+		if len(kv) != 2 {
+			return authorizationHeaderMalformedError("malformed field %q", field)
+		}
+		fields[kv[0]] = kv[1]
+	}
+	amzDate := r.Header.Get("X-Amz-Date")
+	if amzDate == "" {
+		date, err := http.ParseTime(r.Header.Get("Date"))
+		if err != nil {
+			return accessDeniedError(errors.New("the request must include an X-Amz-Date or Date header"))
+		}
+		amzDate = date.UTC().Format(sigV4DateFormat)
+	}
+	t, err := time.Parse(sigV4DateFormat, amzDate)
+	if err != nil {
+		return accessDeniedError(errors.Errorf("invalid request date %q", amzDate))
+	}
+	if t.Sub(now) > maxClockSkew || now.Sub(t) > maxClockSkew {
+		return requestTimeTooSkewedError()
+	}
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	if payloadHash == "" {
+		payloadHash = unsignedPayload
+	}
+	return checkV4Signature(r, fields["Credential"], fields["SignedHeaders"], fields["Signature"], amzDate, payloadHash, secretKey)
+}
+
+func verifyV4Query(r *http.Request, secretKey string, now time.Time) error {
+	q := r.URL.Query()
+	if q.Get("X-Amz-Algorithm") != sigV4Algorithm {
+		return authorizationQueryParametersError("unsupported algorithm %q", q.Get("X-Amz-Algorithm"))
+	}
+	amzDate := q.Get("X-Amz-Date")
+	t, err := time.Parse(sigV4DateFormat, amzDate)
+	if err != nil {
+		return authorizationQueryParametersError("invalid X-Amz-Date %q", amzDate)
+	}
+	expires, err := strconv.ParseInt(q.Get("X-Amz-Expires"), 10, 64)
+	if err != nil || expires < 0 || time.Duration(expires)*time.Second > maxPresignExpiry {
+		return authorizationQueryParametersError("invalid X-Amz-Expires %q", q.Get("X-Amz-Expires"))
+	}
+	if t.Sub(now) > maxClockSkew {
+		return requestTimeTooSkewedError()
+	}
+	if now.After(t.Add(time.Duration(expires) * time.Second)) {
+		return accessDeniedError(errors.New("request has expired"))
+	}
+	payloadHash := q.Get("X-Amz-Content-Sha256")
+	if payloadHash == "" {
+		payloadHash = unsignedPayload
+	}
+	return checkV4Signature(r, q.Get("X-Amz-Credential"), q.Get("X-Amz-SignedHeaders"), q.Get("X-Amz-Signature"), amzDate, payloadHash, secretKey)
+}
+
+func checkV4Signature(r *http.Request, credential, signedHeaders, signature, amzDate, payloadHash, secretKey string) error {
+	// The credential is <access key>/<date>/<region>/<service>/aws4_request.
+	scope := strings.SplitN(credential, "/", 2)
+	if len(scope) != 2 || len(strings.Split(scope[1], "/")) != 4 || !strings.HasSuffix(scope[1], "/aws4_request") {
+		return authorizationHeaderMalformedError("malformed credential %q", credential)
+	}
+	if !strings.HasPrefix(scope[1], amzDate[:8]+"/") {
+		return authorizationHeaderMalformedError("the credential date does not match the request date")
+	}
+	if signedHeaders == "" || signature == "" {
+		return authorizationHeaderMalformedError("missing signed headers or signature")
+	}
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		uriEncode(r.URL.Path, false),
+		canonicalQuery(r.URL.Query()),
+		canonicalHeaders(r, strings.Split(signedHeaders, ";")),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		amzDate,
+		scope[1],
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+	key := []byte("AWS4" + secretKey)
+	for _, s := range strings.Split(scope[1], "/") {
+		key = hmacSum(sha256.New, key, s)
+	}
+	expected := hex.EncodeToString(hmacSum(sha256.New, key, stringToSign))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return signatureDoesNotMatchError()
+	}
+	return nil
+}
+
+// canonicalQuery returns the sorted, encoded query parameters of a request,
+// excluding the signature.
+func canonicalQuery(q url.Values) string {
+	var keys []string
+	for k := range q {
+		if k != "X-Amz-Signature" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, k := range keys {
+		for _, v := range q[k] {
+			params = append(params, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// canonicalHeaders returns the signed headers of a request, one per line.
+func canonicalHeaders(r *http.Request, signedHeaders []string) string {
+	var b strings.Builder
+	for _, h := range signedHeaders {
+		var values []string
+		switch h {
+		case "host":
+			values = []string{r.Host}
+		case "content-length":
+			// The server removes the Content-Length header from chunked
+			// requests.
+			values = r.Header.Values(h)
+			if len(values) == 0 && r.ContentLength >= 0 {
+				values = []string{strconv.FormatInt(r.ContentLength, 10)}
+			}
+		case "transfer-encoding":
+			values = r.TransferEncoding
+		default:
+			values = r.Header.Values(h)
+		}
+		for i, v := range values {
+			values[i] = strings.Join(strings.Fields(v), " ")
+		}
+		b.WriteString(h + ":" + strings.Join(values, ",") + "\n")
+	}
+	return b.String()
+}
+
+func verifyV2Header(r *http.Request, authHeader, secretKey string, now time.Time) error {
+	// The header is <access key>:<signature>.
+	i := strings.LastIndex(authHeader, ":")
+	if i < 0 {
+		return authorizationHeaderMalformedError("missing signature")
+	}
+	date := r.Header.Get("X-Amz-Date")
+	if date == "" {
+		date = r.Header.Get("Date")
+	}
+	t, err := http.ParseTime(date)
+	if err != nil {
+		return accessDeniedError(errors.New("the request must include an X-Amz-Date or Date header"))
+	}
+	if t.Sub(now) > maxClockSkew || now.Sub(t) > maxClockSkew {
+		return requestTimeTooSkewedError()
+	}
+	// The Date header isn't signed when the X-Amz-Date header is (as it's
+	// one of the signed amz headers).
+	date = r.Header.Get("Date")
+	if r.Header.Get("X-Amz-Date") != "" {
+		date = ""
+	}
+	return checkV2Signature(r, date, authHeader[i+1:], secretKey)
+}
+
+func verifyV2Query(r *http.Request, secretKey string, now time.Time) error {
+	q := r.URL.Query()
+	expires, err := strconv.ParseInt(q.Get("Expires"), 10, 64)
+	if err != nil {
+		return authorizationQueryParametersError("invalid Expires %q", q.Get("Expires"))
+	}
+	if now.After(time.Unix(expires, 0)) {
+		return accessDeniedError(errors.New("request has expired"))
+	}
+	return checkV2Signature(r, q.Get("Expires"), q.Get("Signature"), secretKey)
+}
+
+// subresources are the query parameters that are included in the resource
+// that is signed with signature V2, in sorted order.
+var subresources = []string{
+	"acl",
+	"delete",
+	"lifecycle",
+	"location",
+	"logging",
+	"notification",
+	"partNumber",
+	"policy",
+	"requestPayment",
+	"response-cache-control",
+	"response-content-disposition",
+	"response-content-encoding",
+	"response-content-language",
+	"response-content-type",
+	"response-expires",
+	"torrent",
+	"uploadId",
+	"uploads",
+	"versionId",
+	"versioning",
+	"versions",
+	"website",
+}
+
+func checkV2Signature(r *http.Request, date, signature, secretKey string) error {
+	var b strings.Builder
+	b.WriteString(r.Method + "\n")
+	b.WriteString(r.Header.Get("Content-Md5") + "\n")
+	b.WriteString(r.Header.Get("Content-Type") + "\n")
+	b.WriteString(date + "\n")
+	// The amz headers.
+	var amzHeaders []string
+	for k := range r.Header {
+		if k := strings.ToLower(k); strings.HasPrefix(k, "x-amz-") {
+			amzHeaders = append(amzHeaders, k)
+		}
+	}
+	sort.Strings(amzHeaders)
+	for _, k := range amzHeaders {
+		b.WriteString(k + ":" + strings.Join(r.Header.Values(k), ",") + "\n")
+	}
+	// The resource.
+	b.WriteString(uriEncode(r.URL.Path, false))
+	q := r.URL.Query()
+	sep := "?"
+	for _, k := range subresources {
+		vs, ok := q[k]
+		if !ok {
+			continue
+		}
+		b.WriteString(sep + k)
+		if len(vs) > 0 && vs[0] != "" {
+			b.WriteString("=" + vs[0])
+		}
+		sep = "&"
+	}
+	expected := base64.StdEncoding.EncodeToString(hmacSum(sha1.New, []byte(secretKey), b.String()))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return signatureDoesNotMatchError()
+	}
+	return nil
+}
+
+func hmacSum(h func() hash.Hash, key []byte, data string) []byte {
+	mac := hmac.New(h, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// uriEncode percent-encodes every byte of s except for the unreserved
+// characters (and slashes, unless encodeSlash is set), as AWS signatures
+// require.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' || c == '/' && !encodeSlash {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+	}
+	return b.String()
+}
@@ -0,0 +1,266 @@
+package s3
+
+import (
+	"encoding/hex"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	pfsServer "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+const (
+	defaultMaxKeys = 1000
+	// timeFormat is the format of the timestamps in S3 XML documents.
+	timeFormat = "2006-01-02T15:04:05.000Z"
+)
+
+type owner struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName"`
+}
+
+var defaultOwner = owner{ID: "pachyderm", DisplayName: "pachyderm"}
+
+type bucketInfo struct {
+	Name         string `xml:"Name"`
+	CreationDate string `xml:"CreationDate"`
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name     `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListAllMyBucketsResult"`
+	Owner   owner        `xml:"Owner"`
+	Buckets []bucketInfo `xml:"Buckets>Bucket"`
+}
+
+func listBuckets(pc *client.APIClient, w http.ResponseWriter, r *http.Request) error {
+	repoInfos, err := pc.ListRepo()
+	if err != nil {
+		return err
+	}
+	result := &listAllMyBucketsResult{Owner: defaultOwner}
+	for _, repoInfo := range repoInfos {
+		if repoInfo.Repo.Name == multipartRepo {
+			continue
+		}
+		for _, branch := range repoInfo.Branches {
+			result.Buckets = append(result.Buckets, bucketInfo{
+				Name:         branch.Name + "." + repoInfo.Repo.Name,
+				CreationDate: formatTimestamp(repoInfo.Created),
+			})
+		}
+	}
+	writeXML(w, r, http.StatusOK, result)
+	return nil
+}
+
+// checkBucket checks that the PFS branch or commit that the bucket refers to
+// exists.
+func checkBucket(pc *client.APIClient, b *bucket) error {
+	var err error
+	if b.branch {
+		_, err = pc.InspectBranch(b.repo, b.commit)
+	} else {
+		_, err = pc.InspectCommit(b.repo, b.commit)
+	}
+	if err != nil {
+		if pfsServer.IsRepoNotFoundErr(err) || pfsServer.IsBranchNotFoundErr(err) || pfsServer.IsCommitNotFoundErr(err) {
+			return noSuchBucketError(b.name)
+		}
+		return err
+	}
+	return nil
+}
+
+type locationConstraint struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LocationConstraint"`
+	Location string   `xml:",chardata"`
+}
+
+func getBucketLocation(pc *client.APIClient, w http.ResponseWriter, r *http.Request, b *bucket) error {
+	if err := checkBucket(pc, b); err != nil {
+		return err
+	}
+	// An empty location constraint is the default region (us-east-1).
+	writeXML(w, r, http.StatusOK, &locationConstraint{})
+	return nil
+}
+
+func headBucket(pc *client.APIClient, w http.ResponseWriter, b *bucket) error {
+	if err := checkBucket(pc, b); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+type object struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         uint64 `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+	Owner        *owner `xml:"Owner,omitempty"`
+}
+
+type commonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type listBucketResult struct {
+	XMLName        xml.Name       `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name           string         `xml:"Name"`
+	Prefix         string         `xml:"Prefix"`
+	Delimiter      string         `xml:"Delimiter,omitempty"`
+	MaxKeys        int            `xml:"MaxKeys"`
+	IsTruncated    bool           `xml:"IsTruncated"`
+	Contents       []object       `xml:"Contents"`
+	CommonPrefixes []commonPrefix `xml:"CommonPrefixes"`
+	// V1 listing fields.
+	Marker     string `xml:"Marker,omitempty"`
+	NextMarker string `xml:"NextMarker,omitempty"`
+	// V2 listing fields.
+	KeyCount              *int   `xml:"KeyCount,omitempty"`
+	StartAfter            string `xml:"StartAfter,omitempty"`
+	ContinuationToken     string `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string `xml:"NextContinuationToken,omitempty"`
+}
+
+// listObjects implements both versions of ListObjects. Continuation tokens and
+// markers are the last key of the previous page.
+func listObjects(pc *client.APIClient, w http.ResponseWriter, r *http.Request, b *bucket) error {
+	q := r.URL.Query()
+	v2 := q.Get("list-type") == "2"
+	prefix := q.Get("prefix")
+	delimiter := q.Get("delimiter")
+	if delimiter != "" && delimiter != "/" {
+		return invalidArgumentError("only the '/' delimiter is supported")
+	}
+	maxKeys := defaultMaxKeys
+	if maxKeysStr := q.Get("max-keys"); maxKeysStr != "" {
+		var err error
+		maxKeys, err = strconv.Atoi(maxKeysStr)
+		if err != nil || maxKeys < 0 {
+			return invalidArgumentError("invalid max-keys %q", maxKeysStr)
+		}
+	}
+	result := &listBucketResult{
+		Name:      b.name,
+		Prefix:    prefix,
+		Delimiter: delimiter,
+		MaxKeys:   maxKeys,
+	}
+	var marker string
+	if v2 {
+		result.StartAfter = q.Get("start-after")
+		result.ContinuationToken = q.Get("continuation-token")
+		marker = result.StartAfter
+		if result.ContinuationToken != "" {
+			marker = result.ContinuationToken
+		}
+	} else {
+		result.Marker = q.Get("marker")
+		marker = result.Marker
+	}
+	if err := checkBucket(pc, b); err != nil {
+		return err
+	}
+	var lastKey string
+	if err := walkObjects(pc, b, prefix, delimiter == "/", func(key string, fi *pfs.FileInfo) error {
+		if key <= marker {
+			return nil
+		}
+		if len(result.Contents)+len(result.CommonPrefixes) >= maxKeys {
+			result.IsTruncated = true
+			return errutil.ErrBreak
+		}
+		lastKey = key
+		if fi.FileType == pfs.FileType_DIR {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: key})
+			return nil
+		}
+		result.Contents = append(result.Contents, object{
+			Key:          key,
+			LastModified: formatTimestamp(fi.Committed),
+			ETag:         etag(fi),
+			Size:         fi.SizeBytes,
+			StorageClass: "STANDARD",
+			Owner:        &defaultOwner,
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+	if result.IsTruncated {
+		if v2 {
+			result.NextContinuationToken = lastKey
+		} else {
+			result.NextMarker = lastKey
+		}
+	}
+	if v2 {
+		keyCount := len(result.Contents) + len(result.CommonPrefixes)
+		result.KeyCount = &keyCount
+	}
+	writeXML(w, r, http.StatusOK, result)
+	return nil
+}
+
+// walkObjects calls cb, in lexicographic order, with the keys under the prefix.
+// If delimit is set, only the keys at the level of the prefix are returned,
+// and directories are returned as common prefixes (with a trailing '/').
+func walkObjects(pc *client.APIClient, b *bucket, prefix string, delimit bool, cb func(string, *pfs.FileInfo) error) error {
+	dir := "/" + prefix[:strings.LastIndex(prefix, "/")+1]
+	filter := func(fi *pfs.FileInfo) error {
+		key := strings.TrimPrefix(fi.File.Path, "/")
+		if fi.FileType == pfs.FileType_DIR {
+			if !delimit || key == "" {
+				return nil
+			}
+			if !strings.HasSuffix(key, "/") {
+				key += "/"
+			}
+		}
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		return cb(key, fi)
+	}
+	var err error
+	if delimit {
+		err = pc.ListFile(b.repo, b.commit, dir, filter)
+	} else {
+		err = pc.WalkFile(b.repo, b.commit, dir, filter)
+	}
+	if err != nil && (pfsServer.IsFileNotFoundErr(err) || pfsServer.IsNoHeadErr(err)) {
+		return nil
+	}
+	return err
+}
+
+func etag(fi *pfs.FileInfo) string {
+	return "\"" + hex.EncodeToString(fi.Hash) + "\""
+}
+
+func formatTimestamp(timestamp *types.Timestamp) string {
+	t, err := types.TimestampFromProto(timestamp)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(timeFormat)
+}
+
+func parseTimestamp(timestamp *types.Timestamp) (time.Time, error) {
+	t, err := types.TimestampFromProto(timestamp)
+	if err != nil {
+		return time.Time{}, errors.EnsureStack(err)
+	}
+	return t.UTC(), nil
+}
@@ -0,0 +1,128 @@
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	pfsServer "github.com/pachyderm/pachyderm/src/server/pfs"
+	log "github.com/sirupsen/logrus"
+)
+
+// Error is an S3 error, which is returned to the client as an XML document.
+type Error struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+
+	httpStatus int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func newError(httpStatus int, code, format string, args ...interface{}) *Error {
+	return &Error{
+		Code:       code,
+		Message:    fmt.Sprintf(format, args...),
+		httpStatus: httpStatus,
+	}
+}
+
+func accessDeniedError(err error) *Error {
+	return newError(http.StatusForbidden, "AccessDenied", "%v", err)
+}
+
+func authorizationHeaderMalformedError(format string, args ...interface{}) *Error {
+	return newError(http.StatusBadRequest, "AuthorizationHeaderMalformed", format, args...)
+}
+
+func authorizationQueryParametersError(format string, args ...interface{}) *Error {
+	return newError(http.StatusBadRequest, "AuthorizationQueryParametersError", format, args...)
+}
+
+func internalError(err error) *Error {
+	return newError(http.StatusInternalServerError, "InternalError", "%v", err)
+}
+
+func invalidArgumentError(format string, args ...interface{}) *Error {
+	return newError(http.StatusBadRequest, "InvalidArgument", format, args...)
+}
+
+func invalidPartError(partNumber int) *Error {
+	return newError(http.StatusBadRequest, "InvalidPart", "part %d could not be found", partNumber)
+}
+
+func invalidPartOrderError() *Error {
+	return newError(http.StatusBadRequest, "InvalidPartOrder", "the list of parts was not in ascending order")
+}
+
+func invalidRangeError(size int64) *Error {
+	return newError(http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "the requested range is not satisfiable for an object of size %d", size)
+}
+
+func malformedXMLError(err error) *Error {
+	return newError(http.StatusBadRequest, "MalformedXML", "%v", err)
+}
+
+func methodNotAllowedError(format string, args ...interface{}) *Error {
+	return newError(http.StatusMethodNotAllowed, "MethodNotAllowed", format, args...)
+}
+
+func requestTimeTooSkewedError() *Error {
+	return newError(http.StatusForbidden, "RequestTimeTooSkewed", "the difference between the request time and the server's time is too large")
+}
+
+func signatureDoesNotMatchError() *Error {
+	return newError(http.StatusForbidden, "SignatureDoesNotMatch", "the request signature does not match the signature calculated with the access key")
+}
+
+func noSuchBucketError(bucket string) *Error {
+	return newError(http.StatusNotFound, "NoSuchBucket", "the bucket %q does not exist", bucket)
+}
+
+func noSuchKeyError(key string) *Error {
+	return newError(http.StatusNotFound, "NoSuchKey", "the key %q does not exist", key)
+}
+
+func noSuchUploadError(uploadID string) *Error {
+	return newError(http.StatusNotFound, "NoSuchUpload", "the multipart upload %q does not exist", uploadID)
+}
+
+func notImplementedError(r *http.Request) *Error {
+	return newError(http.StatusNotImplemented, "NotImplemented", "%s %s is not implemented", r.Method, r.URL.Path)
+}
+
+// convertError converts an error returned by pachd into an S3 error.
+func convertError(err error) *Error {
+	var s3Err *Error
+	switch {
+	case errors.As(err, &s3Err):
+		return s3Err
+	case auth.IsErrNotSignedIn(err), auth.IsErrBadToken(err), auth.IsErrExpiredToken(err), auth.IsErrNotAuthorized(err):
+		return accessDeniedError(err)
+	case pfsServer.IsRepoNotFoundErr(err), pfsServer.IsBranchNotFoundErr(err), pfsServer.IsCommitNotFoundErr(err):
+		return newError(http.StatusNotFound, "NoSuchBucket", "%v", err)
+	case pfsServer.IsFileNotFoundErr(err), pfsServer.IsNoHeadErr(err):
+		return newError(http.StatusNotFound, "NoSuchKey", "%v", err)
+	default:
+		return internalError(err)
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	s3Err := convertError(err)
+	s3Err.Resource = r.URL.Path
+	if s3Err.httpStatus == http.StatusInternalServerError {
+		log.Errorf("s3gateway: %s %s: %v", r.Method, r.URL.Path, err)
+	}
+	if r.Method == http.MethodHead {
+		w.WriteHeader(s3Err.httpStatus)
+		return
+	}
+	writeXML(w, r, s3Err.httpStatus, s3Err)
+}
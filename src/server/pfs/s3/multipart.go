@@ -0,0 +1,207 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	pfsServer "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+const (
+	// multipartRepo is the repo that stores the parts of in-progress multipart
+	// uploads. Each upload is stored in a directory named after its upload ID,
+	// which contains the parts and a file that records the destination.
+	multipartRepo   = "_s3gateway_multipart_"
+	multipartBranch = "master"
+	destinationFile = "destination"
+	maxPartNumber   = 10000
+)
+
+func uploadDir(uploadID string) string {
+	return "/" + uploadID + "/"
+}
+
+func partPath(uploadID string, partNumber int) string {
+	return path.Join("/", uploadID, fmt.Sprintf("%05d", partNumber))
+}
+
+func destination(b *bucket, key string) string {
+	return path.Join(b.name, key)
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ InitiateMultipartUploadResult"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadID string   `xml:"UploadId"`
+}
+
+func initMultipart(pc *client.APIClient, w http.ResponseWriter, r *http.Request, b *bucket, key string) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	if err := checkBucket(pc, b); err != nil {
+		return err
+	}
+	if err := pc.CreateRepo(multipartRepo); err != nil && !pfsServer.IsRepoExistsErr(err) {
+		return err
+	}
+	uploadID := uuid.NewWithoutDashes()
+	if err := pc.PutFileOverwrite(multipartRepo, multipartBranch, path.Join(uploadDir(uploadID), destinationFile), strings.NewReader(destination(b, key))); err != nil {
+		return err
+	}
+	writeXML(w, r, http.StatusOK, &initiateMultipartUploadResult{
+		Bucket:   b.name,
+		Key:      key,
+		UploadID: uploadID,
+	})
+	return nil
+}
+
+// checkUpload checks that the multipart upload exists, and is for the given
+// bucket and key.
+func checkUpload(pc *client.APIClient, b *bucket, key, uploadID string) error {
+	if !uuid.IsUUIDWithoutDashes(uploadID) {
+		return noSuchUploadError(uploadID)
+	}
+	buf := &bytes.Buffer{}
+	if err := pc.GetFile(multipartRepo, multipartBranch, path.Join(uploadDir(uploadID), destinationFile), buf); err != nil {
+		if pfsServer.IsRepoNotFoundErr(err) || pfsServer.IsFileNotFoundErr(err) || pfsServer.IsNoHeadErr(err) {
+			return noSuchUploadError(uploadID)
+		}
+		return err
+	}
+	if buf.String() != destination(b, key) {
+		return noSuchUploadError(uploadID)
+	}
+	return nil
+}
+
+func uploadPart(pc *client.APIClient, w http.ResponseWriter, r *http.Request, b *bucket, key, uploadID string) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	partNumber, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > maxPartNumber {
+		return invalidArgumentError("part number must be an integer between 1 and %d", maxPartNumber)
+	}
+	if err := checkUpload(pc, b, key, uploadID); err != nil {
+		return err
+	}
+	hash := md5.New()
+	if err := pc.PutFileOverwrite(multipartRepo, multipartBranch, partPath(uploadID, partNumber), io.TeeReader(requestBody(r), hash)); err != nil {
+		return err
+	}
+	w.Header().Set("ETag", "\""+hex.EncodeToString(hash.Sum(nil))+"\"")
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CompleteMultipartUploadResult"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+func completeMultipart(pc *client.APIClient, w http.ResponseWriter, r *http.Request, b *bucket, key, uploadID string) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	if err := checkUpload(pc, b, key, uploadID); err != nil {
+		return err
+	}
+	payload := &completeMultipartUpload{}
+	if err := xml.NewDecoder(requestBody(r)).Decode(payload); err != nil {
+		return malformedXMLError(err)
+	}
+	if len(payload.Parts) == 0 {
+		return malformedXMLError(errors.Errorf("no parts were specified"))
+	}
+	// The ETag of a multipart object is the MD5 of the concatenated part MD5s,
+	// followed by the number of parts.
+	hash := md5.New()
+	for i, part := range payload.Parts {
+		if i > 0 && part.PartNumber <= payload.Parts[i-1].PartNumber {
+			return invalidPartOrderError()
+		}
+		if _, err := pc.InspectFile(multipartRepo, multipartBranch, partPath(uploadID, part.PartNumber)); err != nil {
+			if pfsServer.IsFileNotFoundErr(err) {
+				return invalidPartError(part.PartNumber)
+			}
+			return err
+		}
+		partHash, err := hex.DecodeString(strings.Trim(part.ETag, "\""))
+		if err != nil {
+			return invalidPartError(part.PartNumber)
+		}
+		hash.Write(partHash)
+	}
+	// Concatenate the parts into the object.
+	pr, pw := io.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		err := func() error {
+			for _, part := range payload.Parts {
+				if err := pc.GetFile(multipartRepo, multipartBranch, partPath(uploadID, part.PartNumber), pw); err != nil {
+					return err
+				}
+			}
+			return nil
+		}()
+		pw.CloseWithError(err)
+		errCh <- err
+	}()
+	err := pc.PutFileOverwrite(b.repo, b.commit, "/"+key, pr)
+	// Unblock the goroutine if the put failed before reading all of the parts.
+	pr.CloseWithError(err)
+	if copyErr := <-errCh; copyErr != nil && err == nil {
+		err = copyErr
+	}
+	if err != nil {
+		return err
+	}
+	if err := pc.DeleteFile(multipartRepo, multipartBranch, uploadDir(uploadID)); err != nil {
+		return err
+	}
+	writeXML(w, r, http.StatusOK, &completeMultipartUploadResult{
+		Location: "/" + destination(b, key),
+		Bucket:   b.name,
+		Key:      key,
+		ETag:     fmt.Sprintf("\"%s-%d\"", hex.EncodeToString(hash.Sum(nil)), len(payload.Parts)),
+	})
+	return nil
+}
+
+func abortMultipart(pc *client.APIClient, w http.ResponseWriter, b *bucket, key, uploadID string) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	if err := checkUpload(pc, b, key, uploadID); err != nil {
+		return err
+	}
+	if err := pc.DeleteFile(multipartRepo, multipartBranch, uploadDir(uploadID)); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,146 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	pfsServer "github.com/pachyderm/pachyderm/src/server/pfs"
+	log "github.com/sirupsen/logrus"
+)
+
+func inspectObject(pc *client.APIClient, b *bucket, key string) (*pfs.FileInfo, error) {
+	fi, err := pc.InspectFile(b.repo, b.commit, "/"+key)
+	if err != nil {
+		if pfsServer.IsFileNotFoundErr(err) || pfsServer.IsNoHeadErr(err) {
+			return nil, noSuchKeyError(key)
+		}
+		return nil, err
+	}
+	if fi.FileType != pfs.FileType_FILE {
+		return nil, noSuchKeyError(key)
+	}
+	return fi, nil
+}
+
+func setObjectHeaders(w http.ResponseWriter, fi *pfs.FileInfo) {
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("ETag", etag(fi))
+	if lastModified, err := parseTimestamp(fi.Committed); err == nil {
+		w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	}
+}
+
+func headObject(pc *client.APIClient, w http.ResponseWriter, b *bucket, key string) error {
+	fi, err := inspectObject(pc, b, key)
+	if err != nil {
+		return err
+	}
+	setObjectHeaders(w, fi)
+	w.Header().Set("Content-Length", strconv.FormatUint(fi.SizeBytes, 10))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func getObject(pc *client.APIClient, w http.ResponseWriter, r *http.Request, b *bucket, key string) error {
+	fi, err := inspectObject(pc, b, key)
+	if err != nil {
+		return err
+	}
+	size := int64(fi.SizeBytes)
+	offset, length := int64(0), size
+	status := http.StatusOK
+	if rangeHeader := r.Header.Get("Range"); rangeHeader != "" {
+		offset, length, err = parseRange(rangeHeader, size)
+		if err != nil {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+			return err
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, size))
+		status = http.StatusPartialContent
+	}
+	setObjectHeaders(w, fi)
+	w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	w.WriteHeader(status)
+	if err := pc.GetFile(b.repo, b.commit, "/"+key, w, client.WithOffset(offset), client.WithSize(length)); err != nil {
+		// The headers have already been sent, so the error can only be logged.
+		log.Errorf("s3gateway: could not get object %q in bucket %q: %v", key, b.name, err)
+	}
+	return nil
+}
+
+// parseRange parses a single byte range in a Range header into an offset and
+// a length.
+func parseRange(rangeHeader string, size int64) (int64, int64, error) {
+	spec := strings.TrimPrefix(rangeHeader, "bytes=")
+	if spec == rangeHeader || strings.Contains(spec, ",") {
+		return 0, 0, invalidRangeError(size)
+	}
+	parts := strings.SplitN(spec, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, invalidRangeError(size)
+	}
+	if parts[0] == "" {
+		// Suffix range, which is the last n bytes.
+		n, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || n <= 0 || size == 0 {
+			return 0, 0, invalidRangeError(size)
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, n, nil
+	}
+	start, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return 0, 0, invalidRangeError(size)
+	}
+	end := size - 1
+	if parts[1] != "" {
+		end, err = strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || end < start {
+			return 0, 0, invalidRangeError(size)
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end - start + 1, nil
+}
+
+func putObject(pc *client.APIClient, w http.ResponseWriter, r *http.Request, b *bucket, key string) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	if r.Header.Get("X-Amz-Copy-Source") != "" {
+		return notImplementedError(r)
+	}
+	if strings.HasSuffix(key, "/") {
+		return invalidArgumentError("object keys cannot end with '/'")
+	}
+	hash := md5.New()
+	if err := pc.PutFileOverwrite(b.repo, b.commit, "/"+key, io.TeeReader(requestBody(r), hash)); err != nil {
+		return err
+	}
+	w.Header().Set("ETag", "\""+hex.EncodeToString(hash.Sum(nil))+"\"")
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func deleteObject(pc *client.APIClient, w http.ResponseWriter, b *bucket, key string) error {
+	if err := b.checkWritable(); err != nil {
+		return err
+	}
+	if err := pc.DeleteFile(b.repo, b.commit, "/"+key); err != nil && !pfsServer.IsFileNotFoundErr(err) {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,218 @@
+// Package s3 implements an S3-compatible gateway for PFS.
+//
+// Buckets are named after the PFS branch or commit they refer to, in the form
+// `branch.repo` or `commit.repo`. Branch buckets can be written to, while
+// commit buckets are read-only. Requests are authenticated by using the
+// Pachyderm auth token as the S3 access key and secret key. The gateway
+// verifies each request's signature with the token, and pachd authenticates
+// the token itself.
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// ClientFactory creates the pach client that the gateway uses to talk to
+// pachd.
+type ClientFactory func() (*client.APIClient, error)
+
+type server struct {
+	clientFactory  ClientFactory
+	pachClient     *client.APIClient
+	pachClientErr  error
+	pachClientOnce sync.Once
+}
+
+// Server returns an S3 gateway HTTP server that listens on the given port.
+func Server(port uint16, clientFactory ClientFactory) *http.Server {
+	return &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: Handler(clientFactory),
+	}
+}
+
+// Handler returns the HTTP handler for the S3 gateway.
+func Handler(clientFactory ClientFactory) http.Handler {
+	return &server{clientFactory: clientFactory}
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Debugf("s3gateway: %s %s", r.Method, r.URL.String())
+	if err := s.serveHTTP(w, r); err != nil {
+		writeError(w, r, err)
+	}
+}
+
+func (s *server) serveHTTP(w http.ResponseWriter, r *http.Request) error {
+	pc, err := s.getPachClient(r)
+	if err != nil {
+		return err
+	}
+	bucketName, key := splitPath(r.URL.Path)
+	if bucketName == "" {
+		if r.Method != http.MethodGet {
+			return notImplementedError(r)
+		}
+		return listBuckets(pc, w, r)
+	}
+	b, err := parseBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	q := r.URL.Query()
+	_, uploads := q["uploads"]
+	uploadID := q.Get("uploadId")
+	if key == "" {
+		switch r.Method {
+		case http.MethodGet:
+			if _, ok := q["location"]; ok {
+				return getBucketLocation(pc, w, r, b)
+			}
+			if uploads {
+				return notImplementedError(r)
+			}
+			return listObjects(pc, w, r, b)
+		case http.MethodHead:
+			return headBucket(pc, w, b)
+		default:
+			return notImplementedError(r)
+		}
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if uploadID != "" {
+			return notImplementedError(r)
+		}
+		return getObject(pc, w, r, b, key)
+	case http.MethodHead:
+		return headObject(pc, w, b, key)
+	case http.MethodPut:
+		if uploadID != "" {
+			return uploadPart(pc, w, r, b, key, uploadID)
+		}
+		return putObject(pc, w, r, b, key)
+	case http.MethodPost:
+		if uploads {
+			return initMultipart(pc, w, r, b, key)
+		}
+		if uploadID != "" {
+			return completeMultipart(pc, w, r, b, key, uploadID)
+		}
+		return notImplementedError(r)
+	case http.MethodDelete:
+		if uploadID != "" {
+			return abortMultipart(pc, w, b, key, uploadID)
+		}
+		return deleteObject(pc, w, b, key)
+	default:
+		return notImplementedError(r)
+	}
+}
+
+// getPachClient returns a pach client for the request, which is authenticated
+// with the access key of the request.
+func (s *server) getPachClient(r *http.Request) (*client.APIClient, error) {
+	s.pachClientOnce.Do(func() {
+		s.pachClient, s.pachClientErr = s.clientFactory()
+	})
+	if s.pachClientErr != nil {
+		return nil, internalError(s.pachClientErr)
+	}
+	token := accessKey(r)
+	if token != "" {
+		if err := verifySignature(r, token, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	pc := s.pachClient.WithCtx(r.Context())
+	pc.SetAuthToken(token)
+	return pc, nil
+}
+
+// accessKey returns the access key used to sign the request. The access key
+// is the auth token, which is also the secret key that the request's
+// signature is verified with (see verifySignature).
+func accessKey(r *http.Request) string {
+	if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+		switch {
+		case strings.HasPrefix(authHeader, "AWS4-HMAC-SHA256 "):
+			// Signature V4: Credential=<key>/<date>/<region>/s3/aws4_request, ...
+			for _, field := range strings.Split(strings.TrimPrefix(authHeader, "AWS4-HMAC-SHA256 "), ",") {
+				field = strings.TrimSpace(field)
+				if strings.HasPrefix(field, "Credential=") {
+					return strings.Split(strings.TrimPrefix(field, "Credential="), "/")[0]
+				}
+			}
+		case strings.HasPrefix(authHeader, "AWS "):
+			// Signature V2: AWS <key>:<signature>
+			return strings.Split(strings.TrimPrefix(authHeader, "AWS "), ":")[0]
+		}
+		return ""
+	}
+	// Presigned URLs.
+	q := r.URL.Query()
+	if credential := q.Get("X-Amz-Credential"); credential != "" {
+		return strings.Split(credential, "/")[0]
+	}
+	return q.Get("AWSAccessKeyId")
+}
+
+func splitPath(p string) (string, string) {
+	p = strings.TrimPrefix(p, "/")
+	parts := strings.SplitN(p, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// bucket is the PFS location that an S3 bucket refers to.
+type bucket struct {
+	name   string
+	repo   string
+	commit string
+	// branch is true if the bucket refers to a branch (rather than a commit).
+	branch bool
+}
+
+func parseBucket(name string) (*bucket, error) {
+	i := strings.LastIndex(name, ".")
+	if i <= 0 || i == len(name)-1 {
+		return nil, noSuchBucketError(name)
+	}
+	commit := name[:i]
+	return &bucket{
+		name:   name,
+		repo:   name[i+1:],
+		commit: commit,
+		branch: !uuid.IsUUIDWithoutDashes(commit),
+	}, nil
+}
+
+func (b *bucket) checkWritable() error {
+	if !b.branch {
+		return methodNotAllowedError("the bucket %q refers to a commit, which is read-only", b.name)
+	}
+	return nil
+}
+
+func writeXML(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		log.Errorf("s3gateway: could not write response to %s %s: %v", r.Method, r.URL.Path, err)
+		return
+	}
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		log.Errorf("s3gateway: could not write response to %s %s: %v", r.Method, r.URL.Path, err)
+	}
+}
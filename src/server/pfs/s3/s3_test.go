@@ -0,0 +1,265 @@
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v6/pkg/signer"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
+)
+
+func withGateway(t *testing.T, cb func(*client.APIClient, *minio.Client)) {
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		s := httptest.NewServer(Handler(func() (*client.APIClient, error) {
+			return env.PachClient, nil
+		}))
+		defer s.Close()
+		u, err := url.Parse(s.URL)
+		require.NoError(t, err)
+		minioClient, err := minio.NewV4(u.Host, "", "", false)
+		require.NoError(t, err)
+		cb(env.PachClient, minioClient)
+		return nil
+	}))
+}
+
+func readObject(t *testing.T, minioClient *minio.Client, bucket, key string, opts minio.GetObjectOptions) string {
+	obj, err := minioClient.GetObject(bucket, key, opts)
+	require.NoError(t, err)
+	defer obj.Close()
+	data, err := ioutil.ReadAll(obj)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestObjects(t *testing.T) {
+	t.Parallel()
+	withGateway(t, func(c *client.APIClient, minioClient *minio.Client) {
+		repo := tu.UniqueString(strings.ToLower(t.Name()))
+		require.NoError(t, c.CreateRepo(repo))
+		require.NoError(t, c.PutFile(repo, "master", "file", strings.NewReader("content")))
+		bucket := "master." + repo
+
+		buckets, err := minioClient.ListBuckets()
+		require.NoError(t, err)
+		var names []string
+		for _, b := range buckets {
+			names = append(names, b.Name)
+		}
+		require.OneOfEquals(t, bucket, names)
+
+		// Get the object and a range of it.
+		require.Equal(t, "content", readObject(t, minioClient, bucket, "file", minio.GetObjectOptions{}))
+		opts := minio.GetObjectOptions{}
+		require.NoError(t, opts.SetRange(1, 3))
+		require.Equal(t, "ont", readObject(t, minioClient, bucket, "file", opts))
+		info, err := minioClient.StatObject(bucket, "file", minio.StatObjectOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int64(len("content")), info.Size)
+
+		// Put an object, then read it through PFS.
+		_, err = minioClient.PutObject(bucket, "dir/file", strings.NewReader("other content"), -1, minio.PutObjectOptions{})
+		require.NoError(t, err)
+		buf := &bytes.Buffer{}
+		require.NoError(t, c.GetFile(repo, "master", "dir/file", buf))
+		require.Equal(t, "other content", buf.String())
+
+		// Commit buckets are read-only.
+		commitInfo, err := c.InspectCommit(repo, "master")
+		require.NoError(t, err)
+		commitBucket := commitInfo.Commit.ID + "." + repo
+		require.Equal(t, "other content", readObject(t, minioClient, commitBucket, "dir/file", minio.GetObjectOptions{}))
+		_, err = minioClient.PutObject(commitBucket, "file", strings.NewReader("content"), -1, minio.PutObjectOptions{})
+		require.YesError(t, err)
+
+		// Delete an object.
+		require.NoError(t, minioClient.RemoveObject(bucket, "file"))
+		_, err = minioClient.StatObject(bucket, "file", minio.StatObjectOptions{})
+		require.YesError(t, err)
+		require.Equal(t, "NoSuchKey", minio.ToErrorResponse(err).Code)
+	})
+}
+
+func TestListObjects(t *testing.T) {
+	t.Parallel()
+	withGateway(t, func(c *client.APIClient, minioClient *minio.Client) {
+		repo := tu.UniqueString(strings.ToLower(t.Name()))
+		require.NoError(t, c.CreateRepo(repo))
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, c.PutFile(repo, commit.ID, fmt.Sprintf("file%d", i), strings.NewReader("content")))
+			require.NoError(t, c.PutFile(repo, commit.ID, fmt.Sprintf("dir/file%d", i), strings.NewReader("content")))
+		}
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		bucket := "master." + repo
+		list := func(prefix string, recursive bool) []string {
+			done := make(chan struct{})
+			defer close(done)
+			var keys []string
+			for obj := range minioClient.ListObjectsV2(bucket, prefix, recursive, done) {
+				require.NoError(t, obj.Err)
+				keys = append(keys, obj.Key)
+			}
+			return keys
+		}
+		require.Equal(t, []string{"dir/", "file0", "file1", "file2"}, list("", false))
+		require.Equal(t, []string{"dir/file0", "dir/file1", "dir/file2", "file0", "file1", "file2"}, list("", true))
+		require.Equal(t, []string{"dir/file0", "dir/file1", "dir/file2"}, list("dir/", false))
+		require.Equal(t, []string{"file1"}, list("file1", true))
+	})
+}
+
+func TestMultipart(t *testing.T) {
+	t.Parallel()
+	withGateway(t, func(c *client.APIClient, minioClient *minio.Client) {
+		repo := tu.UniqueString(strings.ToLower(t.Name()))
+		require.NoError(t, c.CreateRepo(repo))
+		require.NoError(t, c.PutFile(repo, "master", "file", strings.NewReader("content")))
+		bucket := "master." + repo
+		core := minio.Core{Client: minioClient}
+		uploadID, err := core.NewMultipartUpload(bucket, "multipart", minio.PutObjectOptions{})
+		require.NoError(t, err)
+		var parts []minio.CompletePart
+		var expected string
+		for i := 1; i <= 3; i++ {
+			data := strings.Repeat(fmt.Sprint(i), 10)
+			expected += data
+			part, err := core.PutObjectPart(bucket, "multipart", uploadID, i, strings.NewReader(data), int64(len(data)), "", "", nil)
+			require.NoError(t, err)
+			parts = append(parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+		}
+		_, err = core.CompleteMultipartUpload(bucket, "multipart", uploadID, parts)
+		require.NoError(t, err)
+		require.Equal(t, expected, readObject(t, minioClient, bucket, "multipart", minio.GetObjectOptions{}))
+
+		// Aborted uploads cannot be completed.
+		uploadID, err = core.NewMultipartUpload(bucket, "aborted", minio.PutObjectOptions{})
+		require.NoError(t, err)
+		require.NoError(t, core.AbortMultipartUpload(bucket, "aborted", uploadID))
+		_, err = core.CompleteMultipartUpload(bucket, "aborted", uploadID, parts)
+		require.YesError(t, err)
+		require.Equal(t, "NoSuchUpload", minio.ToErrorResponse(err).Code)
+	})
+}
+
+func TestAccessKey(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=token/20200101/us-east-1/s3/aws4_request, SignedHeaders=host, Signature=abc")
+	require.Equal(t, "token", accessKey(r))
+	r.Header.Set("Authorization", "AWS token:abc")
+	require.Equal(t, "token", accessKey(r))
+	r = httptest.NewRequest(http.MethodGet, "/?X-Amz-Credential=token%2F20200101%2Fus-east-1%2Fs3%2Faws4_request", nil)
+	require.Equal(t, "token", accessKey(r))
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	require.Equal(t, "", accessKey(r))
+}
+
+func TestVerifySignature(t *testing.T) {
+	errs := make(chan error, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		errs <- verifySignature(r, accessKey(r), time.Now())
+	}))
+	defer s.Close()
+	verify := func(r *http.Request) error {
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return <-errs
+	}
+	newRequest := func() *http.Request {
+		r, err := http.NewRequest(http.MethodPut, s.URL+"/master.repo/dir/a file~?uploadId=1&partNumber=2", strings.NewReader("data"))
+		require.NoError(t, err)
+		r.Header.Set("Content-Type", "text/plain")
+		r.Header.Set("X-Amz-Content-Sha256", "UNSIGNED-PAYLOAD")
+		r.Header.Set("X-Amz-Meta-Key", "value")
+		return r
+	}
+	signers := map[string]func(r *http.Request, secretKey string) *http.Request{
+		"V4": func(r *http.Request, secretKey string) *http.Request {
+			return signer.SignV4(*r, "token", secretKey, "", "us-east-1")
+		},
+		"V4 presigned": func(r *http.Request, secretKey string) *http.Request {
+			return signer.PreSignV4(*r, "token", secretKey, "", "us-east-1", 60)
+		},
+		"V2": func(r *http.Request, secretKey string) *http.Request {
+			r.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+			return signer.SignV2(*r, "token", secretKey, false)
+		},
+		"V2 presigned": func(r *http.Request, secretKey string) *http.Request {
+			return signer.PreSignV2(*r, "token", secretKey, 60, false)
+		},
+	}
+	for name, sign := range signers {
+		t.Run(name, func(t *testing.T) {
+			// A request signed with the token as the secret key is verified.
+			require.NoError(t, verify(sign(newRequest(), "token")))
+			// A request signed with another secret key is rejected.
+			err := verify(sign(newRequest(), "other"))
+			require.YesError(t, err)
+			require.Equal(t, "SignatureDoesNotMatch", err.(*Error).Code)
+			// A request that's modified after it's signed is rejected.
+			r := sign(newRequest(), "token")
+			r.URL.Path = "/master.repo/other"
+			err = verify(r)
+			require.YesError(t, err)
+			require.Equal(t, "SignatureDoesNotMatch", err.(*Error).Code)
+		})
+	}
+	// Old requests are rejected.
+	r := newRequest()
+	r.Header.Set("Date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	err := verify(signer.SignV2(*r, "token", "token", false))
+	require.YesError(t, err)
+	require.Equal(t, "RequestTimeTooSkewed", err.(*Error).Code)
+	// Unsigned requests are anonymous.
+	require.NoError(t, verify(newRequest()))
+}
+
+func TestParseRange(t *testing.T) {
+	for _, test := range []struct {
+		header         string
+		offset, length int64
+		ok             bool
+	}{
+		{"bytes=0-9", 0, 10, true},
+		{"bytes=5-", 5, 95, true},
+		{"bytes=-10", 90, 10, true},
+		{"bytes=90-200", 90, 10, true},
+		{"bytes=100-", 0, 0, false},
+		{"bytes=5-1", 0, 0, false},
+		{"bytes=0-1,5-6", 0, 0, false},
+		{"lines=0-1", 0, 0, false},
+	} {
+		offset, length, err := parseRange(test.header, 100)
+		if !test.ok {
+			require.YesError(t, err, test.header)
+			continue
+		}
+		require.NoError(t, err, test.header)
+		require.Equal(t, test.offset, offset, test.header)
+		require.Equal(t, test.length, length, test.header)
+	}
+}
+
+func TestChunkedReader(t *testing.T) {
+	payload := "5;chunk-signature=abc\r\nhello\r\n6;chunk-signature=def\r\n world\r\n0;chunk-signature=ghi\r\n\r\n"
+	r := httptest.NewRequest(http.MethodPut, "/bucket/key", strings.NewReader(payload))
+	r.Header.Set("X-Amz-Content-Sha256", streamingPayload)
+	data, err := ioutil.ReadAll(requestBody(r))
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(data))
+}
@@ -0,0 +1,82 @@
+package s3
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+const streamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+
+// requestBody returns the payload of the request, decoding it if it was sent
+// with chunked signatures (which is how SDKs upload over plain HTTP).
+func requestBody(r *http.Request) io.Reader {
+	if r.Header.Get("X-Amz-Content-Sha256") == streamingPayload {
+		return &chunkedReader{r: bufio.NewReader(r.Body)}
+	}
+	return r.Body
+}
+
+// chunkedReader decodes an aws-chunked payload, which is a series of chunks in
+// the form `<hex size>;chunk-signature=<signature>\r\n<data>\r\n` terminated
+// by a zero sized chunk. Only the seed signature in the Authorization header is
+// verified (see verifySignature), not the chunk signatures.
+type chunkedReader struct {
+	r         *bufio.Reader
+	remaining int64
+	started   bool
+	done      bool
+}
+
+func (cr *chunkedReader) Read(data []byte) (int, error) {
+	for cr.remaining == 0 {
+		if cr.done {
+			return 0, io.EOF
+		}
+		if err := cr.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+	if int64(len(data)) > cr.remaining {
+		data = data[:cr.remaining]
+	}
+	n, err := cr.r.Read(data)
+	cr.remaining -= int64(n)
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (cr *chunkedReader) nextChunk() error {
+	if cr.started {
+		// Consume the CRLF at the end of the previous chunk's data.
+		crlf := make([]byte, 2)
+		if _, err := io.ReadFull(cr.r, crlf); err != nil {
+			return io.ErrUnexpectedEOF
+		}
+		if string(crlf) != "\r\n" {
+			return errors.Errorf("malformed chunk encoding")
+		}
+	}
+	cr.started = true
+	line, err := cr.r.ReadString('\n')
+	if err != nil {
+		return io.ErrUnexpectedEOF
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if i := strings.Index(line, ";"); i >= 0 {
+		line = line[:i]
+	}
+	size, err := strconv.ParseInt(line, 16, 64)
+	if err != nil || size < 0 {
+		return errors.Errorf("malformed chunk size %q", line)
+	}
+	cr.remaining = size
+	cr.done = size == 0
+	return nil
+}
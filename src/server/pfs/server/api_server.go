@@ -14,6 +14,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
@@ -315,7 +316,11 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 			return 0, err
 		}
 		var bytesRead int64
-		if err := a.driver.modifyFile(a.env.GetPachClient(server.Context()), request.Commit, func(uw *fileset.UnorderedWriter) error {
+		pachClient := a.env.GetPachClient(server.Context())
+		if err := a.driver.modifyFile(pachClient, request.Commit, func(uw *fileset.UnorderedWriter, commitInfo *pfs.CommitInfo) error {
+			indexer := newSplitIndexer(func(dir string) (int64, error) {
+				return a.driver.countFiles(pachClient.Ctx(), commitInfo, dir)
+			})
 			for {
 				req, err := server.Recv()
 				if err != nil {
@@ -331,7 +336,11 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 					var err error
 					switch mod.AppendFile.Source.(type) {
 					case *pfs.AppendFile_RawFileSource:
-						n, err = appendFileRaw(uw, server, mod.AppendFile)
+						if mod.AppendFile.Delimiter != pfs.Delimiter_NONE {
+							n, err = appendFileSplit(uw, server, mod.AppendFile, indexer)
+						} else {
+							n, err = appendFileRaw(uw, server, mod.AppendFile)
+						}
 					case *pfs.AppendFile_TarFileSource:
 						n, err = appendFileTar(uw, server, mod.AppendFile)
 					case *pfs.AppendFile_UrlFileSource:
@@ -345,6 +354,11 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 					if err := deleteFile(uw, mod.DeleteFile); err != nil {
 						return err
 					}
+					indexer.delete(mod.DeleteFile.File)
+				case *pfs.ModifyFileRequest_CopyFile:
+					if err := a.driver.copyFileRef(pachClient, uw, mod.CopyFile); err != nil {
+						return err
+					}
 				}
 			}
 		}); err != nil {
@@ -519,11 +533,18 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 		commit := request.File.Commit
 		glob := request.File.Path
 		gfw := newGetFileWriter(grpcutil.NewStreamingBytesWriter(server))
-		err := a.driver.getFile(a.env.GetPachClient(server.Context()), commit, glob, gfw)
+		err := a.driver.getFile(a.env.GetPachClient(server.Context()), commit, glob, request.OffsetBytes, request.SizeBytes, int(request.Readahead), gfw)
 		return gfw.bytesWritten, err
 	})
 }
 
+// GetOpenCommitFile writes the files in commit that match glob to w as a tar
+// stream. Unlike GetFile, the commit may be open. It's not part of the PFS API
+// and is only used by PPS.
+func (a *apiServer) GetOpenCommitFile(pachClient *client.APIClient, commit *pfs.Commit, glob string, w io.Writer) error {
+	return a.driver.getOpenCommitFile(pachClient, commit, glob, w)
+}
+
 type getFileWriter struct {
 	w            io.Writer
 	bytesWritten int64
@@ -543,7 +564,7 @@ func (gfw *getFileWriter) Write(data []byte) (int, error) {
 func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileRequest) (response *pfs.FileInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	return a.driver.inspectFile(a.env.GetPachClient(ctx), request.File)
+	return a.driver.inspectFile(a.env.GetPachClient(ctx), request.File, request.History)
 }
 
 // ListFile implements the protobuf pfs.ListFile RPC
@@ -553,7 +574,7 @@ func (a *apiServer) ListFile(request *pfs.ListFileRequest, server pfs.API_ListFi
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listFile(a.env.GetPachClient(server.Context()), request.File, request.Full, func(fi *pfs.FileInfo) error {
+	return a.driver.listFile(a.env.GetPachClient(server.Context()), request.File, request.Full, request.History, func(fi *pfs.FileInfo) error {
 		sent++
 		return server.Send(fi)
 	})
@@ -648,3 +669,23 @@ func (a *apiServer) RenewFileset(ctx context.Context, req *pfs.RenewFilesetReque
 	}
 	return &types.Empty{}, nil
 }
+
+// GarbageCollect implements the protobuf pfs.GarbageCollect RPC
+func (a *apiServer) GarbageCollect(request *pfs.GarbageCollectRequest, gcServer pfs.API_GarbageCollectServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	sent := 0
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
+	}(time.Now())
+	return a.driver.garbageCollect(a.env.GetPachClient(gcServer.Context()), request.DryRun, func(resp *pfs.GarbageCollectResponse) error {
+		sent++
+		return gcServer.Send(resp)
+	})
+}
+
+// ExplainStorageObject implements the protobuf pfs.ExplainStorageObject RPC
+func (a *apiServer) ExplainStorageObject(ctx context.Context, request *pfs.ExplainStorageObjectRequest) (response *pfs.ExplainStorageObjectResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.explainStorageObject(a.env.GetPachClient(ctx), request.Id)
+}
@@ -1,9 +1,11 @@
 package server
 
 import (
+	"bytes"
 	"io"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -22,7 +24,7 @@ import (
 	"golang.org/x/net/context"
 )
 
-func (d *driver) modifyFile(pachClient *client.APIClient, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
+func (d *driver) modifyFile(pachClient *client.APIClient, commit *pfs.Commit, cb func(*fileset.UnorderedWriter, *pfs.CommitInfo) error) error {
 	ctx := pachClient.Ctx()
 	repo := commit.Repo.Name
 	var branch string
@@ -42,11 +44,13 @@ func (d *driver) modifyFile(pachClient *client.APIClient, commit *pfs.Commit, cb
 		}
 		return d.oneOffModifyFile(ctx, repo, branch, cb)
 	}
-	return d.withCommitWriter(ctx, commitInfo.Commit, cb)
+	return d.withCommitWriter(ctx, commitInfo.Commit, func(uw *fileset.UnorderedWriter) error {
+		return cb(uw, commitInfo)
+	})
 }
 
 // TODO: Cleanup after failure?
-func (d *driver) oneOffModifyFile(ctx context.Context, repo, branch string, cb func(*fileset.UnorderedWriter) error) error {
+func (d *driver) oneOffModifyFile(ctx context.Context, repo, branch string, cb func(*fileset.UnorderedWriter, *pfs.CommitInfo) error) error {
 	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) (retErr error) {
 		commit, err := d.startCommit(txnCtx, "", client.NewCommit(repo, ""), branch, nil, "")
 		if err != nil {
@@ -57,7 +61,13 @@ func (d *driver) oneOffModifyFile(ctx context.Context, repo, branch string, cb f
 				retErr = d.finishCommit(txnCtx, commit, "")
 			}
 		}()
-		return d.withCommitWriter(txnCtx.ClientContext, commit, cb)
+		commitInfo, err := d.resolveCommit(txnCtx.Stm, commit)
+		if err != nil {
+			return err
+		}
+		return d.withCommitWriter(txnCtx.ClientContext, commit, func(uw *fileset.UnorderedWriter) error {
+			return cb(uw, commitInfo)
+		})
 	})
 }
 
@@ -76,6 +86,74 @@ func (d *driver) withCommitWriter(ctx context.Context, commit *pfs.Commit, cb fu
 	})
 }
 
+// countFiles returns the number of files directly under dir in an open
+// commit, including the files written to it so far.
+func (d *driver) countFiles(ctx context.Context, commitInfo *pfs.CommitInfo, dir string) (int64, error) {
+	dir = fileset.Clean(dir, true)
+	fs, err := d.openCommit(ctx, commitInfo, index.WithPrefix(dir))
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		p := f.Index().Path
+		if strings.HasPrefix(p, dir) && !strings.Contains(strings.TrimPrefix(p, dir), "/") {
+			count++
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// openCommit opens the file set of a commit. A finished commit is read from
+// its compacted file set, while an open commit is read from its parent's
+// compacted file set merged with the sub file sets written to it so far.
+func (d *driver) openCommit(ctx context.Context, commitInfo *pfs.CommitInfo, opts ...index.Option) (fileset.FileSet, error) {
+	return d.openCommitFrom(ctx, d.storage, commitInfo, opts...)
+}
+
+// openCommitFrom opens the file set for a commit with the passed in storage
+// (which may be configured differently from the driver's storage).
+func (d *driver) openCommitFrom(ctx context.Context, storage *fileset.Storage, commitInfo *pfs.CommitInfo, opts ...index.Option) (fileset.FileSet, error) {
+	if commitInfo.Finished != nil {
+		return storage.Open(ctx, []string{compactedCommitPath(commitInfo.Commit)}, opts...)
+	}
+	var fileSets []string
+	if commitInfo.ParentCommit != nil {
+		fileSets = append(fileSets, compactedCommitPath(commitInfo.ParentCommit))
+	}
+	// The diff and compacted file sets of the commit are skipped, since they
+	// may be partially written if the commit is being finished.
+	subFileSets := make(map[string]bool)
+	commitPath := commitPath(commitInfo.Commit)
+	if err := storage.Store().Walk(ctx, commitPath, func(p string) error {
+		subFileSet := strings.Split(strings.TrimPrefix(p, commitPath+"/"), "/")[0]
+		if subFileSet != fileset.Diff && subFileSet != fileset.Compacted {
+			subFileSets[path.Join(commitPath, subFileSet)] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	for subFileSet := range subFileSets {
+		fileSets = append(fileSets, subFileSet)
+	}
+	// Sub file sets are merged in the order they were written.
+	sort.Strings(fileSets[len(fileSets)-len(subFileSets):])
+	if len(fileSets) == 0 {
+		return emptyFileSet{}, nil
+	}
+	return storage.Open(ctx, fileSets, opts...)
+}
+
+type emptyFileSet struct{}
+
+func (emptyFileSet) Iterate(_ context.Context, _ func(fileset.File) error, _ ...bool) error {
+	return nil
+}
+
 func (d *driver) getSubFileset() int64 {
 	// TODO subFileSet will need to be incremented through postgres or etcd.
 	nonce := atomic.AddUint64(&d.nonce, 1)
@@ -151,8 +229,25 @@ func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.
 		// TODO: after delete merging is sorted out add overwrite support
 		return errors.New("overwrite not yet supported")
 	}
-	srcPath := cleanPath(src.Path)
-	dstPath := cleanPath(dst.Path)
+	fs, err := d.copySource(ctx, srcCommit, src.Path, dst.Path)
+	if err != nil {
+		return err
+	}
+	return d.withWriter(pachClient, dstCommit, func(tag string, dst *fileset.Writer) error {
+		return fs.Iterate(ctx, func(f fileset.File) error {
+			return dst.Append(f.Index().Path, func(fw *fileset.FileWriter) error {
+				fw.Append(tag)
+				return f.Content(fw)
+			})
+		})
+	})
+}
+
+// copySource opens the files at srcPath in the finished commit srcCommit,
+// with their paths moved under dstPath.
+func (d *driver) copySource(ctx context.Context, srcCommit *pfs.Commit, srcPath, dstPath string) (fileset.FileSet, error) {
+	srcPath = cleanPath(srcPath)
+	dstPath = cleanPath(dstPath)
 	pathTransform := func(x string) string {
 		relPath, err := filepath.Rel(srcPath, x)
 		if err != nil {
@@ -162,27 +257,39 @@ func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.
 	}
 	fs, err := d.storage.Open(ctx, []string{compactedCommitPath(srcCommit)}, index.WithPrefix(srcPath))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
 		return idx.Path == srcPath || strings.HasPrefix(idx.Path, srcPath+"/")
 	})
-	fs = fileset.NewIndexMapper(fs, func(idx *index.Index) *index.Index {
+	return fileset.NewIndexMapper(fs, func(idx *index.Index) *index.Index {
 		idx.Path = pathTransform(idx.Path)
 		return idx
-	})
-	return d.withWriter(pachClient, dstCommit, func(tag string, dst *fileset.Writer) error {
-		return fs.Iterate(ctx, func(f fileset.File) error {
-			return dst.Append(f.Index().Path, func(fw *fileset.FileWriter) error {
-				fw.Append(tag)
-				return f.Content(fw)
-			})
-		})
-	})
+	}), nil
 }
 
-func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob string, w io.Writer) error {
-	ctx := pachClient.Ctx()
+// copyFileRef copies the files at src into uw by reference. Unlike copyFile,
+// the content of the files is not re-uploaded, the new files reuse the data
+// references of the source files.
+func (d *driver) copyFileRef(pachClient *client.APIClient, uw *fileset.UnorderedWriter, req *pfs.CopyFile) error {
+	srcCommitInfo, err := d.inspectCommit(pachClient, req.Src.Commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return err
+	}
+	if srcCommitInfo.Finished == nil {
+		return pfsserver.ErrCommitNotFinished{srcCommitInfo.Commit}
+	}
+	fs, err := d.copySource(pachClient.Ctx(), srcCommitInfo.Commit, req.Src.Path, req.Dst)
+	if err != nil {
+		return err
+	}
+	return uw.Copy(pachClient.Ctx(), fs, req.Overwrite, req.Tag)
+}
+
+func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob string, offset, size int64, readahead int, w io.Writer) error {
+	if offset < 0 || size < 0 {
+		return errors.Errorf("invalid byte range (offset %d, size %d)", offset, size)
+	}
 	commitInfo, err := d.inspectCommit(pachClient, commit, pfs.CommitState_STARTED)
 	if err != nil {
 		return err
@@ -190,12 +297,39 @@ func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob
 	if commitInfo.Finished == nil {
 		return pfsserver.ErrCommitNotFinished{commitInfo.Commit}
 	}
-	commit = commitInfo.Commit
+	// Each chunk being read ahead is buffered in memory, so the readahead
+	// requested by the client is bounded.
+	if max := d.env.StorageMaxReadahead; readahead > max {
+		readahead = max
+	}
+	return d.writeTarStream(pachClient.Ctx(), commitInfo, glob, offset, size, readahead, w)
+}
+
+// getOpenCommitFile is like getFile, except that the commit may be open, in
+// which case the files written to it so far are read. It isn't exposed
+// through the PFS API (which only reads finished commits); it's used by PPS to
+// read the meta commits of running jobs.
+func (d *driver) getOpenCommitFile(pachClient *client.APIClient, commit *pfs.Commit, glob string, w io.Writer) error {
+	commitInfo, err := d.inspectCommit(pachClient, commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return err
+	}
+	return d.writeTarStream(pachClient.Ctx(), commitInfo, glob, 0, 0, 0, w)
+}
+
+// writeTarStream writes the files in a commit that match glob to w as a tar
+// stream. If offset or size is set, only that byte range of each file is
+// written.
+func (d *driver) writeTarStream(ctx context.Context, commitInfo *pfs.CommitInfo, glob string, offset, size int64, readahead int, w io.Writer) error {
 	indexOpt, mf, err := parseGlob(glob)
 	if err != nil {
 		return err
 	}
-	fs, err := d.storage.Open(ctx, []string{compactedCommitPath(commit)}, indexOpt)
+	storage := d.storage
+	if readahead > 1 {
+		storage = storage.WithReadahead(readahead)
+	}
+	fs, err := d.openCommitFrom(ctx, storage, commitInfo, indexOpt)
 	if err != nil {
 		return err
 	}
@@ -219,11 +353,16 @@ func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob
 	// 		return th
 	// 	},
 	// }
+	if offset > 0 || size > 0 {
+		filter = fileset.NewByteRanger(filter, storage.ChunkStorage(), offset, size)
+	}
 	return fileset.WriteTarStream(ctx, w, filter)
 }
 
-func (d *driver) inspectFile(pachClient *client.APIClient, file *pfs.File) (*pfs.FileInfo, error) {
-	ctx := pachClient.Ctx()
+func (d *driver) inspectFile(pachClient *client.APIClient, file *pfs.File, history int64) (*pfs.FileInfo, error) {
+	if history < -1 {
+		return nil, errors.Errorf("invalid history %v, must be -1 or greater", history)
+	}
 	commitInfo, err := d.inspectCommit(pachClient, file.Commit, pfs.CommitState_STARTED)
 	if err != nil {
 		return nil, err
@@ -231,12 +370,30 @@ func (d *driver) inspectFile(pachClient *client.APIClient, file *pfs.File) (*pfs
 	if commitInfo.Finished == nil {
 		return nil, pfsserver.ErrCommitNotFinished{commitInfo.Commit}
 	}
+	fi, err := d.inspectFileInCommit(pachClient.Ctx(), commitInfo, file)
+	if err != nil || history == 0 {
+		return fi, err
+	}
+	// The last version that's walked back to is returned.
+	var ret *pfs.FileInfo
+	if err := d.fileHistory(pachClient, commitInfo, fi, history, func(fi *pfs.FileInfo) error {
+		ret = fi
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// inspectFileInCommit returns the file info of file in the (finished) commit
+// described by commitInfo.
+func (d *driver) inspectFileInCommit(ctx context.Context, commitInfo *pfs.CommitInfo, file *pfs.File) (*pfs.FileInfo, error) {
 	commit := commitInfo.Commit
 	p := cleanPath(file.Path)
 	if p == "/" {
 		p = ""
 	}
-	fs, err := d.storage.Open(ctx, []string{compactedCommitPath(commit)}, index.WithPrefix(p))
+	fs, err := d.openCommit(ctx, commitInfo, index.WithPrefix(p))
 	if err != nil {
 		return nil, err
 	}
@@ -260,7 +417,82 @@ func (d *driver) inspectFile(pachClient *client.APIClient, file *pfs.File) (*pfs
 	return ret, nil
 }
 
-func (d *driver) listFile(pachClient *client.APIClient, file *pfs.File, full bool, cb func(*pfs.FileInfo) error) error {
+func (d *driver) listFile(pachClient *client.APIClient, file *pfs.File, full bool, history int64, cb func(*pfs.FileInfo) error) error {
+	if history < -1 {
+		return errors.Errorf("invalid history %v, must be -1 or greater", history)
+	}
+	if history == 0 {
+		return d.listFileCurrent(pachClient, file, full, cb)
+	}
+	// The file infos are collected before walking their histories, so that the
+	// commit's fileset isn't held open while the ancestors are read.
+	var fileInfos []*pfs.FileInfo
+	if err := d.listFileCurrent(pachClient, file, full, func(fi *pfs.FileInfo) error {
+		fileInfos = append(fileInfos, fi)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(fileInfos) == 0 {
+		return nil
+	}
+	commitInfo, err := d.inspectCommit(pachClient, fileInfos[0].File.Commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fileInfos {
+		if err := d.fileHistory(pachClient, commitInfo, fi, history, cb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileHistory calls cb with each distinct version of the file in fi, starting
+// with the version in fi (which is in the commit described by commitInfo) and
+// walking back through the ancestry of the commit. Each version is reported at
+// the oldest commit in which the file had that content, i.e. the commit that
+// last modified it. history limits the number of versions, -1 returns all of
+// them. The walk stops as soon as history versions have been reported, or at
+// the most recent ancestor that doesn't have the file, so each ancestor that
+// is walked costs one inspectCommit and one read of the file's metadata.
+func (d *driver) fileHistory(pachClient *client.APIClient, commitInfo *pfs.CommitInfo, fi *pfs.FileInfo, history int64, cb func(*pfs.FileInfo) error) error {
+	ctx := pachClient.Ctx()
+	for n := int64(0); history < 0 || n < history; n++ {
+		// Find the oldest consecutive ancestor with the same version of the file.
+		for {
+			if commitInfo.ParentCommit == nil {
+				return cb(fi)
+			}
+			parentCommitInfo, err := d.inspectCommit(pachClient, commitInfo.ParentCommit, pfs.CommitState_STARTED)
+			if err != nil {
+				return err
+			}
+			if parentCommitInfo.Finished == nil {
+				return pfsserver.ErrCommitNotFinished{parentCommitInfo.Commit}
+			}
+			parentFi, err := d.inspectFileInCommit(ctx, parentCommitInfo, client.NewFile(parentCommitInfo.Commit.Repo.Name, parentCommitInfo.Commit.ID, fi.File.Path))
+			if err != nil {
+				if pfsserver.IsFileNotFoundErr(err) {
+					return cb(fi)
+				}
+				return err
+			}
+			commitInfo = parentCommitInfo
+			if parentFi.FileType != fi.FileType || !bytes.Equal(parentFi.Hash, fi.Hash) {
+				if err := cb(fi); err != nil {
+					return err
+				}
+				fi = parentFi
+				break
+			}
+			fi = parentFi
+		}
+	}
+	return nil
+}
+
+func (d *driver) listFileCurrent(pachClient *client.APIClient, file *pfs.File, full bool, cb func(*pfs.FileInfo) error) error {
 	if _, err := d.inspectCommit(pachClient, file.Commit, pfs.CommitState_FINISHED); err != nil {
 		return err
 	}
@@ -274,7 +506,7 @@ func (d *driver) listFile(pachClient *client.APIClient, file *pfs.File, full boo
 	}
 	commit := commitInfo.Commit
 	name := cleanPath(file.Path)
-	fs, err := d.storage.Open(ctx, []string{compactedCommitPath(commit)}, index.WithPrefix(name))
+	fs, err := d.openCommit(ctx, commitInfo, index.WithPrefix(name))
 	if err != nil {
 		return err
 	}
@@ -315,7 +547,7 @@ func (d *driver) walkFile(pachClient *client.APIClient, file *pfs.File, cb func(
 	if p == "/" {
 		p = ""
 	}
-	fs, err := d.storage.Open(ctx, []string{compactedCommitPath(commit)}, index.WithPrefix(p))
+	fs, err := d.openCommit(ctx, commitInfo, index.WithPrefix(p))
 	if err != nil {
 		return err
 	}
@@ -344,7 +576,7 @@ func (d *driver) globFile(pachClient *client.APIClient, commit *pfs.Commit, glob
 	if err != nil {
 		return err
 	}
-	fs, err := d.storage.Open(ctx, []string{compactedCommitPath(commit)}, indexOpt)
+	fs, err := d.openCommit(ctx, commitInfo, indexOpt)
 	if err != nil {
 		return err
 	}
@@ -460,6 +692,10 @@ func (d *driver) createFileset(server pfs.API_CreateFilesetServer) (string, erro
 					if err != nil {
 						return err
 					}
+				case *pfs.ModifyFileRequest_CopyFile:
+					if err := d.copyFileRef(d.env.GetPachClient(ctx), uw, mod.CopyFile); err != nil {
+						return err
+					}
 				}
 			}
 		})
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/track"
+)
+
+// checkStorageAdmin checks that the caller is allowed to administer the
+// storage layer -- they must be an admin.
+func checkStorageAdmin(pachClient *client.APIClient, op string) error {
+	me, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "error during authorization check")
+	}
+	for _, s := range me.ClusterRoles.GetRoles() {
+		if s == auth.ClusterRole_SUPER {
+			return nil
+		}
+	}
+	return &auth.ErrNotAuthorized{
+		Subject: me.Username,
+		AdminOp: op,
+	}
+}
+
+func (d *driver) garbageCollect(pachClient *client.APIClient, dryRun bool, cb func(*pfs.GarbageCollectResponse) error) error {
+	if err := checkStorageAdmin(pachClient, "GarbageCollect"); err != nil {
+		return err
+	}
+	return d.storage.CollectGarbage(pachClient.Ctx(), dryRun, func(obj *track.CollectedObject) error {
+		return cb(&pfs.GarbageCollectResponse{
+			Id:        obj.ID,
+			SizeBytes: obj.SizeBytes,
+		})
+	})
+}
+
+func (d *driver) explainStorageObject(pachClient *client.APIClient, id string) (*pfs.ExplainStorageObjectResponse, error) {
+	if err := checkStorageAdmin(pachClient, "ExplainStorageObject"); err != nil {
+		return nil, err
+	}
+	explanation, err := d.storage.ExplainObject(pachClient.Ctx(), id)
+	if err != nil {
+		if err == track.ErrObjectNotExists {
+			return nil, errors.Errorf("storage object %q not found", id)
+		}
+		return nil, err
+	}
+	object, err := storageObjectInfo(explanation.Object)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pfs.ExplainStorageObjectResponse{Object: object}
+	for _, ref := range explanation.References {
+		root, err := storageObjectInfo(ref.Root)
+		if err != nil {
+			return nil, err
+		}
+		resp.References = append(resp.References, &pfs.StorageReference{
+			Root:   root,
+			Path:   ref.Path,
+			Commit: filesetCommit(ref.Root.ID),
+		})
+	}
+	return resp, nil
+}
+
+func storageObjectInfo(info *track.ObjectInfo) (*pfs.StorageObjectInfo, error) {
+	result := &pfs.StorageObjectInfo{
+		Id:        info.ID,
+		Expired:   info.Expired,
+		Tombstone: info.Tombstone,
+	}
+	if !info.ExpiresAt.IsZero() {
+		expiresAt, err := types.TimestampProto(info.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		result.ExpiresAt = expiresAt
+	}
+	return result, nil
+}
+
+// filesetCommit returns the commit that the fileset tracked by id belongs to,
+// or nil if it is not a commit's fileset (e.g. it is a temporary fileset).
+// Commit filesets are stored under <repo>/<commit ID>/.
+func filesetCommit(id string) *pfs.Commit {
+	if !strings.HasPrefix(id, fileset.TrackerPrefix) {
+		return nil
+	}
+	parts := strings.SplitN(strings.TrimPrefix(id, fileset.TrackerPrefix), "/", 3)
+	if len(parts) < 2 || parts[0] == tmpRepo {
+		return nil
+	}
+	return client.NewCommit(parts[0], parts[1])
+}
@@ -0,0 +1,274 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/sql"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset"
+)
+
+// splitIndexer keeps track of the index of the next split file in each
+// directory that is split into during a ModifyFile stream.
+type splitIndexer struct {
+	countFiles func(dir string) (int64, error)
+	next       map[string]int64
+	deleted    []string
+}
+
+func newSplitIndexer(countFiles func(dir string) (int64, error)) *splitIndexer {
+	return &splitIndexer{
+		countFiles: countFiles,
+		next:       make(map[string]int64),
+	}
+}
+
+// start returns the index of the first file to write into dir.
+func (si *splitIndexer) start(dir string) (int64, error) {
+	dir = fileset.Clean(dir, true)
+	if n, ok := si.next[dir]; ok {
+		return n, nil
+	}
+	for _, p := range si.deleted {
+		if strings.HasPrefix(dir, p) {
+			return 0, nil
+		}
+	}
+	return si.countFiles(dir)
+}
+
+// end records the index of the next file to write into dir.
+func (si *splitIndexer) end(dir string, next int64) {
+	si.next[fileset.Clean(dir, true)] = next
+}
+
+// delete records that p was deleted, which resets the indices of the
+// directories under it.
+func (si *splitIndexer) delete(p string) {
+	if !fileset.IsDir(p) {
+		return
+	}
+	p = fileset.Clean(p, true)
+	for dir := range si.next {
+		if strings.HasPrefix(dir, p) {
+			delete(si.next, dir)
+		}
+	}
+	si.deleted = append(si.deleted, p)
+}
+
+func splitFilePath(dir string, i int64) string {
+	return path.Join(dir, fmt.Sprintf("%016x", i))
+}
+
+// appendFileSplit splits the data from a raw file source into records with
+// the request's delimiter, and writes the records into files in the directory
+// at the source path.
+func appendFileSplit(uw *fileset.UnorderedWriter, server modifyFileSource, req *pfs.AppendFile, indexer *splitIndexer) (int64, error) {
+	src := req.Source.(*pfs.AppendFile_RawFileSource).RawFileSource
+	rfsr := &rawFileSourceReader{
+		server: server,
+		r:      bytes.NewReader(src.Data),
+	}
+	err := splitFile(uw, src.Path, req, rfsr, indexer)
+	if err == nil {
+		// Drain the remaining messages of the source.
+		_, err = io.Copy(ioutil.Discard, rfsr)
+	}
+	return rfsr.bytesRead, err
+}
+
+func splitFile(uw *fileset.UnorderedWriter, dir string, req *pfs.AppendFile, r io.Reader, indexer *splitIndexer) error {
+	if req.Overwrite {
+		uw.Delete(fileset.Clean(dir, true))
+		indexer.delete(fileset.Clean(dir, true))
+	}
+	rr, err := newRecordReader(req.Delimiter, r)
+	if err != nil {
+		return err
+	}
+	// Read the header records.
+	headerRecords := &bytes.Buffer{}
+	for i := int64(0); i < req.HeaderRecords; i++ {
+		record, err := rr.ReadRecord()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		headerRecords.Write(record)
+	}
+	start, err := indexer.start(dir)
+	if err != nil {
+		return err
+	}
+	next := start
+	buf := &bytes.Buffer{}
+	var records int64
+	flush := func() error {
+		if records == 0 {
+			return nil
+		}
+		if err := uw.Append(splitFilePath(dir, next), false, buf, req.Tag); err != nil {
+			return err
+		}
+		next++
+		buf.Reset()
+		records = 0
+		return nil
+	}
+	for {
+		record, err := rr.ReadRecord()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		if records == 0 {
+			buf.Write(rr.Header())
+			buf.Write(headerRecords.Bytes())
+		}
+		buf.Write(record)
+		records++
+		if (req.TargetFileDatums == 0 && req.TargetFileBytes == 0) ||
+			(req.TargetFileDatums > 0 && records >= req.TargetFileDatums) ||
+			(req.TargetFileBytes > 0 && int64(buf.Len()) >= req.TargetFileBytes) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	indexer.end(dir, next)
+	// The footer is only known once all of the records have been read, so it
+	// is appended to the files afterwards.
+	if footer := rr.Footer(); len(footer) > 0 {
+		for i := start; i < next; i++ {
+			if err := uw.Append(splitFilePath(dir, i), false, bytes.NewReader(footer), req.Tag); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// recordReader reads the records of a file that is being split.
+type recordReader interface {
+	// ReadRecord returns the next record, or io.EOF when there are no more
+	// records.
+	ReadRecord() ([]byte, error)
+	// Header returns the data that precedes the records in every file.
+	Header() []byte
+	// Footer returns the data that follows the records in every file, it is
+	// only valid after ReadRecord has returned io.EOF.
+	Footer() []byte
+}
+
+func newRecordReader(delimiter pfs.Delimiter, r io.Reader) (recordReader, error) {
+	switch delimiter {
+	case pfs.Delimiter_LINE:
+		return &lineReader{r: bufio.NewReader(r)}, nil
+	case pfs.Delimiter_JSON:
+		return &jsonReader{d: json.NewDecoder(r)}, nil
+	case pfs.Delimiter_CSV:
+		return &csvReader{lineReader{r: bufio.NewReader(r)}}, nil
+	case pfs.Delimiter_SQL:
+		return &sqlReader{r: sql.NewPGDumpReader(bufio.NewReader(r))}, nil
+	default:
+		return nil, errors.Errorf("unrecognized delimiter %v", delimiter)
+	}
+}
+
+type lineReader struct {
+	r *bufio.Reader
+}
+
+func (lr *lineReader) ReadRecord() ([]byte, error) {
+	record, err := lr.r.ReadBytes('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && len(record) > 0 {
+			return record, nil
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+func (lr *lineReader) Header() []byte { return nil }
+
+func (lr *lineReader) Footer() []byte { return nil }
+
+type jsonReader struct {
+	d *json.Decoder
+}
+
+func (jr *jsonReader) ReadRecord() ([]byte, error) {
+	var record json.RawMessage
+	if err := jr.d.Decode(&record); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "invalid JSON")
+	}
+	return record, nil
+}
+
+func (jr *jsonReader) Header() []byte { return nil }
+
+func (jr *jsonReader) Footer() []byte { return nil }
+
+// csvReader reads CSV records, which are lines unless a quoted field contains
+// a newline.
+type csvReader struct {
+	lineReader
+}
+
+func (cr *csvReader) ReadRecord() ([]byte, error) {
+	var record []byte
+	for {
+		line, err := cr.lineReader.ReadRecord()
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(record) > 0 {
+				return nil, errors.Errorf("invalid CSV: unterminated quoted field")
+			}
+			return nil, err
+		}
+		record = append(record, line...)
+		// A record ends when all of its quotes are balanced.
+		if bytes.Count(record, []byte{'"'})%2 == 0 {
+			return record, nil
+		}
+	}
+}
+
+type sqlReader struct {
+	r *sql.PGDumpReader
+}
+
+func (sr *sqlReader) ReadRecord() ([]byte, error) {
+	for {
+		record, err := sr.r.ReadRow()
+		if err != nil {
+			return nil, err
+		}
+		if len(record) > 0 {
+			return record, nil
+		}
+	}
+}
+
+func (sr *sqlReader) Header() []byte { return sr.r.Header }
+
+func (sr *sqlReader) Footer() []byte { return sr.r.Footer }
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
+)
+
+func readRecords(t *testing.T, delimiter pfs.Delimiter, data string) (recordReader, []string) {
+	rr, err := newRecordReader(delimiter, strings.NewReader(data))
+	require.NoError(t, err)
+	var records []string
+	for {
+		record, err := rr.ReadRecord()
+		if errors.Is(err, io.EOF) {
+			return rr, records
+		}
+		require.NoError(t, err)
+		records = append(records, string(record))
+	}
+}
+
+func TestRecordReaders(t *testing.T) {
+	_, records := readRecords(t, pfs.Delimiter_LINE, "foo\nbar\nbuz")
+	require.Equal(t, []string{"foo\n", "bar\n", "buz"}, records)
+
+	_, records = readRecords(t, pfs.Delimiter_JSON, "{}{\"a\": 1}\n[1,2]")
+	require.Equal(t, []string{"{}", "{\"a\": 1}", "[1,2]"}, records)
+
+	// The second record contains a quoted newline.
+	_, records = readRecords(t, pfs.Delimiter_CSV, "this,is,a,test\n\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n")
+	require.Equal(t, []string{"this,is,a,test\n", "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"}, records)
+
+	rr, records := readRecords(t, pfs.Delimiter_SQL, tu.TestPGDump)
+	require.Equal(t, 5, len(records))
+	require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", records[0])
+	require.Matches(t, "CREATE TABLE public\\.cars", string(rr.Header()))
+	require.Matches(t, "PostgreSQL database dump complete", string(rr.Footer()))
+
+	_, err := newRecordReader(pfs.Delimiter_NONE, strings.NewReader(""))
+	require.YesError(t, err)
+}
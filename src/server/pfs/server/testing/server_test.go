@@ -2,6 +2,7 @@ package testing
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
@@ -26,9 +27,11 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/sql"
 	"github.com/pachyderm/pachyderm/src/server/pkg/tarutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
@@ -494,6 +497,66 @@ func TestPutFileIntoOpenCommit(t *testing.T) {
 	}))
 }
 
+func TestReadOpenCommit(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		readOpenCommit := func(commit *pfs.Commit) map[string]string {
+			buf := &bytes.Buffer{}
+			require.NoError(t, env.PFSServer.GetOpenCommitFile(env.PachClient, commit, "/*", buf))
+			files := make(map[string]string)
+			require.NoError(t, tarutil.Iterate(buf, func(f tarutil.File) error {
+				hdr, err := f.Header()
+				if err != nil {
+					return err
+				}
+				content := &bytes.Buffer{}
+				if err := f.Content(content); err != nil {
+					return err
+				}
+				files[hdr.Name] = content.String()
+				return nil
+			}))
+			return files
+		}
+
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		// An open commit with no parent and no files is empty.
+		require.Equal(t, 0, len(readOpenCommit(commit1)))
+		require.NoError(t, env.PachClient.PutFile(repo, commit1.ID, "foo", strings.NewReader("foo\n")))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit1.ID))
+
+		// Reads of an open commit include the parent's files and the files
+		// written to the commit so far.
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(repo, commit2.ID, "foo", strings.NewReader("foo\n")))
+		require.NoError(t, env.PachClient.PutFile(repo, commit2.ID, "bar", strings.NewReader("bar\n")))
+		require.Equal(t, map[string]string{"/foo": "foo\nfoo\n", "/bar": "bar\n"}, readOpenCommit(commit2))
+		require.NoError(t, env.PachClient.DeleteFile(repo, commit2.ID, "bar"))
+		require.Equal(t, map[string]string{"/foo": "foo\nfoo\n"}, readOpenCommit(commit2))
+
+		// The public API doesn't read open commits.
+		buf := &bytes.Buffer{}
+		err = env.PachClient.GetFile(repo, commit2.ID, "foo", buf)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsCommitNotFinishedErr(err))
+		_, err = env.PachClient.InspectFile(repo, commit2.ID, "foo")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsCommitNotFinishedErr(err))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit2.ID))
+
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, commit2.ID, "foo", buf))
+		require.Equal(t, "foo\nfoo\n", buf.String())
+		require.Equal(t, map[string]string{"/foo": "foo\nfoo\n"}, readOpenCommit(commit2))
+		return nil
+	}))
+}
+
 // TODO: Make work with V2?
 //func TestPutFileDirectoryTraversal(t *testing.T) {
 //	t.Parallel()
@@ -3178,245 +3241,238 @@ func TestFlushNonExistentCommit(t *testing.T) {
 	}))
 }
 
-// TODO: Make work with V2?
-//func TestPutFileSplit(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		if testing.Short() {
-//			t.Skip("Skipping integration tests in short mode")
-//		}
-//
-//		// create repos
-//		repo := tu.UniqueString("TestPutFileSplit")
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		commit, err := env.PachClient.StartCommit(repo, "master")
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "none", pfs.Delimiter_NONE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line2", pfs.Delimiter_LINE, 2, 0, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line3", pfs.Delimiter_LINE, 0, 8, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json2", pfs.Delimiter_JSON, 2, 0, 0, false, strings.NewReader("{}{}{}{}"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json3", pfs.Delimiter_JSON, 0, 4, 0, false, strings.NewReader("{}{}{}{}"))
-//		require.NoError(t, err)
-//
-//		files, err := env.PachClient.ListFileAll(repo, commit.ID, "line2")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(8), fileInfo.SizeBytes)
-//		}
-//
-//		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-//		commit2, err := env.PachClient.StartCommit(repo, "master")
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-//		require.NoError(t, err)
-//		_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-//		require.NoError(t, err)
-//
-//		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-//		require.NoError(t, err)
-//		require.Equal(t, 9, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//
-//		require.NoError(t, env.PachClient.FinishCommit(repo, commit2.ID))
-//		fileInfo, err := env.PachClient.InspectFile(repo, commit.ID, "none")
-//		require.NoError(t, err)
-//		require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line")
-//		require.NoError(t, err)
-//		require.Equal(t, 6, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-//		require.NoError(t, err)
-//		require.Equal(t, 9, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line2")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(8), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line3")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(8), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json")
-//		require.NoError(t, err)
-//		require.Equal(t, 20, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(2), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "json")
-//		require.NoError(t, err)
-//		require.Equal(t, 30, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(2), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json2")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json3")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//
-//		return nil
-//	}))
-//}
-//
-//func TestPutFileSplitBig(t *testing.T) {
-//	if os.Getenv("RUN_BAD_TESTS") == "" {
-//		t.Skip("Skipping because RUN_BAD_TESTS was empty")
-//	}
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		if testing.Short() {
-//			t.Skip("Skipping integration tests in short mode")
-//		}
-//
-//		// create repos
-//		repo := tu.UniqueString("TestPutFileSplitBig")
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		commit, err := env.PachClient.StartCommit(repo, "master")
-//		require.NoError(t, err)
-//		w, err := env.PachClient.PutFileSplitWriter(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false)
-//		require.NoError(t, err)
-//		for i := 0; i < 1000; i++ {
-//			_, err = w.Write([]byte("foo\n"))
-//			require.NoError(t, err)
-//		}
-//		require.NoError(t, w.Close())
-//		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-//		files, err := env.PachClient.ListFileAll(repo, commit.ID, "line")
-//		require.NoError(t, err)
-//		require.Equal(t, 1000, len(files))
-//		for _, fileInfo := range files {
-//			require.Equal(t, uint64(4), fileInfo.SizeBytes)
-//		}
-//
-//		return nil
-//	}))
-//}
-//
-//func TestPutFileSplitCSV(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		// create repos
-//		repo := tu.UniqueString("TestPutFileSplitCSV")
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		_, err := env.PachClient.PutFileSplit(repo, "master", "data", pfs.Delimiter_CSV, 0, 0, 0, false,
-//			// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
-//			strings.NewReader("this,is,a,test\n"+
-//				"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"))
-//		require.NoError(t, err)
-//		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/data")
-//		require.NoError(t, err)
-//		require.Equal(t, 2, len(fileInfos))
-//		var contents bytes.Buffer
-//		env.PachClient.GetFile(repo, "master", "/data/0000000000000000", &contents)
-//		require.Equal(t, "this,is,a,test\n", contents.String())
-//		contents.Reset()
-//		env.PachClient.GetFile(repo, "master", "/data/0000000000000001", &contents)
-//		require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
-//
-//		return nil
-//	}))
-//}
-//
-//func TestPutFileSplitSQL(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		// create repos
-//		repo := tu.UniqueString("TestPutFileSplitSQL")
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//
-//		_, err := env.PachClient.PutFileSplit(repo, "master", "/sql", pfs.Delimiter_SQL, 0, 0, 0,
-//			false, strings.NewReader(tu.TestPGDump))
-//		require.NoError(t, err)
-//		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/sql")
-//		require.NoError(t, err)
-//		require.Equal(t, 5, len(fileInfos))
-//
-//		// Get one of the SQL records & validate it
-//		var contents bytes.Buffer
-//		env.PachClient.GetFile(repo, "master", "/sql/0000000000000000", &contents)
-//		// Validate that the recieved pgdump file creates the cars table
-//		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-//		// Validate the SQL header more generally by passing the output of GetFile
-//		// back through the SQL library & confirm that it parses correctly but only
-//		// has one row
-//		pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
-//		record, err := pgReader.ReadRow()
-//		require.NoError(t, err)
-//		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-//		_, err = pgReader.ReadRow()
-//		require.YesError(t, err)
-//		require.True(t, errors.Is(err, io.EOF))
-//
-//		// Create a new commit that overwrites all existing data & puts it back with
-//		// --header-records=1
-//		commit, err := env.PachClient.StartCommit(repo, "master")
-//		require.NoError(t, err)
-//		require.NoError(t, env.PachClient.DeleteFile(repo, commit.ID, "/sql"))
-//		_, err = env.PachClient.PutFileSplit(repo, commit.ID, "/sql", pfs.Delimiter_SQL, 0, 0, 1,
-//			false, strings.NewReader(tu.TestPGDump))
-//		require.NoError(t, err)
-//		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
-//		fileInfos, err = env.PachClient.ListFileAll(repo, "master", "/sql")
-//		require.NoError(t, err)
-//		require.Equal(t, 4, len(fileInfos))
-//
-//		// Get one of the SQL records & validate it
-//		contents.Reset()
-//		env.PachClient.GetFile(repo, "master", "/sql/0000000000000003", &contents)
-//		// Validate a that the recieved pgdump file creates the cars table
-//		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-//		// Validate the SQL header more generally by passing the output of GetFile
-//		// back through the SQL library & confirm that it parses correctly but only
-//		// has one row
-//		pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
-//		record, err = pgReader.ReadRow()
-//		require.NoError(t, err)
-//		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-//		record, err = pgReader.ReadRow()
-//		require.NoError(t, err)
-//		require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
-//		_, err = pgReader.ReadRow()
-//		require.YesError(t, err)
-//		require.True(t, errors.Is(err, io.EOF))
-//
-//		return nil
-//	}))
-//}
+func TestPutFileSplit(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		if testing.Short() {
+			t.Skip("Skipping integration tests in short mode")
+		}
+
+		// create repos
+		repo := tu.UniqueString("TestPutFileSplit")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "none", pfs.Delimiter_NONE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "line2", pfs.Delimiter_LINE, 2, 0, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "line3", pfs.Delimiter_LINE, 0, 8, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "json2", pfs.Delimiter_JSON, 2, 0, 0, false, strings.NewReader("{}{}{}{}"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "json3", pfs.Delimiter_JSON, 0, 4, 0, false, strings.NewReader("{}{}{}{}"))
+		require.NoError(t, err)
+
+		files, err := env.PachClient.ListFileAll(repo, commit.ID, "line2")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(8), fileInfo.SizeBytes)
+		}
+
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit2.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
+		require.NoError(t, err)
+		err = env.PachClient.PutFileSplit(repo, commit2.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
+		require.NoError(t, err)
+
+		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
+		require.NoError(t, err)
+		require.Equal(t, 9, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(4), fileInfo.SizeBytes)
+		}
+
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit2.ID))
+		fileInfo, err := env.PachClient.InspectFile(repo, commit.ID, "none")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
+		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line")
+		require.NoError(t, err)
+		require.Equal(t, 6, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(4), fileInfo.SizeBytes)
+		}
+		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
+		require.NoError(t, err)
+		require.Equal(t, 9, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(4), fileInfo.SizeBytes)
+		}
+		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line2")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(8), fileInfo.SizeBytes)
+		}
+		files, err = env.PachClient.ListFileAll(repo, commit.ID, "line3")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(8), fileInfo.SizeBytes)
+		}
+		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json")
+		require.NoError(t, err)
+		require.Equal(t, 20, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(2), fileInfo.SizeBytes)
+		}
+		files, err = env.PachClient.ListFileAll(repo, commit2.ID, "json")
+		require.NoError(t, err)
+		require.Equal(t, 30, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(2), fileInfo.SizeBytes)
+		}
+		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json2")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(4), fileInfo.SizeBytes)
+		}
+		files, err = env.PachClient.ListFileAll(repo, commit.ID, "json3")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(4), fileInfo.SizeBytes)
+		}
+
+		return nil
+	}))
+}
+
+func TestPutFileSplitBig(t *testing.T) {
+	if os.Getenv("RUN_BAD_TESTS") == "" {
+		t.Skip("Skipping because RUN_BAD_TESTS was empty")
+	}
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		if testing.Short() {
+			t.Skip("Skipping integration tests in short mode")
+		}
+
+		// create repos
+		repo := tu.UniqueString("TestPutFileSplitBig")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader(strings.Repeat("foo\n", 1000))))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+		files, err := env.PachClient.ListFileAll(repo, commit.ID, "line")
+		require.NoError(t, err)
+		require.Equal(t, 1000, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, uint64(4), fileInfo.SizeBytes)
+		}
+
+		return nil
+	}))
+}
+
+func TestPutFileSplitCSV(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		// create repos
+		repo := tu.UniqueString("TestPutFileSplitCSV")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		err := env.PachClient.PutFileSplit(repo, "master", "data", pfs.Delimiter_CSV, 0, 0, 0, false,
+			// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
+			strings.NewReader("this,is,a,test\n"+
+				"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"))
+		require.NoError(t, err)
+		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/data")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		var contents bytes.Buffer
+		env.PachClient.GetFile(repo, "master", "/data/0000000000000000", &contents)
+		require.Equal(t, "this,is,a,test\n", contents.String())
+		contents.Reset()
+		env.PachClient.GetFile(repo, "master", "/data/0000000000000001", &contents)
+		require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
+
+		return nil
+	}))
+}
+
+func TestPutFileSplitSQL(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		// create repos
+		repo := tu.UniqueString("TestPutFileSplitSQL")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		err := env.PachClient.PutFileSplit(repo, "master", "/sql", pfs.Delimiter_SQL, 0, 0, 0,
+			false, strings.NewReader(tu.TestPGDump))
+		require.NoError(t, err)
+		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 5, len(fileInfos))
+
+		// Get one of the SQL records & validate it
+		var contents bytes.Buffer
+		env.PachClient.GetFile(repo, "master", "/sql/0000000000000000", &contents)
+		// Validate that the recieved pgdump file creates the cars table
+		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+		// Validate the SQL header more generally by passing the output of GetFile
+		// back through the SQL library & confirm that it parses correctly but only
+		// has one row
+		pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
+		record, err := pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
+		_, err = pgReader.ReadRow()
+		require.YesError(t, err)
+		require.True(t, errors.Is(err, io.EOF))
+
+		// Create a new commit that overwrites all existing data & puts it back with
+		// --header-records=1
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.DeleteFile(repo, commit.ID, "/sql/"))
+		err = env.PachClient.PutFileSplit(repo, commit.ID, "/sql", pfs.Delimiter_SQL, 0, 0, 1,
+			false, strings.NewReader(tu.TestPGDump))
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+		fileInfos, err = env.PachClient.ListFileAll(repo, "master", "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 4, len(fileInfos))
+
+		// Get one of the SQL records & validate it
+		contents.Reset()
+		env.PachClient.GetFile(repo, "master", "/sql/0000000000000003", &contents)
+		// Validate a that the recieved pgdump file creates the cars table
+		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+		// Validate the SQL header more generally by passing the output of GetFile
+		// back through the SQL library & confirm that it parses correctly but only
+		// has one row
+		pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
+		record, err = pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
+		record, err = pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
+		_, err = pgReader.ReadRow()
+		require.YesError(t, err)
+		require.True(t, errors.Is(err, io.EOF))
+
+		return nil
+	}))
+}
 
 func TestDiffFile(t *testing.T) {
 	t.Parallel()
@@ -3814,6 +3870,56 @@ func TestCopyFile(t *testing.T) {
 	}))
 }
 
+func TestCopyFileByReference(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		repo := "TestCopyFileByReference"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		masterCommit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		numFiles := 5
+		for i := 0; i < numFiles; i++ {
+			require.NoError(t, env.PachClient.PutFile(repo, masterCommit.ID, fmt.Sprintf("files/%d", i), strings.NewReader(fmt.Sprintf("foo %d\n", i))))
+		}
+		require.NoError(t, env.PachClient.FinishCommit(repo, masterCommit.ID))
+
+		otherCommit, err := env.PachClient.StartCommit(repo, "other")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.WithModifyFileClient(repo, otherCommit.ID, func(mfc *pclient.ModifyFileClient) error {
+			if err := mfc.AppendFile("file", false, strings.NewReader("bar\n")); err != nil {
+				return err
+			}
+			if err := mfc.CopyFile("dir", repo, masterCommit.ID, "files", false); err != nil {
+				return err
+			}
+			return mfc.CopyFile("file0", repo, masterCommit.ID, "files/0", false)
+		}))
+		require.NoError(t, env.PachClient.FinishCommit(repo, otherCommit.ID))
+
+		for i := 0; i < numFiles; i++ {
+			buf := &bytes.Buffer{}
+			require.NoError(t, env.PachClient.GetFile(repo, otherCommit.ID, fmt.Sprintf("dir/%d", i), buf))
+			require.Equal(t, fmt.Sprintf("foo %d\n", i), buf.String())
+		}
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(repo, otherCommit.ID, "file0", buf))
+		require.Equal(t, "foo 0\n", buf.String())
+		buf.Reset()
+		require.NoError(t, env.PachClient.GetFile(repo, otherCommit.ID, "file", buf))
+		require.Equal(t, "bar\n", buf.String())
+
+		// Copying from an open commit is not allowed.
+		openCommit, err := env.PachClient.StartCommit(repo, "open")
+		require.NoError(t, err)
+		require.YesError(t, env.PachClient.WithModifyFileClient(repo, openCommit.ID, func(mfc *pclient.ModifyFileClient) error {
+			return mfc.CopyFile("file1", repo, openCommit.ID, "files/1", false)
+		}))
+		return nil
+	}))
+}
+
 func TestPropagateCommit(t *testing.T) {
 	t.Parallel()
 	db := dbutil.NewTestDB(t)
@@ -5344,50 +5450,58 @@ func TestPutFileOutputRepo(t *testing.T) {
 	}))
 }
 
-// TODO: Make work with V2?
-//func TestFileHistory(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		var err error
-//
-//		repo := "test"
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		numCommits := 10
-//		for i := 0; i < numCommits; i++ {
-//			_, err = env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
-//			require.NoError(t, err)
-//		}
-//		fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", -1)
-//		require.NoError(t, err)
-//		require.Equal(t, numCommits, len(fileInfos))
-//
-//		for i := 1; i < numCommits; i++ {
-//			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
-//			require.NoError(t, err)
-//			require.Equal(t, i, len(fileInfos))
-//		}
-//
-//		require.NoError(t, env.PachClient.DeleteFile(repo, "master", "file"))
-//		for i := 0; i < numCommits; i++ {
-//			_, err = env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
-//			require.NoError(t, err)
-//			_, err = env.PachClient.PutFile(repo, "master", "unrelated", strings.NewReader("foo\n"))
-//			require.NoError(t, err)
-//		}
-//		fileInfos, err = env.PachClient.ListFileHistory(repo, "master", "file", -1)
-//		require.NoError(t, err)
-//		require.Equal(t, numCommits, len(fileInfos))
-//
-//		for i := 1; i < numCommits; i++ {
-//			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
-//			require.NoError(t, err)
-//			require.Equal(t, i, len(fileInfos))
-//		}
-//
-//		return nil
-//	}))
-//}
+func TestFileHistory(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		numCommits := 10
+		for i := 0; i < numCommits; i++ {
+			require.NoError(t, env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n")))
+		}
+		fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", -1)
+		require.NoError(t, err)
+		require.Equal(t, numCommits, len(fileInfos))
+
+		for i := 1; i < numCommits; i++ {
+			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
+			require.NoError(t, err)
+			require.Equal(t, i, len(fileInfos))
+		}
+
+		// Inspecting a version of the file returns the same file info as
+		// listing the history up to that version.
+		for i := 1; i <= numCommits; i++ {
+			fileInfo, err := env.PachClient.InspectFileHistory(repo, "master", "file", int64(i))
+			require.NoError(t, err)
+			require.Equal(t, fileInfos[i-1].File.Commit.ID, fileInfo.File.Commit.ID)
+		}
+		fileInfo, err := env.PachClient.InspectFileHistory(repo, "master", "file", -1)
+		require.NoError(t, err)
+		require.Equal(t, fileInfos[numCommits-1].File.Commit.ID, fileInfo.File.Commit.ID)
+		fileInfo, err = env.PachClient.InspectFileHistory(repo, "master", "file", int64(numCommits+1))
+		require.NoError(t, err)
+		require.Equal(t, fileInfos[numCommits-1].File.Commit.ID, fileInfo.File.Commit.ID)
+
+		require.NoError(t, env.PachClient.DeleteFile(repo, "master", "file"))
+		for i := 0; i < numCommits; i++ {
+			require.NoError(t, env.PachClient.PutFile(repo, "master", "file", strings.NewReader("foo\n")))
+			require.NoError(t, env.PachClient.PutFile(repo, "master", "unrelated", strings.NewReader("foo\n")))
+		}
+		fileInfos, err = env.PachClient.ListFileHistory(repo, "master", "file", -1)
+		require.NoError(t, err)
+		require.Equal(t, numCommits, len(fileInfos))
+
+		for i := 1; i < numCommits; i++ {
+			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "file", int64(i))
+			require.NoError(t, err)
+			require.Equal(t, i, len(fileInfos))
+		}
+
+		return nil
+	}))
+}
 
 func TestUpdateRepo(t *testing.T) {
 	t.Parallel()
@@ -5980,62 +6094,60 @@ func TestFuzzProvenance(t *testing.T) {
 // TestAtomicHistory repeatedly writes to a file while concurrently reading
 // its history. This checks for a regression where the repo would sometimes
 // lock.
-// TODO: Make work with V2?
-//func TestAtomicHistory(t *testing.T) {
-//	t.Parallel()
-//	db := dbutil.NewTestDB(t)
-//	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
-//		repo := tu.UniqueString("TestAtomicHistory")
-//		require.NoError(t, env.PachClient.CreateRepo(repo))
-//		require.NoError(t, env.PachClient.CreateBranch(repo, "master", "", nil))
-//		aSize := 1 * 1024 * 1024
-//		bSize := aSize + 1024
-//
-//		for i := 0; i < 10; i++ {
-//			// create a file of all A's
-//			a := strings.Repeat("A", aSize)
-//			_, err := env.PachClient.PutFileOverwrite(repo, "master", "/file", strings.NewReader(a))
-//			require.NoError(t, err)
-//
-//			// sllowwwllly replace it with all B's
-//			ctx, cancel := context.WithCancel(context.Background())
-//			eg, ctx := errgroup.WithContext(ctx)
-//			eg.Go(func() error {
-//				b := strings.Repeat("B", bSize)
-//				r := SlowReader{underlying: strings.NewReader(b)}
-//				_, err := env.PachClient.PutFileOverwrite(repo, "master", "/file", &r)
-//				cancel()
-//				return err
-//			})
-//
-//			// should pull /file when it's all A's
-//			eg.Go(func() error {
-//				for {
-//					fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "/file", 1)
-//					require.NoError(t, err)
-//					require.Equal(t, len(fileInfos), 1)
-//
-//					// stop once B's have been written
-//					select {
-//					case <-ctx.Done():
-//						return nil
-//					default:
-//						time.Sleep(1 * time.Millisecond)
-//					}
-//				}
-//			})
-//
-//			require.NoError(t, eg.Wait())
-//
-//			// should pull /file when it's all B's
-//			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "/file", 1)
-//			require.NoError(t, err)
-//			require.Equal(t, 1, len(fileInfos))
-//			require.Equal(t, bSize, int(fileInfos[0].SizeBytes))
-//		}
-//		return nil
-//	}))
-//}
+func TestAtomicHistory(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		repo := tu.UniqueString("TestAtomicHistory")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.CreateBranch(repo, "master", "", nil))
+		aSize := 1 * 1024 * 1024
+		bSize := aSize + 1024
+
+		for i := 0; i < 10; i++ {
+			// create a file of all A's
+			a := strings.Repeat("A", aSize)
+			require.NoError(t, env.PachClient.PutFileOverwrite(repo, "master", "/file", strings.NewReader(a)))
+
+			// sllowwwllly replace it with all B's
+			ctx, cancel := context.WithCancel(context.Background())
+			eg, ctx := errgroup.WithContext(ctx)
+			eg.Go(func() error {
+				b := strings.Repeat("B", bSize)
+				r := SlowReader{underlying: strings.NewReader(b)}
+				err := env.PachClient.PutFileOverwrite(repo, "master", "/file", &r)
+				cancel()
+				return err
+			})
+
+			// should pull /file when it's all A's
+			eg.Go(func() error {
+				for {
+					fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "/file", 1)
+					require.NoError(t, err)
+					require.Equal(t, len(fileInfos), 1)
+
+					// stop once B's have been written
+					select {
+					case <-ctx.Done():
+						return nil
+					default:
+						time.Sleep(1 * time.Millisecond)
+					}
+				}
+			})
+
+			require.NoError(t, eg.Wait())
+
+			// should pull /file when it's all B's
+			fileInfos, err := env.PachClient.ListFileHistory(repo, "master", "/file", 1)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(fileInfos))
+			require.Equal(t, bSize, int(fileInfos[0].SizeBytes))
+		}
+		return nil
+	}))
+}
 
 type SlowReader struct {
 	underlying io.Reader
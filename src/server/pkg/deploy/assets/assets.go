@@ -72,6 +72,10 @@ var (
 		APIGroups: []string{""},
 		Verbs:     []string{"get", "list", "watch", "create", "update", "delete", "deletecollection"},
 		Resources: []string{"secrets"},
+	}, {
+		APIGroups: []string{"kubeflow.org"},
+		Verbs:     []string{"get", "list", "watch", "create", "delete"},
+		Resources: []string{"tfjobs"},
 	}}
 
 	// The name of the local volume (mounted kubernetes secret) where pachd
@@ -81,6 +85,12 @@ var (
 	// tlsVolumeName)
 	tlsSecretName = "pachd-tls-cert"
 
+	// The name of the (optional) kubernetes secret holding the keys that pachd
+	// encrypts chunks with, and the path where it's mounted in pachd.
+	// (this path is also the default in the service env config)
+	storageEncryptionSecretName = "pachyderm-storage-encryption"
+	storageEncryptionKeyDir     = "/pachyderm-storage-encryption"
+
 	// IAMAnnotation is the annotation used for the IAM role, this can work
 	// with something like kube2iam as an alternative way to provide
 	// credentials.
@@ -127,6 +137,12 @@ const (
 
 	// PutFileConcurrencyLimitEnvVar is the environment variable for the PutFile concurrency limit.
 	PutFileConcurrencyLimitEnvVar = "STORAGE_PUT_FILE_CONCURRENCY_LIMIT"
+
+	// EncryptionKeyIDEnvVar is the environment variable for the ID of the key used to encrypt new chunks.
+	EncryptionKeyIDEnvVar = "STORAGE_ENCRYPTION_KEY_ID"
+
+	// CompressionAlgoEnvVar is the environment variable for the algorithm used to compress new chunks.
+	CompressionAlgoEnvVar = "STORAGE_COMPRESSION_ALGO"
 )
 
 const (
@@ -143,6 +159,12 @@ const (
 type StorageOpts struct {
 	UploadConcurrencyLimit  int
 	PutFileConcurrencyLimit int
+	// EncryptionKeyID is the ID of the key (in the storage encryption secret)
+	// used to encrypt new chunks. Chunks are not encrypted if it's empty.
+	EncryptionKeyID string
+	// CompressionAlgo is the algorithm used to compress new chunks. Chunks
+	// are not compressed if it's empty.
+	CompressionAlgo string
 }
 
 const (
@@ -449,6 +471,26 @@ func workerRoleBinding(opts *AssetOpts) *rbacv1.RoleBinding {
 	}
 }
 
+// GetStorageEncryptionSecretVolumeAndMount returns a properly configured Volume
+// and VolumeMount object for the storage encryption secret. The secret is
+// optional, and is mounted even if new chunks aren't encrypted so that
+// previously encrypted chunks can still be read.
+func GetStorageEncryptionSecretVolumeAndMount() (v1.Volume, v1.VolumeMount) {
+	optional := true
+	return v1.Volume{
+			Name: storageEncryptionSecretName,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: storageEncryptionSecretName,
+					Optional:   &optional,
+				},
+			},
+		}, v1.VolumeMount{
+			Name:      storageEncryptionSecretName,
+			MountPath: storageEncryptionKeyDir,
+		}
+}
+
 // GetBackendSecretVolumeAndMount returns a properly configured Volume and
 // VolumeMount object given a backend.  The backend needs to be one of the
 // constants defined in pfs/server.
@@ -494,10 +536,17 @@ func GetSecretEnvVars(storageBackend string) []v1.EnvVar {
 }
 
 func getStorageEnvVars(opts *AssetOpts) []v1.EnvVar {
-	return []v1.EnvVar{
+	envVars := []v1.EnvVar{
 		{Name: UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(opts.StorageOpts.UploadConcurrencyLimit)},
 		{Name: PutFileConcurrencyLimitEnvVar, Value: strconv.Itoa(opts.StorageOpts.PutFileConcurrencyLimit)},
 	}
+	if opts.StorageOpts.EncryptionKeyID != "" {
+		envVars = append(envVars, v1.EnvVar{Name: EncryptionKeyIDEnvVar, Value: opts.StorageOpts.EncryptionKeyID})
+	}
+	if opts.StorageOpts.CompressionAlgo != "" {
+		envVars = append(envVars, v1.EnvVar{Name: CompressionAlgoEnvVar, Value: opts.StorageOpts.CompressionAlgo})
+	}
+	return envVars
 }
 
 func versionedPachdImage(opts *AssetOpts) string {
@@ -577,6 +626,9 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend Backend, hostPath strin
 	volume, mount := GetBackendSecretVolumeAndMount(backendEnvVar)
 	volumes = append(volumes, volume)
 	volumeMounts = append(volumeMounts, mount)
+	volume, mount = GetStorageEncryptionSecretVolumeAndMount()
+	volumes = append(volumes, volume)
+	volumeMounts = append(volumeMounts, mount)
 	if opts.TLS != nil {
 		volumes = append(volumes, v1.Volume{
 			Name: tlsVolumeName,
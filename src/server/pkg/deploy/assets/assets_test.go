@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestAddRegistry_NoSlash(t *testing.T) {
@@ -36,3 +37,16 @@ func TestAddRegistry_SwitchRegistry(t *testing.T) {
 	img := AddRegistry(registry, imageName)
 	require.Equal(t, expected, img)
 }
+
+func TestStorageEnvVars(t *testing.T) {
+	opts := &AssetOpts{}
+	for _, envVar := range getStorageEnvVars(opts) {
+		require.NotEqual(t, EncryptionKeyIDEnvVar, envVar.Name)
+		require.NotEqual(t, CompressionAlgoEnvVar, envVar.Name)
+	}
+	opts.StorageOpts.EncryptionKeyID = "key-1"
+	opts.StorageOpts.CompressionAlgo = "zstd"
+	envVars := getStorageEnvVars(opts)
+	require.OneOfEquals(t, v1.EnvVar{Name: EncryptionKeyIDEnvVar, Value: "key-1"}, envVars)
+	require.OneOfEquals(t, v1.EnvVar{Name: CompressionAlgoEnvVar, Value: "zstd"}, envVars)
+}
@@ -33,6 +33,7 @@ import (
 	_metrics "github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serde"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	clientcmd "k8s.io/client-go/tools/clientcmd/api/v1"
 
 	docker "github.com/fsouza/go-dockerclient"
@@ -314,6 +315,8 @@ func standardDeployCmds() []*cobra.Command {
 	var registry string
 	var tlsCertKey string
 	var uploadConcurrencyLimit int
+	var storageEncryptionKeyID string
+	var storageCompressionAlgo string
 	var putFileConcurrencyLimit int
 	var clusterDeploymentID string
 	var requireCriticalServersOnly bool
@@ -341,6 +344,8 @@ func standardDeployCmds() []*cobra.Command {
 		cmd.Flags().BoolVar(&exposeObjectAPI, "expose-object-api", false, "If set, instruct pachd to serve its object/block API on its public port (not safe with auth enabled, do not set in production).")
 		cmd.Flags().StringVar(&tlsCertKey, "tls", "", "string of the form \"<cert path>,<key path>\" of the signed TLS certificate and private key that Pachd should use for TLS authentication (enables TLS-encrypted communication with Pachd)")
 		cmd.Flags().IntVar(&uploadConcurrencyLimit, "upload-concurrency-limit", assets.DefaultUploadConcurrencyLimit, "The maximum number of concurrent object storage uploads per Pachd instance.")
+		cmd.Flags().StringVar(&storageEncryptionKeyID, "storage-encryption-key-id", "", "If set, encrypt stored data with this key, which must be in the 'pachyderm-storage-encryption' secret (one hex-encoded 32 byte key per secret entry, named by key ID).")
+		cmd.Flags().StringVar(&storageCompressionAlgo, "storage-compression-algo", "", "If set, compress stored data with this algorithm, one of: gzip, zstd, snappy.")
 		cmd.Flags().IntVar(&putFileConcurrencyLimit, "put-file-concurrency-limit", assets.DefaultPutFileConcurrencyLimit, "The maximum number of files to upload or fetch from remote sources (HTTP, blob storage) using PutFile concurrently.")
 		cmd.Flags().StringVar(&clusterDeploymentID, "cluster-deployment-id", "", "Set an ID for the cluster deployment. Defaults to a random value.")
 		cmd.Flags().BoolVar(&requireCriticalServersOnly, "require-critical-servers-only", assets.DefaultRequireCriticalServersOnly, "Only require the critical Pachd servers to startup and run without errors.")
@@ -416,6 +421,10 @@ func standardDeployCmds() []*cobra.Command {
 				"will be enabled: %v.\n", err)
 		}
 
+		if _, err := chunk.ParseCompressionAlgo(storageCompressionAlgo); err != nil {
+			return err
+		}
+
 		if namespace == "" {
 			kubeConfig := config.KubeConfig(nil)
 			var err error
@@ -449,6 +458,8 @@ func standardDeployCmds() []*cobra.Command {
 			StorageOpts: assets.StorageOpts{
 				UploadConcurrencyLimit:  uploadConcurrencyLimit,
 				PutFileConcurrencyLimit: putFileConcurrencyLimit,
+				EncryptionKeyID:         storageEncryptionKeyID,
+				CompressionAlgo:         storageCompressionAlgo,
 			},
 			PachdShards:                uint64(pachdShards),
 			Version:                    version.PrettyPrintVersion(version.Version),
@@ -0,0 +1,51 @@
+package obj
+
+import (
+	"context"
+	io "io"
+	"time"
+)
+
+var _ Client = &latencyClient{}
+
+// latencyClient is a Client which adds a fixed latency to opening objects for
+// reading and writing, to simulate a remote object store.
+type latencyClient struct {
+	Client
+	latency time.Duration
+}
+
+// NewLatencyClient constructs a Client which waits for latency before opening
+// each object for reading or writing.
+// This is useful for testing and benchmarking against a local Client.
+func NewLatencyClient(client Client, latency time.Duration) Client {
+	return &latencyClient{
+		Client:  client,
+		latency: latency,
+	}
+}
+
+func (lc *latencyClient) Writer(ctx context.Context, name string) (io.WriteCloser, error) {
+	if err := lc.wait(ctx); err != nil {
+		return nil, err
+	}
+	return lc.Client.Writer(ctx, name)
+}
+
+func (lc *latencyClient) Reader(ctx context.Context, name string, offset, size uint64) (io.ReadCloser, error) {
+	if err := lc.wait(ctx); err != nil {
+		return nil, err
+	}
+	return lc.Client.Reader(ctx, name, offset, size)
+}
+
+func (lc *latencyClient) wait(ctx context.Context) error {
+	t := time.NewTimer(lc.latency)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -2,17 +2,218 @@ package pfssync
 
 import (
 	"archive/tar"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"syscall"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/tarutil"
 )
 
+// PullOption configures a pull.
+type PullOption func(*pullConfig)
+
+type pullConfig struct {
+	headerCallbacks []func(*tar.Header) error
+	readahead       int
+}
+
+// WithHeaderCallback sets a callback that is called with the header of each
+// file that is pulled.
+func WithHeaderCallback(cb func(*tar.Header) error) PullOption {
+	return func(pc *pullConfig) {
+		pc.headerCallbacks = append(pc.headerCallbacks, cb)
+	}
+}
+
+// WithReadahead sets the number of chunks that are fetched from object
+// storage in parallel while pulling the files.
+func WithReadahead(n int) PullOption {
+	return func(pc *pullConfig) {
+		pc.readahead = n
+	}
+}
+
+func newPullConfig(opts ...PullOption) *pullConfig {
+	pc := &pullConfig{}
+	for _, opt := range opts {
+		opt(pc)
+	}
+	return pc
+}
+
 // Pull pulls a file from PFS and stores it in the local filesystem.
-func Pull(pachClient *client.APIClient, file *pfs.File, storageRoot string, cb ...func(*tar.Header) error) error {
-	r, err := pachClient.GetTarFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+func Pull(pachClient *client.APIClient, file *pfs.File, storageRoot string, opts ...PullOption) error {
+	pc := newPullConfig(opts...)
+	r, err := pachClient.GetTarFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, client.WithReadahead(pc.readahead))
+	if err != nil {
+		return err
+	}
+	return tarutil.Import(storageRoot, r, pc.headerCallbacks...)
+}
+
+// Puller handles pulling data from PFS for lazy and empty file inputs. Lazy
+// files are created as named pipes, which are filled from PFS when they are
+// opened.
+type Puller struct {
+	mu sync.Mutex
+	// pipes tracks the pipes that have not been opened yet.
+	pipes     map[string]bool
+	pipePaths []string
+	cleaned   bool
+	wg        sync.WaitGroup
+	size      int64
+	errCh     chan error
+}
+
+// NewPuller creates a new puller.
+func NewPuller() *Puller {
+	return &Puller{
+		pipes: make(map[string]bool),
+		errCh: make(chan error, 1),
+	}
+}
+
+// Pull pulls a file from PFS and stores it in the local filesystem.
+// If lazy is set, the files are created as named pipes that are filled when
+// they are opened. Otherwise, if emptyFiles is set, the files are created as
+// zero-byte placeholders. CleanUp must be called when the files are no longer
+// in use.
+func (p *Puller) Pull(pachClient *client.APIClient, file *pfs.File, storageRoot string, lazy, emptyFiles bool, opts ...PullOption) error {
+	if !lazy && !emptyFiles {
+		return Pull(pachClient, file, storageRoot, opts...)
+	}
+	pc := newPullConfig(opts...)
+	repo, commit := file.Commit.Repo.Name, file.Commit.ID
+	return pachClient.WalkFile(repo, commit, file.Path, func(fi *pfs.FileInfo) error {
+		fullPath := path.Join(storageRoot, fi.File.Path)
+		if fi.FileType == pfs.FileType_DIR {
+			return os.MkdirAll(fullPath, 0700)
+		}
+		if lazy {
+			return p.makePipe(fullPath, func(w io.Writer) error {
+				return pachClient.GetFile(repo, commit, fi.File.Path, w, client.WithReadahead(pc.readahead))
+			})
+		}
+		return makeEmptyFile(fullPath)
+	})
+}
+
+func makeEmptyFile(fullPath string) error {
+	if err := os.MkdirAll(path.Dir(fullPath), 0700); err != nil {
+		return err
+	}
+	f, err := os.Create(fullPath)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// CleanUp unblocks and removes the pipes created by the puller. It returns
+// the number of bytes written to the pipes and the first error encountered
+// while filling them.
+func (p *Puller) CleanUp() (int64, error) {
+	var pipes []string
+	func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.cleaned = true
+		for path := range p.pipes {
+			pipes = append(pipes, path)
+		}
+	}()
+	// Open the pipes that were never opened by the user code, so that the
+	// goroutines waiting to fill them can exit.
+	var retErr error
+	for _, path := range pipes {
+		f, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, os.ModeNamedPipe)
+		if err != nil {
+			if retErr == nil {
+				retErr = err
+			}
+			continue
+		}
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}
+	p.wg.Wait()
+	for _, path := range p.pipePaths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) && retErr == nil {
+			retErr = err
+		}
+	}
+	select {
+	case err := <-p.errCh:
+		if retErr == nil {
+			retErr = err
+		}
+	default:
+	}
+	return p.size, retErr
+}
+
+type sizeWriter struct {
+	w    io.Writer
+	size int64
+}
+
+func (sw *sizeWriter) Write(p []byte) (int, error) {
+	n, err := sw.w.Write(p)
+	sw.size += int64(n)
+	return n, err
+}
+
+// isPipeClosedErr returns true if the error is the result of the reader
+// closing a pipe before all of its content was written, which happens when
+// user code only reads part of a lazy file.
+func isPipeClosedErr(err error) bool {
+	return errors.Is(err, syscall.EPIPE)
+}
+
+// PushObj pushes the content of a commit from PFS to object storage under
+// root. Existing objects are overwritten, so an interrupted push can be
+// restarted from the beginning.
+func PushObj(pachClient *client.APIClient, commit *pfs.Commit, objClient obj.Client, root string) error {
+	r, err := pachClient.GetTarFile(commit.Repo.Name, commit.ID, "/")
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := pushObj(pachClient, objClient, strings.TrimPrefix(path.Join(root, hdr.Name), "/"), tr); err != nil {
+			return err
+		}
+	}
+}
+
+func pushObj(pachClient *client.APIClient, objClient obj.Client, name string, r io.Reader) (retErr error) {
+	w, err := objClient.Writer(pachClient.Ctx(), name)
 	if err != nil {
 		return err
 	}
-	return tarutil.Import(storageRoot, r, cb...)
+	defer func() {
+		if err := w.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(w, r)
+	return err
 }
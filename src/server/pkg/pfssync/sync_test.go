@@ -0,0 +1,123 @@
+package pfssync
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
+)
+
+func TestPushObj(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		repo := tu.UniqueString(t.Name())
+		require.NoError(t, c.CreateRepo(repo))
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		files := make(map[string]string)
+		for i := 0; i < 10; i++ {
+			name := fmt.Sprintf("dir%v/file%v", i%3, i)
+			files[name] = strings.Repeat(name, i)
+			require.NoError(t, c.PutFile(repo, commit.ID, name, strings.NewReader(files[name])))
+		}
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		check := func(t *testing.T, objClient obj.Client, root string) {
+			for name, content := range files {
+				r, err := objClient.Reader(c.Ctx(), path.Join(root, name), 0, 0)
+				require.NoError(t, err)
+				buf := &bytes.Buffer{}
+				_, err = buf.ReadFrom(r)
+				require.NoError(t, err)
+				require.NoError(t, r.Close())
+				require.Equal(t, content, buf.String())
+			}
+		}
+		push := func(t *testing.T, objClient obj.Client, root string) {
+			require.NoError(t, PushObj(c, commit, objClient, root))
+			check(t, objClient, root)
+			// Pushing again (as when resuming an egress) should overwrite
+			// the existing objects.
+			require.NoError(t, PushObj(c, commit, objClient, root))
+			check(t, objClient, root)
+		}
+		t.Run("Local", func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "push-obj")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			objClient, err := obj.NewLocalClient(dir)
+			require.NoError(t, err)
+			push(t, objClient, "out")
+		})
+		t.Run("Minio", func(t *testing.T) {
+			if _, ok := os.LookupEnv(obj.MinioEndpointEnvVar); !ok {
+				t.Skip("Skipping because MINIO_ENDPOINT was not set")
+			}
+			objClient, err := obj.NewMinioClientFromEnv()
+			require.NoError(t, err)
+			push(t, objClient, tu.UniqueString("out"))
+		})
+		return nil
+	}))
+}
+
+func TestPullLazyAndEmptyFiles(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		repo := tu.UniqueString(t.Name())
+		require.NoError(t, c.CreateRepo(repo))
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(repo, commit.ID, "foo", strings.NewReader("foo\n")))
+		require.NoError(t, c.PutFile(repo, commit.ID, "dir/bar", strings.NewReader("bar\n")))
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		t.Run("Lazy", func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "pull-lazy")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			puller := NewPuller()
+			require.NoError(t, puller.Pull(c, client.NewFile(repo, commit.ID, "/"), dir, true, false))
+			fi, err := os.Stat(path.Join(dir, "dir/bar"))
+			require.NoError(t, err)
+			require.True(t, fi.Mode()&os.ModeNamedPipe != 0)
+			// Only one of the pipes is opened, the other should still be cleaned up.
+			data, err := ioutil.ReadFile(path.Join(dir, "dir/bar"))
+			require.NoError(t, err)
+			require.Equal(t, "bar\n", string(data))
+			size, err := puller.CleanUp()
+			require.NoError(t, err)
+			require.Equal(t, int64(4), size)
+			_, err = os.Stat(path.Join(dir, "foo"))
+			require.True(t, os.IsNotExist(err))
+		})
+		t.Run("EmptyFiles", func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "pull-empty")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+			puller := NewPuller()
+			require.NoError(t, puller.Pull(c, client.NewFile(repo, commit.ID, "/"), dir, false, true))
+			for _, name := range []string{"foo", "dir/bar"} {
+				fi, err := os.Stat(path.Join(dir, name))
+				require.NoError(t, err)
+				require.True(t, fi.Mode().IsRegular())
+				require.Equal(t, int64(0), fi.Size())
+			}
+			_, err = puller.CleanUp()
+			require.NoError(t, err)
+		})
+		return nil
+	}))
+}
@@ -140,7 +140,7 @@ func GetPipelineInfoAllowIncomplete(pachClient *client.APIClient, name string, p
 // the PFS read/unmarshalling of bytes as well as filling in missing fields
 func GetPipelineInfo(pachClient *client.APIClient, name string, ptr *pps.EtcdPipelineInfo) (*pps.PipelineInfo, error) {
 	result, err := GetPipelineInfoAllowIncomplete(pachClient, name, ptr)
-	if err == nil && result.Transform == nil {
+	if err == nil && result.Transform == nil && result.TFJob == nil {
 		return nil, errors.Errorf("could not retrieve pipeline spec file from PFS for pipeline '%s', there may be a problem reaching object storage, or the pipeline may need to be deleted and recreated", result.Pipeline.Name)
 	}
 	return result, err
@@ -302,6 +302,7 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 	return &pps.CreatePipelineRequest{
 		Pipeline:              pipelineInfo.Pipeline,
 		Transform:             pipelineInfo.Transform,
+		TFJob:                 pipelineInfo.TFJob,
 		ParallelismSpec:       pipelineInfo.ParallelismSpec,
 		Egress:                pipelineInfo.Egress,
 		OutputBranch:          pipelineInfo.OutputBranch,
@@ -92,6 +92,10 @@ type StorageConfiguration struct {
 	StorageCompactionMaxFanIn      int    `env:"STORAGE_COMPACTION_MAX_FANIN,default=50"`
 	StorageFileSetsMaxOpen         int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize           int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
+	StorageMaxReadahead            int    `env:"STORAGE_MAX_READAHEAD,default=16"`
+	StorageCompressionAlgo         string `env:"STORAGE_COMPRESSION_ALGO"`
+	StorageEncryptionKeyDir        string `env:"STORAGE_ENCRYPTION_KEY_DIR,default=/pachyderm-storage-encryption"`
+	StorageEncryptionKeyID         string `env:"STORAGE_ENCRYPTION_KEY_ID"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -109,6 +113,9 @@ type WorkerSpecificConfiguration struct {
 	PPSPipelineName string `env:"PPS_PIPELINE_NAME,required"`
 	// The name of this pod
 	PodName string `env:"PPS_POD_NAME,required"`
+	// The number of chunks that are fetched in parallel while downloading the
+	// input data for a datum
+	DatumReadahead int `env:"PPS_DATUM_READAHEAD,default=4"`
 }
 
 // FeatureFlags contains the configuration for feature flags.  XXX: if you're
@@ -23,9 +23,25 @@ func (env *ServiceEnv) ChunkStorageOptions() ([]chunk.StorageOption, error) {
 		}
 		opts = append(opts, chunk.WithObjectCache(diskCache, env.StorageDiskCacheSize))
 	}
+	compression, err := chunk.ParseCompressionAlgo(env.StorageCompressionAlgo)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, chunk.WithCompression(compression))
+	keyring, err := env.StorageEncryptionKeyring()
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, chunk.WithEncryption(keyring))
 	return opts, nil
 }
 
+// StorageEncryptionKeyring loads the keys used to encrypt chunks from the storage
+// encryption key directory (the mounted storage encryption secret).
+func (c *StorageConfiguration) StorageEncryptionKeyring() (*chunk.Keyring, error) {
+	return chunk.LoadKeyring(c.StorageEncryptionKeyDir, c.StorageEncryptionKeyID)
+}
+
 // FileSetStorageOptions returns the fileset storage options for the service environment.
 func (env *ServiceEnv) FileSetStorageOptions() []fileset.StorageOption {
 	var opts []fileset.StorageOption
@@ -19,6 +19,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/dynamic"
 	kube "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
@@ -54,6 +55,9 @@ type ServiceEnv struct {
 	// kubeClient is a kubernetes client that, if initialized, is shared by all
 	// users of this environment
 	kubeClient *kube.Clientset
+	// dynamicKubeClient is a kubernetes client for custom resources (e.g.
+	// Kubeflow TFJobs). It's initialized alongside kubeClient.
+	dynamicKubeClient dynamic.Interface
 	// kubeEg coordinates the initialization of kubeClient (see pachdEg)
 	kubeEg errgroup.Group
 
@@ -172,6 +176,10 @@ func (env *ServiceEnv) initKubeClient() error {
 		if err != nil {
 			return errors.Wrapf(err, "could not initialize kube client")
 		}
+		env.dynamicKubeClient, err = dynamic.NewForConfig(cfg)
+		if err != nil {
+			return errors.Wrapf(err, "could not initialize dynamic kube client")
+		}
 		return nil
 	}, backoff.RetryEvery(time.Second).For(5*time.Minute))
 }
@@ -239,6 +247,18 @@ func (env *ServiceEnv) GetKubeClient() *kube.Clientset {
 	return env.kubeClient
 }
 
+// GetDynamicKubeClient returns the already connected dynamic Kubernetes API
+// client without modification.
+func (env *ServiceEnv) GetDynamicKubeClient() dynamic.Interface {
+	if err := env.kubeEg.Wait(); err != nil {
+		panic(err) // If env can't connect, there's no sensible way to recover
+	}
+	if env.dynamicKubeClient == nil {
+		panic("service env never connected to kubernetes")
+	}
+	return env.dynamicKubeClient
+}
+
 // GetLokiClient returns the loki client, it doesn't require blocking on a
 // connection because the client is just a dumb struct with no init function.
 func (env *ServiceEnv) GetLokiClient() (*loki.Client, error) {
@@ -22,6 +22,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CompressionAlgo is the algorithm used to compress a chunk in object storage.
+type CompressionAlgo int32
+
+const (
+	CompressionAlgo_NONE   CompressionAlgo = 0
+	CompressionAlgo_GZIP   CompressionAlgo = 1
+	CompressionAlgo_ZSTD   CompressionAlgo = 2
+	CompressionAlgo_SNAPPY CompressionAlgo = 3
+)
+
+var CompressionAlgo_name = map[int32]string{
+	0: "NONE",
+	1: "GZIP",
+	2: "ZSTD",
+	3: "SNAPPY",
+}
+
+var CompressionAlgo_value = map[string]int32{
+	"NONE":   0,
+	"GZIP":   1,
+	"ZSTD":   2,
+	"SNAPPY": 3,
+}
+
+func (x CompressionAlgo) String() string {
+	return proto.EnumName(CompressionAlgo_name, int32(x))
+}
+
+func (CompressionAlgo) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_80b36f82a9f02ff9, []int{0}
+}
+
 // DataRef is a reference to data within a chunk.
 type DataRef struct {
 	// The chunk the referenced data is located in.
@@ -99,9 +131,15 @@ func (m *DataRef) GetSizeBytes() int64 {
 }
 
 type Ref struct {
-	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SizeBytes            int64    `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	Edge                 bool     `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	Id              []byte          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SizeBytes       int64           `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Edge            bool            `protobuf:"varint,3,opt,name=edge,proto3" json:"edge,omitempty"`
+	CompressionAlgo CompressionAlgo `protobuf:"varint,4,opt,name=compression_algo,json=compressionAlgo,proto3,enum=chunk.CompressionAlgo" json:"compression_algo,omitempty"`
+	// The ID of the master key that the chunk was encrypted with when it was
+	// written, or empty if the chunk is not encrypted. Encrypted chunks also
+	// record the ID of their current key in object storage, which differs from
+	// this ID once the chunk has been re-encrypted with a newer key.
+	EncryptionKeyId      string   `protobuf:"bytes,5,opt,name=encryption_key_id,json=encryptionKeyId,proto3" json:"encryption_key_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -161,7 +199,22 @@ func (m *Ref) GetEdge() bool {
 	return false
 }
 
+func (m *Ref) GetCompressionAlgo() CompressionAlgo {
+	if m != nil {
+		return m.CompressionAlgo
+	}
+	return CompressionAlgo_NONE
+}
+
+func (m *Ref) GetEncryptionKeyId() string {
+	if m != nil {
+		return m.EncryptionKeyId
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("chunk.CompressionAlgo", CompressionAlgo_name, CompressionAlgo_value)
 	proto.RegisterType((*DataRef)(nil), "chunk.DataRef")
 	proto.RegisterType((*Ref)(nil), "chunk.Ref")
 }
@@ -171,23 +224,29 @@ func init() {
 }
 
 var fileDescriptor_80b36f82a9f02ff9 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4e, 0x2d, 0x2a,
-	0x4b, 0x2d, 0xd2, 0x2f, 0xc8, 0x4e, 0xd7, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0xd5, 0x4f,
-	0xce, 0x28, 0xcd, 0xcb, 0x86, 0x90, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xac, 0x60, 0x8e,
-	0x52, 0x2d, 0x17, 0xbb, 0x4b, 0x62, 0x49, 0x62, 0x50, 0x6a, 0x9a, 0x90, 0x0c, 0x17, 0x73, 0x51,
-	0x6a, 0x9a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x11, 0x97, 0x1e, 0x44, 0x71, 0x50, 0x6a, 0x5a,
-	0x10, 0x48, 0x58, 0x48, 0x88, 0x8b, 0x25, 0x23, 0xb1, 0x38, 0x43, 0x82, 0x49, 0x81, 0x51, 0x83,
-	0x33, 0x08, 0xcc, 0x16, 0x52, 0xe4, 0xe2, 0xc9, 0x4f, 0x4b, 0x2b, 0x4e, 0x2d, 0x89, 0x4f, 0xaa,
-	0x2c, 0x49, 0x2d, 0x96, 0x60, 0x56, 0x60, 0xd4, 0x60, 0x0e, 0xe2, 0x86, 0x88, 0x39, 0x81, 0x84,
-	0x84, 0x64, 0xb9, 0xb8, 0x8a, 0x33, 0xab, 0x52, 0xa1, 0x0a, 0x58, 0xc0, 0x0a, 0x38, 0x41, 0x22,
-	0x60, 0x69, 0x25, 0x0f, 0x2e, 0x66, 0x90, 0xd5, 0x7c, 0x5c, 0x4c, 0x99, 0x29, 0x60, 0x9b, 0x79,
-	0x82, 0x98, 0x32, 0x53, 0xd0, 0x74, 0x31, 0xa1, 0xe9, 0x02, 0xb9, 0x25, 0x35, 0x25, 0x3d, 0x15,
-	0x6c, 0x1f, 0x47, 0x10, 0x98, 0xed, 0xe4, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x46, 0x59, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7,
-	0xea, 0x17, 0x24, 0x26, 0x67, 0x54, 0xa6, 0xa4, 0x16, 0x21, 0xb3, 0x8a, 0x8b, 0x92, 0xf5, 0x71,
-	0x05, 0x53, 0x12, 0x1b, 0x38, 0x84, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x31, 0x4d,
-	0xd5, 0x49, 0x01, 0x00, 0x00,
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x6a, 0xf2, 0x40,
+	0x14, 0xfd, 0x26, 0x51, 0x3f, 0xbd, 0x8a, 0xa6, 0xb3, 0x28, 0x59, 0xb4, 0x62, 0xa5, 0x0b, 0x71,
+	0x61, 0xc0, 0x2e, 0x5d, 0x69, 0x2d, 0x45, 0x0a, 0x56, 0xc6, 0x6e, 0xea, 0x46, 0x62, 0x72, 0xf3,
+	0x83, 0x3f, 0x13, 0x66, 0x62, 0x21, 0x85, 0x3e, 0x57, 0x5f, 0xa1, 0xcb, 0x3e, 0x42, 0xf1, 0x49,
+	0xca, 0x8c, 0x82, 0xad, 0xd0, 0xcd, 0x70, 0xe6, 0x9c, 0x73, 0xef, 0xb9, 0x97, 0x0b, 0xd7, 0x12,
+	0xc5, 0x0b, 0x0a, 0x27, 0x59, 0x86, 0x8e, 0x4c, 0xb9, 0x70, 0x43, 0x74, 0xbc, 0x68, 0xbb, 0x59,
+	0xee, 0xdf, 0x4e, 0x22, 0x78, 0xca, 0x69, 0x5e, 0x7f, 0x9a, 0x6f, 0xf0, 0x7f, 0xe8, 0xa6, 0x2e,
+	0xc3, 0x80, 0x5e, 0x80, 0x29, 0x30, 0xb0, 0x49, 0x83, 0xb4, 0xca, 0x5d, 0xe8, 0xec, 0xcd, 0x0c,
+	0x03, 0xa6, 0x68, 0x4a, 0x21, 0x17, 0xb9, 0x32, 0xb2, 0x8d, 0x06, 0x69, 0x95, 0x98, 0xc6, 0xf4,
+	0x0a, 0x2a, 0x3c, 0x08, 0x24, 0xa6, 0xf3, 0x45, 0x96, 0xa2, 0xb4, 0xcd, 0x06, 0x69, 0x99, 0xac,
+	0xbc, 0xe7, 0x06, 0x8a, 0xa2, 0x97, 0x00, 0x32, 0x7e, 0xc5, 0x83, 0x21, 0xa7, 0x0d, 0x25, 0xc5,
+	0x68, 0xb9, 0xf9, 0x4e, 0xc0, 0x54, 0xd9, 0x55, 0x30, 0x62, 0x5f, 0x47, 0x57, 0x98, 0x11, 0xfb,
+	0x27, 0x65, 0xc6, 0x49, 0x99, 0x1a, 0x06, 0xfd, 0x10, 0x75, 0x60, 0x91, 0x69, 0x4c, 0xfb, 0x60,
+	0x79, 0x7c, 0x9d, 0x08, 0x94, 0x32, 0xe6, 0x9b, 0xb9, 0xbb, 0x0a, 0xb9, 0xce, 0xab, 0x76, 0xcf,
+	0x0f, 0xbb, 0xdc, 0x1e, 0xe5, 0xfe, 0x2a, 0xe4, 0xac, 0xe6, 0xfd, 0x26, 0x68, 0x1b, 0xce, 0x70,
+	0xe3, 0x89, 0x2c, 0x49, 0x55, 0x87, 0x25, 0x66, 0xf3, 0xd8, 0xb7, 0xf3, 0x7a, 0xe1, 0xda, 0x51,
+	0x78, 0xc0, 0x6c, 0xe4, 0xb7, 0x7b, 0x50, 0x3b, 0xe9, 0x47, 0x8b, 0x90, 0x1b, 0x3f, 0x8e, 0xef,
+	0xac, 0x7f, 0x0a, 0xdd, 0xcf, 0x46, 0x13, 0x8b, 0x28, 0x34, 0x9b, 0x3e, 0x0d, 0x2d, 0x83, 0x02,
+	0x14, 0xa6, 0xe3, 0xfe, 0x64, 0xf2, 0x6c, 0x99, 0x83, 0xd1, 0xc7, 0xae, 0x4e, 0x3e, 0x77, 0x75,
+	0xf2, 0xb5, 0xab, 0x93, 0x59, 0x2f, 0x8c, 0xd3, 0x68, 0xbb, 0xe8, 0x78, 0x7c, 0xed, 0x24, 0xae,
+	0x17, 0x65, 0x3e, 0x8a, 0x9f, 0x48, 0x0a, 0xcf, 0xf9, 0xeb, 0xa6, 0x8b, 0x82, 0x3e, 0xe7, 0xcd,
+	0xf7, 0x00, 0x69, 0x34, 0xbf, 0x7e, 0xf6, 0x01, 0x00, 0x00,
 }
 
 func (m *DataRef) Marshal() (dAtA []byte, err error) {
@@ -270,6 +329,18 @@ func (m *Ref) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.EncryptionKeyId) > 0 {
+		i -= len(m.EncryptionKeyId)
+		copy(dAtA[i:], m.EncryptionKeyId)
+		i = encodeVarintChunk(dAtA, i, uint64(len(m.EncryptionKeyId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CompressionAlgo != 0 {
+		i = encodeVarintChunk(dAtA, i, uint64(m.CompressionAlgo))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Edge {
 		i--
 		if m.Edge {
@@ -348,6 +419,13 @@ func (m *Ref) Size() (n int) {
 	if m.Edge {
 		n += 2
 	}
+	if m.CompressionAlgo != 0 {
+		n += 1 + sovChunk(uint64(m.CompressionAlgo))
+	}
+	l = len(m.EncryptionKeyId)
+	if l > 0 {
+		n += 1 + l + sovChunk(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -501,7 +579,10 @@ func (m *DataRef) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthChunk
 			}
 			if (iNdEx + skippy) > l {
@@ -619,13 +700,67 @@ func (m *Ref) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Edge = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionAlgo", wireType)
+			}
+			m.CompressionAlgo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionAlgo |= CompressionAlgo(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChunk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChunk(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthChunk
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthChunk
 			}
 			if (iNdEx + skippy) > l {
@@ -3,14 +3,22 @@ package chunk
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"math/rand"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/chmduquesne/rollinghash/buzhash64"
 	units "github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/track"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testutil/random"
@@ -48,6 +56,218 @@ func TestWriteThenRead(t *testing.T) {
 	}
 }
 
+func TestWriteThenReadReadahead(t *testing.T) {
+	_, chunks := newTestStorage(t)
+	msg := random.SeedRand()
+	for _, test := range tests {
+		t.Run(test.name(), func(t *testing.T) {
+			as := generateAnnotations(test)
+			writeAnnotations(t, chunks, as, msg)
+			readAnnotations(t, chunks.WithReadahead(4), as, msg)
+		})
+	}
+}
+
+// blockingClient is an obj.Client that blocks reads after the first one until
+// their context is canceled.
+type blockingClient struct {
+	obj.Client
+	reads int64
+}
+
+func (bc *blockingClient) Reader(ctx context.Context, name string, offset, size uint64) (io.ReadCloser, error) {
+	if atomic.AddInt64(&bc.reads, 1) > 1 {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return bc.Client.Reader(ctx, name, offset, size)
+}
+
+func TestReadaheadBreak(t *testing.T) {
+	db := dbutil.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	objC := &blockingClient{Client: obj.NewTestClient(t)}
+	chunks := NewStorage(objC, NewTestStore(t, db), tr)
+	var dataRefs []*DataRef
+	w := chunks.NewWriter(context.Background(), uuid.NewWithoutDashes(), func(annotations []*Annotation) error {
+		for _, a := range annotations {
+			if a.NextDataRef != nil {
+				dataRefs = append(dataRefs, a.NextDataRef)
+			}
+		}
+		return nil
+	}, WithRollingHashConfig(16, 0), WithMinMax(64*units.KB, units.MB))
+	seq := RandSeq(10 * units.MB)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, w.Annotate(&Annotation{}))
+		_, err := w.Write(seq[i*units.MB : (i+1)*units.MB])
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	// Iteration that ends early doesn't wait for the chunks being fetched in
+	// the background.
+	done := make(chan error)
+	go func() {
+		r := chunks.WithReadahead(4).NewReader(context.Background(), dataRefs)
+		done <- r.Iterate(func(dr *DataReader) error {
+			if err := dr.Get(ioutil.Discard); err != nil {
+				return err
+			}
+			return errutil.ErrBreak
+		})
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Minute):
+		t.Fatal("iterate did not return after breaking")
+	}
+}
+
+func TestWriteThenReadCompressed(t *testing.T) {
+	msg := random.SeedRand()
+	for _, algo := range []CompressionAlgo{CompressionAlgo_GZIP, CompressionAlgo_ZSTD, CompressionAlgo_SNAPPY} {
+		t.Run(algo.String(), func(t *testing.T) {
+			db := dbutil.NewTestDB(t)
+			tr := track.NewTestTracker(t, db)
+			objC, chunks := NewTestStorage(t, db, tr, WithCompression(algo))
+			test := test{1 * units.KB, 1 * units.MB}
+			as := generateAnnotations(test)
+			writeAnnotations(t, chunks, as, msg)
+			readAnnotations(t, chunks, as, msg)
+			// Chunks record their compression, and are stored compressed.
+			for _, a := range as {
+				for _, dataRef := range a.dataRefs {
+					require.Equal(t, algo, dataRef.Ref.CompressionAlgo, msg)
+					require.True(t, objC.Exists(context.Background(), chunkPath(dataRef.Ref.Id, algo, false)), msg)
+				}
+			}
+		})
+	}
+}
+
+func TestCompression(t *testing.T) {
+	data := bytes.Repeat([]byte("compressible,comma,separated,values\n"), 10000)
+	for algo := range CompressionAlgo_name {
+		algo := CompressionAlgo(algo)
+		t.Run(algo.String(), func(t *testing.T) {
+			compressed, err := compress(algo, data)
+			require.NoError(t, err)
+			if algo != CompressionAlgo_NONE {
+				require.True(t, len(compressed) < len(data))
+			}
+			decompressed, err := decompress(algo, compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, decompressed)
+		})
+	}
+	algo, err := ParseCompressionAlgo("")
+	require.NoError(t, err)
+	require.Equal(t, CompressionAlgo_NONE, algo)
+	algo, err = ParseCompressionAlgo("zstd")
+	require.NoError(t, err)
+	require.Equal(t, CompressionAlgo_ZSTD, algo)
+	_, err = ParseCompressionAlgo("lzma")
+	require.YesError(t, err)
+}
+
+func TestWriteThenReadEncrypted(t *testing.T) {
+	msg := random.SeedRand()
+	keyring := newTestKeyring(t, "key1")
+	db := dbutil.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	objC, chunks := NewTestStorage(t, db, tr, WithCompression(CompressionAlgo_ZSTD), WithEncryption(keyring))
+	test := test{1 * units.KB, 1 * units.MB}
+	as := generateAnnotations(test)
+	writeAnnotations(t, chunks, as, msg)
+	readAnnotations(t, chunks, as, msg)
+	// Chunks record their encryption key, and are stored encrypted.
+	for _, a := range as {
+		for _, dataRef := range a.dataRefs {
+			require.Equal(t, "key1", dataRef.Ref.EncryptionKeyId, msg)
+			require.True(t, objC.Exists(context.Background(), chunkPath(dataRef.Ref.Id, CompressionAlgo_ZSTD, true)), msg)
+		}
+	}
+}
+
+func TestEncryption(t *testing.T) {
+	data := RandSeq(units.MB)
+	chunkID := Hash(data)
+	keyring := newTestKeyring(t, "key1", "key2")
+	encrypted, err := keyring.encrypt(chunkID, data)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(encrypted, data[:1024]))
+	decrypted, err := keyring.decrypt(chunkID, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, data, decrypted)
+	// Encrypted chunks can't be passed off as another chunk.
+	_, err = keyring.decrypt(Hash([]byte("other")), encrypted)
+	require.YesError(t, err)
+	// Chunks can't be decrypted without their key.
+	_, err = newTestKeyring(t, "key2").decrypt(chunkID, encrypted)
+	require.YesError(t, err)
+	// Invalid keyrings are rejected.
+	_, err = NewKeyring(map[string][]byte{"key1": []byte("short")}, "key1")
+	require.YesError(t, err)
+	_, err = NewKeyring(map[string][]byte{}, "key1")
+	require.YesError(t, err)
+}
+
+func TestLoadKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	key := RandSeq(MasterKeySize)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "key1"), []byte(hex.EncodeToString(key)+"\n"), 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0700))
+	keyring, err := LoadKeyring(dir, "key1")
+	require.NoError(t, err)
+	require.Equal(t, "key1", keyring.CurrentID())
+	require.Equal(t, key, keyring.keys["key1"])
+	_, err = LoadKeyring(dir, "key2")
+	require.YesError(t, err)
+	// A missing directory is an empty keyring.
+	keyring, err = LoadKeyring(filepath.Join(dir, "missing"), "")
+	require.NoError(t, err)
+	require.Equal(t, "", keyring.CurrentID())
+}
+
+func TestReEncrypt(t *testing.T) {
+	ctx := context.Background()
+	objC := obj.NewTestClient(t)
+	oldKeyring := newTestKeyring(t, "key1")
+	data := RandSeq(units.MB)
+	chunkID := Hash(data)
+	p := chunkPath(chunkID, CompressionAlgo_NONE, true)
+	encrypted, err := oldKeyring.encrypt(chunkID, data)
+	require.NoError(t, err)
+	require.NoError(t, putObject(ctx, objC, p, encrypted))
+	keyring, err := NewKeyring(map[string][]byte{
+		"key1": oldKeyring.keys["key1"],
+		"key2": RandSeq(MasterKeySize),
+	}, "key2")
+	require.NoError(t, err)
+	var reEncrypted []string
+	cb := func(p string) error {
+		reEncrypted = append(reEncrypted, p)
+		return nil
+	}
+	require.NoError(t, ReEncrypt(ctx, objC, keyring, cb))
+	require.Equal(t, []string{p}, reEncrypted)
+	encrypted, err = getObject(ctx, objC, p)
+	require.NoError(t, err)
+	keyID, _, err := parseEncryptedChunk(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "key2", keyID)
+	decrypted, err := keyring.decrypt(chunkID, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, data, decrypted)
+	// Chunks already encrypted with the current key are left alone.
+	reEncrypted = nil
+	require.NoError(t, ReEncrypt(ctx, objC, keyring, cb))
+	require.Equal(t, 0, len(reEncrypted))
+}
+
 func TestCopy(t *testing.T) {
 	_, chunks := newTestStorage(t)
 	msg := random.SeedRand()
@@ -109,6 +329,41 @@ func BenchmarkWriter(b *testing.B) {
 	}
 }
 
+func BenchmarkReader(b *testing.B) {
+	db := dbutil.NewTestDB(b)
+	tr := track.NewTestTracker(b, db)
+	objC := obj.NewLatencyClient(obj.NewTestClient(b), 10*time.Millisecond)
+	chunks := NewStorage(objC, NewTestStore(b, db), tr)
+	seq := RandSeq(10 * units.MB)
+	var dataRefs []*DataRef
+	cb := func(annotations []*Annotation) error {
+		for _, a := range annotations {
+			if a.NextDataRef != nil {
+				dataRefs = append(dataRefs, a.NextDataRef)
+			}
+		}
+		return nil
+	}
+	w := chunks.NewWriter(context.Background(), uuid.NewWithoutDashes(), cb, WithRollingHashConfig(16, 0), WithMinMax(64*units.KB, units.MB))
+	for i := 0; i < 100; i++ {
+		require.NoError(b, w.Annotate(&Annotation{}))
+		_, err := w.Write(seq[i*100*units.KB : (i+1)*100*units.KB])
+		require.NoError(b, err)
+	}
+	require.NoError(b, w.Close())
+	for _, readahead := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("Readahead %v", readahead), func(b *testing.B) {
+			chunks := chunks.WithReadahead(readahead)
+			b.SetBytes(10 * units.MB)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				r := chunks.NewReader(context.Background(), dataRefs)
+				require.NoError(b, r.Get(ioutil.Discard))
+			}
+		})
+	}
+}
+
 func BenchmarkRollingHash(b *testing.B) {
 	seq := RandSeq(100 * units.MB)
 	b.SetBytes(100 * units.MB)
@@ -193,6 +448,16 @@ func readAnnotations(t *testing.T, chunks *Storage, annotations []*testAnnotatio
 	})
 }
 
+func newTestKeyring(t testing.TB, keyIDs ...string) *Keyring {
+	keys := make(map[string][]byte)
+	for _, keyID := range keyIDs {
+		keys[keyID] = RandSeq(MasterKeySize)
+	}
+	keyring, err := NewKeyring(keys, keyIDs[0])
+	require.NoError(t, err)
+	return keyring
+}
+
 // newTestStorage is like NewTestStorage except it doesn't need an external tracker
 // it is for testing this package, not for reuse.
 func newTestStorage(t testing.TB) (obj.Client, *Storage) {
@@ -21,26 +21,33 @@ type Client struct {
 	tracker track.Tracker
 	renewer *track.Renewer
 	ttl     time.Duration
+	// compression is the algorithm used to compress the chunks created by the client.
+	compression CompressionAlgo
+	// keyring holds the keys used to encrypt and decrypt chunks (nil if encryption is not configured).
+	keyring *Keyring
 }
 
 // NewClient returns a client which will write to objc, mdstore, and tracker.  Name is used
-// for the set of temporary objects
-func NewClient(objc obj.Client, mdstore MetadataStore, tr track.Tracker, name string) *Client {
+// for the set of temporary objects, which (along with the created chunks) expire after ttl
+// unless they are renewed.
+func NewClient(objc obj.Client, mdstore MetadataStore, tr track.Tracker, name string, ttl time.Duration) *Client {
 	var renewer *track.Renewer
 	if name != "" {
-		renewer = track.NewRenewer(tr, name, defaultChunkTTL)
+		renewer = track.NewRenewer(tr, name, ttl)
 	}
 	c := &Client{
 		objc:    objc,
 		tracker: tr,
 		mdstore: mdstore,
 		renewer: renewer,
-		ttl:     defaultChunkTTL,
+		ttl:     ttl,
 	}
 	return c
 }
 
-// Create creates a chunk with data from r and Metadata md
+// Create creates a chunk with data from r and Metadata md.
+// The chunk ID is the hash of the uncompressed, unencrypted data, so identical data is
+// deduplicated regardless of compression and encryption.
 func (c *Client) Create(ctx context.Context, md Metadata, r io.Reader) (_ ID, retErr error) {
 	chunkData, err := ioutil.ReadAll(r)
 	if err != nil {
@@ -62,41 +69,51 @@ func (c *Client) Create(ctx context.Context, md Metadata, r io.Reader) (_ ID, re
 		return nil, err
 	}
 	// at this point no one will be trying to delete the chunk, because there is an object pointing to it.
-	p := chunkPath(chunkID)
+	encrypted := c.keyring.CurrentID() != ""
+	p := chunkPath(chunkID, c.compression, encrypted)
 	if c.objc.Exists(ctx, p) {
 		return chunkID, nil
 	}
 	if err := c.mdstore.Set(ctx, chunkID, md); err != nil && err != ErrMetadataExists {
 		return nil, err
 	}
-	objW, err := c.objc.Writer(ctx, p)
+	chunkData, err = compress(c.compression, chunkData)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if err := objW.Close(); retErr == nil {
-			retErr = err
+	if encrypted {
+		chunkData, err = c.keyring.encrypt(chunkID, chunkData)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	if _, err = objW.Write(chunkData); err != nil {
+	}
+	if err := putObject(ctx, c.objc, p, chunkData); err != nil {
 		return nil, err
 	}
 	return chunkID, nil
 }
 
-// Get writes data for a chunk with ID chunkID to w.
-func (c *Client) Get(ctx context.Context, chunkID ID, w io.Writer) (retErr error) {
-	p := chunkPath(chunkID)
-	objR, err := c.objc.Reader(ctx, p, 0, 0)
+// Get writes the (decrypted and uncompressed) data for the chunk referenced by ref to w.
+func (c *Client) Get(ctx context.Context, ref *Ref, w io.Writer) error {
+	encrypted := ref.EncryptionKeyId != ""
+	if encrypted && c.keyring == nil {
+		return errors.Errorf("chunk %v is encrypted, but no encryption keys are configured", ID(ref.Id).HexString())
+	}
+	chunkData, err := getObject(ctx, c.objc, chunkPath(ref.Id, ref.CompressionAlgo, encrypted))
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err := objR.Close(); retErr == nil {
-			retErr = err
+	if encrypted {
+		chunkData, err = c.keyring.decrypt(ref.Id, chunkData)
+		if err != nil {
+			return err
 		}
-	}()
-	_, err = io.Copy(w, objR)
+	}
+	chunkData, err = decompress(ref.CompressionAlgo, chunkData)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(chunkData)
 	return err
 }
 
@@ -108,11 +125,71 @@ func (c *Client) Close() error {
 	return nil
 }
 
-func chunkPath(chunkID ID) string {
+func getObject(ctx context.Context, objc obj.Client, p string) (_ []byte, retErr error) {
+	objR, err := objc.Reader(ctx, p, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := objR.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	return ioutil.ReadAll(objR)
+}
+
+func putObject(ctx context.Context, objc obj.Client, p string, data []byte) (retErr error) {
+	objW, err := objc.Writer(ctx, p)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := objW.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = objW.Write(data)
+	return err
+}
+
+const encryptedSuffix = "enc"
+
+// chunkPath returns the object storage path of a chunk. Uncompressed, unencrypted
+// chunks are stored at the path of their ID. Compressed chunks get a suffix naming
+// their compression algorithm, and encrypted chunks get an encryption suffix.
+func chunkPath(chunkID ID, algo CompressionAlgo, encrypted bool) string {
 	if len(chunkID) == 0 {
 		panic("chunkID cannot be empty")
 	}
-	return path.Join(prefix, chunkID.HexString())
+	p := path.Join(prefix, chunkID.HexString())
+	if algo != CompressionAlgo_NONE {
+		p += "." + strings.ToLower(algo.String())
+	}
+	if encrypted {
+		p += "." + encryptedSuffix
+	}
+	return p
+}
+
+// parseChunkPath is the inverse of chunkPath.
+func parseChunkPath(p string) (ID, CompressionAlgo, bool, error) {
+	parts := strings.Split(strings.TrimPrefix(p, prefix+"/"), ".")
+	chunkID, err := IDFromHex(parts[0])
+	if err != nil {
+		return nil, 0, false, errors.Wrapf(err, "invalid chunk path %v", p)
+	}
+	algo, encrypted := CompressionAlgo_NONE, false
+	for _, suffix := range parts[1:] {
+		if suffix == encryptedSuffix {
+			encrypted = true
+			continue
+		}
+		algo, err = ParseCompressionAlgo(suffix)
+		if err != nil {
+			return nil, 0, false, errors.Wrapf(err, "invalid chunk path %v", p)
+		}
+	}
+	return chunkID, algo, encrypted, nil
 }
 
 // ObjectID returns an object ID for use with a tracker
@@ -127,16 +204,44 @@ type deleter struct {
 	objc    obj.Client
 }
 
+var _ track.Sizer = &deleter{}
+
 func (d *deleter) Delete(ctx context.Context, id string) error {
-	if !strings.HasPrefix(id, prefix+"/") {
-		return errors.Errorf("cannot delete (%s)", id)
-	}
-	chunkID, err := IDFromHex(id[len(TrackerPrefix):])
+	chunkID, err := parseObjectID(id)
 	if err != nil {
 		return err
 	}
-	if err := d.objc.Delete(ctx, chunkPath(chunkID)); err != nil {
-		return err
+	// The chunk may have been stored with any (or several) compression algorithms,
+	// with or without encryption.
+	for algo := range CompressionAlgo_name {
+		for _, encrypted := range []bool{false, true} {
+			if err := d.objc.Delete(ctx, chunkPath(chunkID, CompressionAlgo(algo), encrypted)); err != nil && !d.objc.IsNotExist(err) {
+				return err
+			}
+		}
 	}
 	return d.mdstore.Delete(ctx, chunkID)
 }
+
+// Size returns the size of the chunk tracked by id.
+func (d *deleter) Size(ctx context.Context, id string) (int64, error) {
+	chunkID, err := parseObjectID(id)
+	if err != nil {
+		return 0, err
+	}
+	md, err := d.mdstore.Get(ctx, chunkID)
+	if err != nil {
+		if err == ErrChunkNotExists {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return int64(md.Size), nil
+}
+
+func parseObjectID(id string) (ID, error) {
+	if !strings.HasPrefix(id, TrackerPrefix) {
+		return nil, errors.Errorf("invalid chunk object id (%s)", id)
+	}
+	return IDFromHex(id[len(TrackerPrefix):])
+}
@@ -0,0 +1,78 @@
+package chunk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+// ParseCompressionAlgo parses the name of a compression algorithm (e.g.
+// "zstd"). The empty string is parsed as no compression.
+func ParseCompressionAlgo(name string) (CompressionAlgo, error) {
+	if name == "" {
+		return CompressionAlgo_NONE, nil
+	}
+	algo, ok := CompressionAlgo_value[strings.ToUpper(name)]
+	if !ok {
+		return CompressionAlgo_NONE, errors.Errorf("unrecognized compression algorithm: %s", name)
+	}
+	return CompressionAlgo(algo), nil
+}
+
+// zstd encoders and decoders are safe for concurrent use when only their
+// stateless EncodeAll / DecodeAll methods are used, so they are shared.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+func compress(algo CompressionAlgo, data []byte) ([]byte, error) {
+	switch algo {
+	case CompressionAlgo_NONE:
+		return data, nil
+	case CompressionAlgo_GZIP:
+		buf := &bytes.Buffer{}
+		gzw, err := gzip.NewWriterLevel(buf, gzip.BestSpeed)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := gzw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := gzw.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionAlgo_ZSTD:
+		return zstdEncoder.EncodeAll(data, nil), nil
+	case CompressionAlgo_SNAPPY:
+		return snappy.Encode(nil, data), nil
+	default:
+		return nil, errors.Errorf("unrecognized compression algorithm: %v", algo)
+	}
+}
+
+func decompress(algo CompressionAlgo, data []byte) ([]byte, error) {
+	switch algo {
+	case CompressionAlgo_NONE:
+		return data, nil
+	case CompressionAlgo_GZIP:
+		gzr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer gzr.Close()
+		return ioutil.ReadAll(gzr)
+	case CompressionAlgo_ZSTD:
+		return zstdDecoder.DecodeAll(data, nil)
+	case CompressionAlgo_SNAPPY:
+		return snappy.Decode(nil, data)
+	default:
+		return nil, errors.Errorf("unrecognized compression algorithm: %v", algo)
+	}
+}
@@ -0,0 +1,209 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// MasterKeySize is the size (in bytes) of the master keys used to encrypt chunks.
+	MasterKeySize = 32
+	// encryptionVersion is the version of the encrypted chunk format, which is:
+	// version (1 byte) | key ID length (1 byte) | key ID | nonce | ciphertext
+	encryptionVersion = 1
+	maxKeyIDSize      = 255
+)
+
+// Keyring holds the master keys used to encrypt and decrypt chunks.
+// Each chunk is encrypted with AES-256-GCM, using a key derived from a master key
+// and the chunk ID. Since the chunk ID is the hash of the chunk's content, chunks
+// with the same content are still deduplicated.
+type Keyring struct {
+	keys      map[string][]byte
+	currentID string
+}
+
+// NewKeyring creates a keyring from a set of master keys, indexed by key ID.
+// New chunks are encrypted with the key 'currentID'. If 'currentID' is empty,
+// the keyring can only decrypt chunks.
+func NewKeyring(keys map[string][]byte, currentID string) (*Keyring, error) {
+	for id, key := range keys {
+		if id == "" || len(id) > maxKeyIDSize {
+			return nil, errors.Errorf("invalid encryption key ID %q", id)
+		}
+		if len(key) != MasterKeySize {
+			return nil, errors.Errorf("encryption key %q must be %d bytes, but is %d bytes", id, MasterKeySize, len(key))
+		}
+	}
+	if _, ok := keys[currentID]; currentID != "" && !ok {
+		return nil, errors.Errorf("encryption key %q not found", currentID)
+	}
+	return &Keyring{
+		keys:      keys,
+		currentID: currentID,
+	}, nil
+}
+
+// LoadKeyring loads the master keys stored in 'dir' (e.g. a mounted kubernetes
+// secret). Each file in 'dir' contains one hex-encoded key, and its name is the key
+// ID. A missing directory is treated as an empty keyring.
+func LoadKeyring(dir, currentID string) (*Keyring, error) {
+	keys := make(map[string][]byte)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.EnsureStack(err)
+	}
+	for _, file := range files {
+		// Skip the hidden files and directories kubernetes uses to update secrets.
+		if strings.HasPrefix(file.Name(), ".") || file.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode encryption key %q", file.Name())
+		}
+		keys[file.Name()] = key
+	}
+	return NewKeyring(keys, currentID)
+}
+
+// CurrentID returns the ID of the key used to encrypt new chunks.
+func (k *Keyring) CurrentID() string {
+	if k == nil {
+		return ""
+	}
+	return k.currentID
+}
+
+func (k *Keyring) chunkCipher(keyID string, chunkID ID) (cipher.AEAD, error) {
+	masterKey, ok := k.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("encryption key %q not found", keyID)
+	}
+	chunkKey := make([]byte, MasterKeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, masterKey, nil, chunkID), chunkKey); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	block, err := aes.NewCipher(chunkKey)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// encrypt encrypts a chunk with the current key. The chunk ID is authenticated along
+// with the data, so an encrypted chunk cannot be passed off as another chunk.
+func (k *Keyring) encrypt(chunkID ID, data []byte) ([]byte, error) {
+	if k.currentID == "" {
+		return nil, errors.Errorf("no current encryption key")
+	}
+	aead, err := k.chunkCipher(k.currentID, chunkID)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteByte(encryptionVersion)
+	buf.WriteByte(byte(len(k.currentID)))
+	buf.WriteString(k.currentID)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	buf.Write(nonce)
+	return aead.Seal(buf.Bytes(), nonce, data, chunkID), nil
+}
+
+// decrypt decrypts a chunk, with whichever key it is currently encrypted with.
+func (k *Keyring) decrypt(chunkID ID, data []byte) ([]byte, error) {
+	keyID, nonceAndCiphertext, err := parseEncryptedChunk(data)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := k.chunkCipher(keyID, chunkID)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonceAndCiphertext) < aead.NonceSize() {
+		return nil, errors.Errorf("encrypted chunk %v is truncated", chunkID.HexString())
+	}
+	nonce, ciphertext := nonceAndCiphertext[:aead.NonceSize()], nonceAndCiphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, chunkID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decrypt chunk %v", chunkID.HexString())
+	}
+	return plaintext, nil
+}
+
+func parseEncryptedChunk(data []byte) (string, []byte, error) {
+	if len(data) < 2 || data[0] != encryptionVersion {
+		return "", nil, errors.Errorf("unrecognized encrypted chunk format")
+	}
+	keyIDSize := int(data[1])
+	if len(data) < 2+keyIDSize {
+		return "", nil, errors.Errorf("encrypted chunk is truncated")
+	}
+	return string(data[2 : 2+keyIDSize]), data[2+keyIDSize:], nil
+}
+
+// ReEncrypt re-encrypts every encrypted chunk in object storage that is not
+// encrypted with the keyring's current key, so that older keys can be retired.
+// It should only be run while pachd is stopped. 'cb', if set, is called with the
+// path of each re-encrypted chunk.
+func ReEncrypt(ctx context.Context, objClient obj.Client, keyring *Keyring, cb func(string) error) error {
+	if keyring.CurrentID() == "" {
+		return errors.Errorf("no current encryption key to re-encrypt chunks with")
+	}
+	return objClient.Walk(ctx, prefix, func(p string) error {
+		chunkID, _, encrypted, err := parseChunkPath(p)
+		if err != nil {
+			return err
+		}
+		if !encrypted {
+			return nil
+		}
+		data, err := getObject(ctx, objClient, p)
+		if err != nil {
+			return err
+		}
+		keyID, _, err := parseEncryptedChunk(data)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse chunk %v", p)
+		}
+		if keyID == keyring.currentID {
+			return nil
+		}
+		plaintext, err := keyring.decrypt(chunkID, data)
+		if err != nil {
+			return err
+		}
+		data, err = keyring.encrypt(chunkID, plaintext)
+		if err != nil {
+			return err
+		}
+		if err := putObject(ctx, objClient, p, data); err != nil {
+			return err
+		}
+		if cb != nil {
+			return cb(p)
+		}
+		return nil
+	})
+}
@@ -33,6 +33,23 @@ func WithObjectCache(fastLayer obj.Client, size int) StorageOption {
 	}
 }
 
+// WithCompression sets the algorithm used to compress new chunks. Chunks record the
+// algorithm they were compressed with, so chunks compressed with any algorithm can
+// be read regardless of this setting.
+func WithCompression(algo CompressionAlgo) StorageOption {
+	return func(s *Storage) {
+		s.compression = algo
+	}
+}
+
+// WithEncryption sets the keyring used to encrypt new chunks (with its current key),
+// and to decrypt encrypted chunks.
+func WithEncryption(keyring *Keyring) StorageOption {
+	return func(s *Storage) {
+		s.keyring = keyring
+	}
+}
+
 // WriterOption configures a chunk writer.
 type WriterOption func(w *Writer)
 
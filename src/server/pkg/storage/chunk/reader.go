@@ -12,31 +12,72 @@ import (
 
 // Reader reads data from chunk storage.
 type Reader struct {
-	ctx      context.Context
-	client   *Client
-	dataRefs []*DataRef
+	ctx       context.Context
+	client    *Client
+	dataRefs  []*DataRef
+	readahead int
 }
 
-func newReader(ctx context.Context, client *Client, dataRefs []*DataRef) *Reader {
+func newReader(ctx context.Context, client *Client, dataRefs []*DataRef, readahead int) *Reader {
 	return &Reader{
-		ctx:      ctx,
-		client:   client,
-		dataRefs: dataRefs,
+		ctx:       ctx,
+		client:    client,
+		dataRefs:  dataRefs,
+		readahead: readahead,
 	}
 }
 
 // Iterate iterates over the data readers for the data references.
+// If readahead is set, the chunks for the next data references are fetched in
+// the background (up to readahead chunks at a time, including the chunk for
+// the current data reference) while the current data reader is processed.
 func (r *Reader) Iterate(cb func(*DataReader) error) error {
+	drs := make([]*DataReader, len(r.dataRefs))
+	// fetches are the indexes of the data readers that fetch a chunk (rather
+	// than sharing the chunk of the prior data reader).
+	var fetches []int
 	var seed *DataReader
-	for _, dataRef := range r.dataRefs {
-		dr := newDataReader(r.ctx, r.client, dataRef, seed)
+	for i, dataRef := range r.dataRefs {
+		drs[i] = newDataReader(r.ctx, r.client, dataRef, seed)
+		if seed == nil || !bytes.Equal(dataRef.Ref.Id, seed.dataRef.Ref.Id) {
+			fetches = append(fetches, i)
+		}
+		seed = drs[i]
+	}
+	// The chunks are fetched in the background with a child context, which
+	// is canceled when iteration ends early, so Iterate doesn't wait for
+	// fetches of chunks that won't be read.
+	ctx, cancel := context.WithCancel(r.ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	cur, next := -1, 0
+	for i, dr := range drs {
+		if cur+1 < len(fetches) && fetches[cur+1] == i {
+			cur++
+		}
+		if next <= cur {
+			next = cur + 1
+		}
+		for ; next < len(fetches) && next < cur+r.readahead; next++ {
+			wg.Add(1)
+			go func(dr *DataReader) {
+				defer wg.Done()
+				// Errors are returned when the data reader is processed.
+				dr.getChunk(ctx)
+			}(drs[fetches[next]])
+		}
 		if err := cb(dr); err != nil {
 			if errors.Is(err, errutil.ErrBreak) {
 				return nil
 			}
 			return err
 		}
-		seed = dr
+		// Release the data reader, so the memory used by readers
+		// that have been processed is bounded.
+		drs[i] = nil
 	}
 	return nil
 }
@@ -77,7 +118,7 @@ func (dr *DataReader) DataRef() *DataRef {
 
 // Get writes the data referenced by the data reference.
 func (dr *DataReader) Get(w io.Writer) error {
-	if err := dr.getChunk(); err != nil {
+	if err := dr.getChunk(dr.ctx); err != nil {
 		return err
 	}
 	data := dr.chunk[dr.dataRef.OffsetBytes : dr.dataRef.OffsetBytes+dr.dataRef.SizeBytes]
@@ -85,7 +126,7 @@ func (dr *DataReader) Get(w io.Writer) error {
 	return err
 }
 
-func (dr *DataReader) getChunk() error {
+func (dr *DataReader) getChunk(ctx context.Context) error {
 	dr.getChunkMu.Lock()
 	defer dr.getChunkMu.Unlock()
 	if dr.chunk != nil {
@@ -93,16 +134,19 @@ func (dr *DataReader) getChunk() error {
 	}
 	// Use seed chunk if possible.
 	if dr.seed != nil && bytes.Equal(dr.dataRef.Ref.Id, dr.seed.dataRef.Ref.Id) {
-		if err := dr.seed.getChunk(); err != nil {
+		if err := dr.seed.getChunk(ctx); err != nil {
 			return err
 		}
 		dr.chunk = dr.seed.chunk
+		dr.seed = nil
 		return nil
 	}
+	// The seed is no longer needed, and holding on to it would keep the
+	// chunks of the prior data references in the chain in memory.
+	dr.seed = nil
 	// Get chunk from object storage.
 	buf := &bytes.Buffer{}
-	chunkID := dr.dataRef.Ref.Id
-	if err := dr.client.Get(dr.ctx, chunkID, buf); err != nil {
+	if err := dr.client.Get(ctx, dr.dataRef.Ref, buf); err != nil {
 		return err
 	}
 	dr.chunk = buf.Bytes()
@@ -22,6 +22,9 @@ type Storage struct {
 	mdstore   MetadataStore
 
 	defaultChunkTTL time.Duration
+	compression     CompressionAlgo
+	keyring         *Keyring
+	readahead       int
 }
 
 // NewStorage creates a new Storage.
@@ -41,18 +44,40 @@ func NewStorage(objClient obj.Client, mdstore MetadataStore, tracker track.Track
 // NewReader creates a new Reader.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef) *Reader {
 	// using the empty string for the tmp id to disable the renewer
-	client := NewClient(s.objClient, s.mdstore, s.tracker, "")
-	return newReader(ctx, client, dataRefs)
+	client := s.newClient("")
+	return newReader(ctx, client, dataRefs, s.readahead)
+}
+
+// WithReadahead returns a copy of the storage whose readers fetch up to n
+// chunks from object storage in parallel. A value of n less than 2 disables
+// readahead.
+func (s *Storage) WithReadahead(n int) *Storage {
+	s2 := *s
+	s2.readahead = n
+	return &s2
+}
+
+// Readahead returns the number of chunks that readers created by the storage
+// fetch in parallel.
+func (s *Storage) Readahead() int {
+	return s.readahead
 }
 
 // NewWriter creates a new Writer for a stream of bytes to be chunked.
 // Chunks are created based on the content, then hashed and deduplicated/uploaded to
 // object storage.
 func (s *Storage) NewWriter(ctx context.Context, tmpID string, cb WriterCallback, opts ...WriterOption) *Writer {
-	client := NewClient(s.objClient, s.mdstore, s.tracker, tmpID)
+	client := s.newClient(tmpID)
 	return newWriter(ctx, client, cb, opts...)
 }
 
+func (s *Storage) newClient(name string) *Client {
+	client := NewClient(s.objClient, s.mdstore, s.tracker, name, s.defaultChunkTTL)
+	client.compression = s.compression
+	client.keyring = s.keyring
+	return client
+}
+
 // List lists all of the chunks in object storage.
 func (s *Storage) List(ctx context.Context, cb func(string) error) error {
 	return s.objClient.Walk(ctx, prefix, cb)
@@ -40,7 +40,8 @@ const (
 	defaultAverageBits  = 23
 	defaultSeed         = 1
 	defaultMinChunkSize = 1 * units.MB
-	defaultMaxChunkSize = 20 * units.MB
+	// DefaultMaxChunkSize is the default maximum size of a chunk.
+	DefaultMaxChunkSize = 20 * units.MB
 )
 
 type chunkSize struct {
@@ -78,7 +79,7 @@ func newWriter(ctx context.Context, client *Client, cb WriterCallback, opts ...W
 		cancel: cancel,
 		chunkSize: &chunkSize{
 			min: defaultMinChunkSize,
-			max: defaultMaxChunkSize,
+			max: DefaultMaxChunkSize,
 		},
 		buf:   &bytes.Buffer{},
 		stats: &stats{},
@@ -261,12 +262,13 @@ func (w *Writer) maybeUpload(ctx context.Context, chunkBytes []byte, pointsTo []
 			return nil, err
 		}
 	} else {
-		// TODO: this has to also deal with compression and encryption
 		chunkID = Hash(chunkBytes)
 	}
 	return &Ref{
-		Id:        chunkID,
-		SizeBytes: int64(len(chunkBytes)),
+		Id:              chunkID,
+		SizeBytes:       int64(len(chunkBytes)),
+		CompressionAlgo: w.client.compression,
+		EncryptionKeyId: w.client.keyring.CurrentID(),
 	}, nil
 }
 
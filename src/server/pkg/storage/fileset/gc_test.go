@@ -0,0 +1,110 @@
+package fileset
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/track"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+type storageUsage struct {
+	filesets, chunks, trackerObjects int
+}
+
+func getStorageUsage(t *testing.T, db *sqlx.DB, s *Storage) storageUsage {
+	ctx := context.Background()
+	var usage storageUsage
+	require.NoError(t, s.store.Walk(ctx, "", func(_ string) error {
+		usage.filesets++
+		return nil
+	}))
+	require.NoError(t, s.chunks.List(ctx, func(_ string) error {
+		usage.chunks++
+		return nil
+	}))
+	require.NoError(t, db.Get(&usage.trackerObjects, `SELECT COUNT(*) FROM storage.tracker_objects`))
+	return usage
+}
+
+func writeTestFileSet(t *testing.T, s *Storage, fileSet string, ttl time.Duration) {
+	w := s.NewWriter(context.Background(), fileSet, WithTTL(ttl))
+	for i := 0; i < 10; i++ {
+		require.NoError(t, w.Append(fmt.Sprintf("/%02d", i), func(fw *FileWriter) error {
+			fw.Append("")
+			_, err := fw.Write(chunk.RandSeq(1024))
+			return err
+		}))
+	}
+	require.NoError(t, w.Close())
+}
+
+func TestGC(t *testing.T) {
+	ctx := context.Background()
+	db := dbutil.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	ttl := time.Second
+	_, chunks := chunk.NewTestStorage(t, db, tr, chunk.WithGCTimeout(ttl))
+	s := NewStorage(NewTestStore(t, db), tr, chunks)
+	gc := s.newGarbageCollector(time.Minute)
+	// A fileset without a ttl is not garbage collected.
+	writeTestFileSet(t, s, "permanent", 0)
+	time.Sleep(2 * ttl)
+	require.NoError(t, gc.RunUntilEmpty(ctx, nil))
+	baseline := getStorageUsage(t, db, s)
+	require.Equal(t, 1, baseline.filesets)
+	require.True(t, baseline.chunks > 0)
+	// Temporary filesets are garbage collected after their ttl lapses.
+	for i := 0; i < 3; i++ {
+		writeTestFileSet(t, s, path.Join("tmp", uuid.NewWithoutDashes()), ttl)
+	}
+	usage := getStorageUsage(t, db, s)
+	require.Equal(t, baseline.filesets+3, usage.filesets)
+	require.True(t, usage.chunks > baseline.chunks)
+	require.True(t, usage.trackerObjects > baseline.trackerObjects)
+	time.Sleep(2 * ttl)
+	// A dry run reports exactly the objects that are then deleted.
+	dryRun := make(map[string]int64)
+	require.NoError(t, s.CollectGarbage(ctx, true, func(obj *track.CollectedObject) error {
+		dryRun[obj.ID] = obj.SizeBytes
+		return nil
+	}))
+	require.Equal(t, usage, getStorageUsage(t, db, s))
+	deleted := make(map[string]int64)
+	require.NoError(t, s.CollectGarbage(ctx, false, func(obj *track.CollectedObject) error {
+		deleted[obj.ID] = obj.SizeBytes
+		return nil
+	}))
+	require.Equal(t, dryRun, deleted)
+	require.Equal(t, usage.trackerObjects-baseline.trackerObjects, len(deleted))
+	require.Equal(t, baseline, getStorageUsage(t, db, s))
+	// The fileset without a ttl is still readable.
+	fs, err := s.Open(ctx, []string{"permanent"})
+	require.NoError(t, err)
+	var n int
+	require.NoError(t, fs.Iterate(ctx, func(f File) error {
+		n++
+		return nil
+	}))
+	require.Equal(t, 10, n)
+	// The chunks of the fileset without a ttl are kept alive by it.
+	md, err := s.store.Get(ctx, "permanent")
+	require.NoError(t, err)
+	chunkID := chunk.ObjectID(index.PointsTo(md.Additive)[0])
+	explanation, err := s.ExplainObject(ctx, chunkID)
+	require.NoError(t, err)
+	require.Equal(t, chunkID, explanation.Object.ID)
+	require.True(t, explanation.Object.Expired)
+	require.Equal(t, 1, len(explanation.References))
+	require.Equal(t, filesetObjectID("permanent"), explanation.References[0].Root.ID)
+	require.False(t, explanation.References[0].Root.Expired)
+	require.Equal(t, chunkID, explanation.References[0].Path[len(explanation.References[0].Path)-1])
+}
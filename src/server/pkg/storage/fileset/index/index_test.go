@@ -2,11 +2,19 @@ package index
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"math"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/track"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
@@ -65,6 +73,11 @@ func Check(t *testing.T, permString string) {
 		actual := actualFiles(t, topIdx, chunks)
 		require.Equal(t, expected, actual)
 	})
+	t.Run("Readahead", func(t *testing.T) {
+		expected := fileNames
+		actual := actualFiles(t, topIdx, chunks.WithReadahead(4))
+		require.Equal(t, expected, actual)
+	})
 	t.Run("FirstFile", func(t *testing.T) {
 		prefix := fileNames[0]
 		expected := []string{prefix}
@@ -122,3 +135,80 @@ func TestSingleLevel(t *testing.T) {
 func TestMultiLevel(t *testing.T) {
 	Check(t, "abcdefg")
 }
+
+func BenchmarkReader(b *testing.B) {
+	db := dbutil.NewTestDB(b)
+	tr := track.NewTestTracker(b, db)
+	objC := obj.NewLatencyClient(obj.NewTestClient(b), 10*time.Millisecond)
+	chunks := chunk.NewStorage(objC, chunk.NewTestStore(b, db), tr)
+	fileNames := Generate("abcdefg")
+	averageBits = 12
+	topIdx := write(b, chunks, fileNames)
+	for _, readahead := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("Readahead %v", readahead), func(b *testing.B) {
+			chunks := chunks.WithReadahead(readahead)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				require.Equal(b, len(fileNames), len(actualFiles(b, topIdx, chunks)))
+			}
+		})
+	}
+}
+
+// blockingClient is an obj.Client that blocks reads after the first 'allowed'
+// ones until their context is canceled, and tracks the reads in progress.
+type blockingClient struct {
+	obj.Client
+	allowed  int64
+	reads    int64
+	inFlight int64
+}
+
+func (bc *blockingClient) Reader(ctx context.Context, name string, offset, size uint64) (io.ReadCloser, error) {
+	if atomic.AddInt64(&bc.reads, 1) <= atomic.LoadInt64(&bc.allowed) {
+		return bc.Client.Reader(ctx, name, offset, size)
+	}
+	atomic.AddInt64(&bc.inFlight, 1)
+	defer atomic.AddInt64(&bc.inFlight, -1)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestReadaheadBreak(t *testing.T) {
+	db := dbutil.NewTestDB(t)
+	tr := track.NewTestTracker(t, db)
+	objC := &blockingClient{Client: obj.NewTestClient(t), allowed: math.MaxInt64}
+	chunks := chunk.NewStorage(objC, chunk.NewTestStore(t, db), tr)
+	fileNames := Generate("abcdefg")
+	averageBits = 12
+	topIdx := write(t, chunks, fileNames)
+	readFirst := func(chunks *chunk.Storage) error {
+		err := NewReader(chunks, topIdx).Iterate(context.Background(), func(_ *Index) error {
+			return errutil.ErrBreak
+		})
+		if errors.Is(err, errutil.ErrBreak) {
+			return nil
+		}
+		return err
+	}
+	// Count the reads needed for the first index without readahead, then
+	// block any reads after those.
+	atomic.StoreInt64(&objC.reads, 0)
+	require.NoError(t, readFirst(chunks.WithReadahead(1)))
+	atomic.StoreInt64(&objC.allowed, atomic.LoadInt64(&objC.reads))
+	atomic.StoreInt64(&objC.reads, 0)
+	// Iteration that ends early cancels the chunks being fetched in the
+	// background, and waits for the fetches to stop.
+	done := make(chan error)
+	go func() {
+		done <- readFirst(chunks.WithReadahead(4))
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Minute):
+		t.Fatal("iterate did not return after breaking")
+	}
+	require.True(t, atomic.LoadInt64(&objC.reads) > atomic.LoadInt64(&objC.allowed))
+	require.Equal(t, int64(0), atomic.LoadInt64(&objC.inFlight))
+}
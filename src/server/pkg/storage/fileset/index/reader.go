@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"sync"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
@@ -40,6 +41,16 @@ func (r *Reader) Iterate(ctx context.Context, cb func(*Index) error) error {
 	if r.topIdx == nil {
 		return nil
 	}
+	// The level readers fetch chunks in the background with a child context,
+	// which is canceled when iteration ends (which may be early, e.g. at the
+	// end of a range or prefix), so Iterate doesn't leave fetches of chunks
+	// that won't be read running.
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
 	// Setup top level reader.
 	pbr := r.topLevel()
 	levels := []pbutil.Reader{pbr}
@@ -72,7 +83,7 @@ func (r *Reader) Iterate(ctx context.Context, cb func(*Index) error) error {
 		if !r.atStart(idx.Range.LastPath) {
 			continue
 		}
-		levels = append(levels, pbutil.NewReader(newLevelReader(ctx, pbr, r.chunks, idx)))
+		levels = append(levels, pbutil.NewReader(newLevelReader(ctx, &wg, pbr, r.chunks, idx)))
 	}
 }
 
@@ -116,16 +127,28 @@ func (r *Reader) atEnd(name string) bool {
 }
 
 type levelReader struct {
-	ctx    context.Context
-	parent pbutil.Reader
-	chunks *chunk.Storage
-	idx    *Index
-	buf    *bytes.Buffer
+	ctx     context.Context
+	wg      *sync.WaitGroup
+	parent  pbutil.Reader
+	chunks  *chunk.Storage
+	idx     *Index
+	buf     *bytes.Buffer
+	pending []*chunkFetch
+	err     error
+}
+
+// chunkFetch is a fetch of the chunk referenced by an index entry in the
+// parent level, which may still be in progress.
+type chunkFetch struct {
+	done chan struct{}
+	buf  *bytes.Buffer
+	err  error
 }
 
-func newLevelReader(ctx context.Context, parent pbutil.Reader, chunks *chunk.Storage, idx *Index) *levelReader {
+func newLevelReader(ctx context.Context, wg *sync.WaitGroup, parent pbutil.Reader, chunks *chunk.Storage, idx *Index) *levelReader {
 	return &levelReader{
 		ctx:    ctx,
+		wg:     wg,
 		parent: parent,
 		chunks: chunks,
 		idx:    idx,
@@ -164,12 +187,46 @@ func (lr *levelReader) setup() error {
 	return nil
 }
 
+// next moves on to the chunk referenced by the next index entry in the parent
+// level. The chunks referenced by the index entries after it are fetched in
+// parallel, based on the readahead of the chunk storage.
 func (lr *levelReader) next() error {
-	lr.idx.Reset()
-	if err := lr.parent.Read(lr.idx); err != nil {
-		return err
+	readahead := lr.chunks.Readahead()
+	if readahead < 1 {
+		readahead = 1
+	}
+	for lr.err == nil && len(lr.pending) < readahead {
+		idx := &Index{}
+		if err := lr.parent.Read(idx); err != nil {
+			lr.err = err
+			break
+		}
+		lr.pending = append(lr.pending, lr.fetch(idx.Range.ChunkRef))
+	}
+	if len(lr.pending) == 0 {
+		return lr.err
+	}
+	f := lr.pending[0]
+	lr.pending = lr.pending[1:]
+	<-f.done
+	if f.err != nil {
+		return f.err
+	}
+	lr.buf = f.buf
+	return nil
+}
+
+func (lr *levelReader) fetch(chunkRef *chunk.DataRef) *chunkFetch {
+	f := &chunkFetch{
+		done: make(chan struct{}),
+		buf:  &bytes.Buffer{},
 	}
-	r := lr.chunks.NewReader(lr.ctx, []*chunk.DataRef{lr.idx.Range.ChunkRef})
-	lr.buf.Reset()
-	return r.Get(lr.buf)
+	lr.wg.Add(1)
+	go func() {
+		defer lr.wg.Done()
+		defer close(f.done)
+		r := lr.chunks.NewReader(lr.ctx, []*chunk.DataRef{chunkRef})
+		f.err = r.Get(f.buf)
+	}()
+	return f
 }
@@ -99,8 +99,15 @@ func (s *Storage) newWriter(ctx context.Context, fileSet string, opts ...WriterO
 	return newWriter(ctx, s.store, s.tracker, s.chunks, fileSet, opts...)
 }
 
-// TODO: Expose some notion of read ahead (read a certain number of chunks in parallel).
-// this will be necessary to speed up reading large files.
+// WithReadahead returns a copy of the storage whose readers fetch up to n
+// chunks from object storage in parallel, while preserving the order of the
+// files and their content.
+func (s *Storage) WithReadahead(n int) *Storage {
+	s2 := *s
+	s2.chunks = s.chunks.WithReadahead(n)
+	return &s2
+}
+
 func (s *Storage) newReader(fileSet string, opts ...index.Option) *Reader {
 	return newReader(s.store, s.chunks, fileSet, opts...)
 }
@@ -304,9 +311,30 @@ func (s *Storage) WithRenewer(ctx context.Context, ttl time.Duration, cb func(co
 	return renew.WithStringSet(ctx, ttl, rf, cb)
 }
 
+const gcPeriod = 10 * time.Second
+
 // GC creates a track.GarbageCollector with a Deleter that can handle deleting filesets and chunks
 func (s *Storage) GC(ctx context.Context) error {
-	const period = 10 * time.Second
+	return s.newGarbageCollector(gcPeriod).Run(ctx)
+}
+
+// CollectGarbage runs garbage collection until there is nothing left to delete,
+// calling cb with each deleted object. If dryRun is set, nothing is deleted, and
+// cb is called with each object that would be deleted instead.
+func (s *Storage) CollectGarbage(ctx context.Context, dryRun bool, cb func(*track.CollectedObject) error) error {
+	gc := s.newGarbageCollector(gcPeriod)
+	if dryRun {
+		return gc.DryRun(ctx, cb)
+	}
+	return gc.RunUntilEmpty(ctx, cb)
+}
+
+// ExplainObject explains why the tracked object with id has not been garbage collected.
+func (s *Storage) ExplainObject(ctx context.Context, id string) (*track.Explanation, error) {
+	return track.Explain(ctx, s.tracker, id)
+}
+
+func (s *Storage) newGarbageCollector(period time.Duration) *track.GarbageCollector {
 	tmpDeleter := track.NewTmpDeleter()
 	chunkDeleter := s.chunks.NewDeleter()
 	filesetDeleter := &deleter{
@@ -324,8 +352,7 @@ func (s *Storage) GC(ctx context.Context) error {
 			return nil
 		}
 	})
-	gc := track.NewGarbageCollector(s.tracker, period, mux)
-	return gc.Run(ctx)
+	return track.NewGarbageCollector(s.tracker, period, mux)
 }
 
 func (s *Storage) levelSize(i int) int64 {
@@ -351,7 +378,7 @@ func parseLevel(x string) (int, error) {
 }
 
 func filesetObjectID(p string) string {
-	return "fileset/" + p
+	return TrackerPrefix + p
 }
 
 var _ track.Deleter = &deleter{}
@@ -360,7 +387,12 @@ type deleter struct {
 	store Store
 }
 
-// TODO: This needs to be implemented, temporary filesets are still in Postgres.
+// Delete deletes the metadata for the fileset path tracked by id. The chunks
+// referenced by the fileset are deleted by the chunk deleter once they are no
+// longer referenced.
 func (d *deleter) Delete(ctx context.Context, id string) error {
-	return nil
+	if !strings.HasPrefix(id, TrackerPrefix) {
+		return errors.Errorf("cannot delete (%s)", id)
+	}
+	return d.store.Delete(ctx, id[len(TrackerPrefix):])
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"io"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset/index"
 )
 
@@ -65,3 +67,79 @@ func (im *indexMap) Index() *index.Index {
 func (im *indexMap) Content(w io.Writer) error {
 	return im.inner.Content(w)
 }
+
+var _ FileSet = &byteRanger{}
+
+type byteRanger struct {
+	x            FileSet
+	chunks       *chunk.Storage
+	offset, size int64
+}
+
+// NewByteRanger limits the content of each file in x to size bytes starting
+// at offset (a size of zero means the rest of the file). Only the chunks that
+// contain the byte range are read.
+func NewByteRanger(x FileSet, chunks *chunk.Storage, offset, size int64) FileSet {
+	return &byteRanger{x: x, chunks: chunks, offset: offset, size: size}
+}
+
+func (br *byteRanger) Iterate(ctx context.Context, cb func(File) error, _ ...bool) error {
+	return br.x.Iterate(ctx, func(fr File) error {
+		idx := proto.Clone(fr.Index()).(*index.Index)
+		if idx.File != nil {
+			idx.File.DataRefs = nil
+			idx.File.Parts = []*index.Part{{
+				DataRefs: sliceDataRefs(getDataRefs(idx.File.Parts), br.offset, br.size),
+			}}
+		}
+		return cb(&byteRange{
+			ctx:    ctx,
+			chunks: br.chunks,
+			idx:    idx,
+		})
+	})
+}
+
+// sliceDataRefs returns the data references for size bytes starting at offset
+// in the data referenced by dataRefs. The hashes of the data references that
+// are cut are not updated, so they're only used for reading.
+func sliceDataRefs(dataRefs []*chunk.DataRef, offset, size int64) []*chunk.DataRef {
+	var result []*chunk.DataRef
+	for _, dataRef := range dataRefs {
+		if offset >= dataRef.SizeBytes {
+			offset -= dataRef.SizeBytes
+			continue
+		}
+		dataRef = proto.Clone(dataRef).(*chunk.DataRef)
+		dataRef.OffsetBytes += offset
+		dataRef.SizeBytes -= offset
+		offset = 0
+		if size > 0 {
+			if dataRef.SizeBytes >= size {
+				dataRef.SizeBytes = size
+				return append(result, dataRef)
+			}
+			size -= dataRef.SizeBytes
+		}
+		result = append(result, dataRef)
+	}
+	return result
+}
+
+var _ File = &byteRange{}
+
+type byteRange struct {
+	ctx    context.Context
+	chunks *chunk.Storage
+	idx    *index.Index
+}
+
+func (br *byteRange) Index() *index.Index {
+	return br.idx
+}
+
+func (br *byteRange) Content(w io.Writer) error {
+	dataRefs := getDataRefs(br.idx.File.Parts)
+	r := br.chunks.NewReader(br.ctx, dataRefs)
+	return r.Get(w)
+}
@@ -0,0 +1,45 @@
+package fileset
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
+)
+
+func TestSliceDataRefs(t *testing.T) {
+	// Three data references of 10 bytes each, at different offsets in their
+	// chunks.
+	var dataRefs []*chunk.DataRef
+	for i := 0; i < 3; i++ {
+		dataRefs = append(dataRefs, &chunk.DataRef{
+			Ref:         &chunk.Ref{Id: []byte{byte(i)}},
+			OffsetBytes: int64(i * 5),
+			SizeBytes:   10,
+		})
+	}
+	type span struct {
+		chunk        byte
+		offset, size int64
+	}
+	spans := func(dataRefs []*chunk.DataRef) []span {
+		var result []span
+		for _, dataRef := range dataRefs {
+			result = append(result, span{dataRef.Ref.Id[0], dataRef.OffsetBytes, dataRef.SizeBytes})
+		}
+		return result
+	}
+	// The whole file.
+	require.Equal(t, []span{{0, 0, 10}, {1, 5, 10}, {2, 10, 10}}, spans(sliceDataRefs(dataRefs, 0, 0)))
+	// The rest of the file from an offset.
+	require.Equal(t, []span{{1, 8, 7}, {2, 10, 10}}, spans(sliceDataRefs(dataRefs, 13, 0)))
+	// A range within one data reference.
+	require.Equal(t, []span{{1, 7, 4}}, spans(sliceDataRefs(dataRefs, 12, 4)))
+	// A range that spans data references.
+	require.Equal(t, []span{{0, 8, 2}, {1, 5, 10}, {2, 10, 1}}, spans(sliceDataRefs(dataRefs, 8, 13)))
+	// A range past the end of the file.
+	require.Equal(t, 0, len(sliceDataRefs(dataRefs, 30, 5)))
+	// The data references that are sliced are copies.
+	require.Equal(t, int64(5), dataRefs[1].OffsetBytes)
+	require.Equal(t, int64(10), dataRefs[1].SizeBytes)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/renew"
 )
 
@@ -18,12 +19,26 @@ type memFile struct {
 }
 
 type memPart struct {
-	tag string
-	buf *bytes.Buffer
+	tag      string
+	contents []*memContent
+}
+
+// memContent is either buffered data or data references copied from
+// another file set.
+type memContent struct {
+	buf      *bytes.Buffer
+	dataRefs []*chunk.DataRef
 }
 
 func (mp *memPart) Write(data []byte) (int, error) {
-	return mp.buf.Write(data)
+	if len(mp.contents) == 0 || mp.contents[len(mp.contents)-1].buf == nil {
+		mp.contents = append(mp.contents, &memContent{buf: &bytes.Buffer{}})
+	}
+	return mp.contents[len(mp.contents)-1].buf.Write(data)
+}
+
+func (mp *memPart) copy(dataRefs []*chunk.DataRef) {
+	mp.contents = append(mp.contents, &memContent{dataRefs: dataRefs})
 }
 
 type memFileSet struct {
@@ -51,10 +66,7 @@ func (mfs *memFileSet) createMemPart(p string, tag string) *memPart {
 	}
 	mf := mfs.additive[p]
 	if _, ok := mf.parts[tag]; !ok {
-		mf.parts[tag] = &memPart{
-			tag: tag,
-			buf: &bytes.Buffer{},
-		}
+		mf.parts[tag] = &memPart{tag: tag}
 	}
 	return mf.parts[tag]
 }
@@ -99,8 +111,16 @@ func (mfs *memFileSet) serializeAdditive(w *Writer) error {
 func serializeParts(fw *FileWriter, mf *memFile) error {
 	for _, mp := range sortMemParts(mf.parts) {
 		fw.Append(mp.tag)
-		if _, err := fw.Write(mp.buf.Bytes()); err != nil {
-			return err
+		for _, mc := range mp.contents {
+			if mc.buf != nil {
+				if _, err := fw.Write(mc.buf.Bytes()); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := fw.Copy(mc.dataRefs...); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -206,6 +226,24 @@ func (uw *UnorderedWriter) Append(p string, overwrite bool, r io.Reader, customT
 	}
 }
 
+// Copy copies the files in fs to the file set.
+// The files are copied by reference, so their content is not re-uploaded.
+func (uw *UnorderedWriter) Copy(ctx context.Context, fs FileSet, overwrite bool, customTag ...string) error {
+	tag := uw.defaultTag
+	if len(customTag) > 0 && customTag[0] != "" {
+		tag = customTag[0]
+	}
+	return fs.Iterate(ctx, func(f File) error {
+		idx := f.Index()
+		p := Clean(idx.Path, false)
+		if overwrite {
+			uw.memFileSet.deleteFile(p, "")
+		}
+		uw.memFileSet.createMemPart(p, tag).copy(getDataRefs(idx.File.Parts))
+		return nil
+	})
+}
+
 // Delete deletes a file from the file set.
 // TODO: Directory deletion needs more invariant checks.
 // Right now you have to specify the trailing slash explicitly.
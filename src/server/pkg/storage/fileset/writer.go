@@ -36,6 +36,21 @@ func (fw *FileWriter) Write(data []byte) (int, error) {
 	return fw.cw.Write(data)
 }
 
+// Copy copies data references into the current part of the file.
+// The referenced chunks are reused, so the data is not re-uploaded.
+func (fw *FileWriter) Copy(dataRefs ...*chunk.DataRef) error {
+	parts := fw.idx.File.Parts
+	part := parts[len(parts)-1]
+	for _, dataRef := range dataRefs {
+		part.SizeBytes += dataRef.SizeBytes
+		fw.w.sizeBytes += dataRef.SizeBytes
+		if err := fw.cw.Copy(dataRef); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Writer provides functionality for writing a file set.
 type Writer struct {
 	ctx                context.Context
@@ -2,9 +2,11 @@ package track
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 )
 
@@ -13,6 +15,12 @@ type Deleter interface {
 	Delete(ctx context.Context, id string) error
 }
 
+// Sizer is implemented by Deleters that can report the size of the data
+// associated with a tracked object (the space reclaimed by deleting it).
+type Sizer interface {
+	Size(ctx context.Context, id string) (int64, error)
+}
+
 // DeleterMux returns a Deleter based on the id being deleted
 type DeleterMux func(string) Deleter
 
@@ -25,6 +33,81 @@ func (dm DeleterMux) Delete(ctx context.Context, id string) error {
 	return deleter.Delete(ctx, id)
 }
 
+// Size implements Sizer. Objects whose Deleter is not a Sizer have size 0.
+func (dm DeleterMux) Size(ctx context.Context, id string) (int64, error) {
+	deleter := dm(id)
+	if deleter == nil {
+		return 0, errors.Errorf("deleter mux does not have deleter for (%s)", id)
+	}
+	return sizeOf(ctx, deleter, id)
+}
+
+func sizeOf(ctx context.Context, deleter Deleter, id string) (int64, error) {
+	sizer, ok := deleter.(Sizer)
+	if !ok {
+		return 0, nil
+	}
+	return sizer.Size(ctx, id)
+}
+
+var (
+	gcObjectsDeletable = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_gc",
+			Name:      "objects_deletable",
+			Help:      "Number of deletable objects found by the garbage collector, by object type",
+		},
+		[]string{"type"},
+	)
+	gcObjectsDeleted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_gc",
+			Name:      "objects_deleted",
+			Help:      "Number of objects deleted by the garbage collector, by object type",
+		},
+		[]string{"type"},
+	)
+	gcBytesReclaimed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_gc",
+			Name:      "bytes_reclaimed",
+			Help:      "Number of bytes reclaimed by the garbage collector, by object type",
+		},
+		[]string{"type"},
+	)
+)
+
+func registerMetrics() {
+	for _, metric := range []prometheus.Collector{
+		gcObjectsDeletable,
+		gcObjectsDeleted,
+		gcBytesReclaimed,
+	} {
+		if err := prometheus.Register(metric); err != nil {
+			// metrics may be redundantly registered; ignore these errors
+			if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+				logrus.Errorf("error registering prometheus metric: %v", err)
+			}
+		}
+	}
+}
+
+// objectType returns the type of an object (the first component of its id),
+// which is used to label the gc metrics.
+func objectType(id string) string {
+	return strings.SplitN(id, "/", 2)[0]
+}
+
+// CollectedObject is an object deleted by the garbage collector (or, in a dry
+// run, an object that would be deleted).
+type CollectedObject struct {
+	ID        string
+	SizeBytes int64
+}
+
 // GarbageCollector periodically runs garbage collection on tracker objects
 type GarbageCollector struct {
 	tracker Tracker
@@ -35,6 +118,7 @@ type GarbageCollector struct {
 // NewGarbageCollector returns a garbage collector monitoring tracker, and kicking off a cycle every period.
 // It will use deleter to deleted associated data before deleting objects from the Tracker
 func NewGarbageCollector(tracker Tracker, period time.Duration, deleter Deleter) *GarbageCollector {
+	registerMetrics()
 	return &GarbageCollector{
 		tracker: tracker,
 		period:  period,
@@ -50,7 +134,7 @@ func (gc *GarbageCollector) Run(ctx context.Context) error {
 		if err := func() error {
 			ctx, cf := context.WithTimeout(ctx, gc.period/2)
 			defer cf()
-			return gc.runUntilEmpty(ctx)
+			return gc.RunUntilEmpty(ctx, nil)
 		}(); err != nil {
 			logrus.Errorf("gc: %v", err)
 		}
@@ -62,9 +146,11 @@ func (gc *GarbageCollector) Run(ctx context.Context) error {
 	}
 }
 
-func (gc *GarbageCollector) runUntilEmpty(ctx context.Context) error {
+// RunUntilEmpty runs gc cycles until there are no more objects to delete.
+// cb, if set, is called with each deleted object.
+func (gc *GarbageCollector) RunUntilEmpty(ctx context.Context, cb func(*CollectedObject) error) error {
 	for {
-		n, err := gc.runOnce(ctx)
+		n, err := gc.runOnce(ctx, cb)
 		if err != nil {
 			return err
 		}
@@ -75,25 +161,174 @@ func (gc *GarbageCollector) runUntilEmpty(ctx context.Context) error {
 	return nil
 }
 
-func (gc *GarbageCollector) runOnce(ctx context.Context) (int, error) {
+func (gc *GarbageCollector) runOnce(ctx context.Context, cb func(*CollectedObject) error) (int, error) {
 	var n int
 	err := gc.tracker.IterateDeletable(ctx, func(id string) error {
-		if err := gc.deleteObject(ctx, id); err != nil {
+		gcObjectsDeletable.WithLabelValues(objectType(id)).Inc()
+		size, err := gc.deleteObject(ctx, id)
+		if err != nil {
 			logrus.Errorf("error deleting object (%s): %v", id, err)
-		} else {
-			n++
+			return nil
+		}
+		n++
+		gcObjectsDeleted.WithLabelValues(objectType(id)).Inc()
+		gcBytesReclaimed.WithLabelValues(objectType(id)).Add(float64(size))
+		if cb != nil {
+			return cb(&CollectedObject{ID: id, SizeBytes: size})
 		}
 		return nil
 	})
 	return n, err
 }
 
-func (gc *GarbageCollector) deleteObject(ctx context.Context, id string) error {
+func (gc *GarbageCollector) deleteObject(ctx context.Context, id string) (int64, error) {
 	if err := gc.tracker.MarkTombstone(ctx, id); err != nil {
-		return err
+		return 0, err
+	}
+	// The size has to be determined before the associated data is deleted.
+	size, err := sizeOf(ctx, gc.deleter, id)
+	if err != nil {
+		logrus.Errorf("error getting size of object (%s): %v", id, err)
 	}
 	if err := gc.deleter.Delete(ctx, id); err != nil {
+		return 0, err
+	}
+	return size, gc.tracker.FinishDelete(ctx, id)
+}
+
+// DryRun calls cb with each object that the garbage collector would delete, without
+// deleting anything. This includes the objects that are deletable now, and the
+// objects that would become deletable once the objects referencing them are deleted.
+func (gc *GarbageCollector) DryRun(ctx context.Context, cb func(*CollectedObject) error) error {
+	var queue []string
+	deletable := make(map[string]bool)
+	if err := gc.tracker.IterateDeletable(ctx, func(id string) error {
+		queue = append(queue, id)
+		deletable[id] = true
+		return nil
+	}); err != nil {
 		return err
 	}
-	return gc.tracker.FinishDelete(ctx, id)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		size, err := sizeOf(ctx, gc.deleter, id)
+		if err != nil {
+			return err
+		}
+		if err := cb(&CollectedObject{ID: id, SizeBytes: size}); err != nil {
+			return err
+		}
+		dwn, err := gc.tracker.GetDownstream(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, dwnID := range dwn {
+			if deletable[dwnID] {
+				continue
+			}
+			ok, err := gc.deletableAfter(ctx, dwnID, deletable)
+			if err != nil {
+				return err
+			}
+			if ok {
+				queue = append(queue, dwnID)
+				deletable[dwnID] = true
+			}
+		}
+	}
+	return nil
+}
+
+// deletableAfter returns true if the object with id will be deletable once the
+// objects in deleted are deleted.
+func (gc *GarbageCollector) deletableAfter(ctx context.Context, id string, deleted map[string]bool) (bool, error) {
+	info, err := gc.tracker.GetObject(ctx, id)
+	if err != nil {
+		if err == ErrObjectNotExists {
+			return false, nil
+		}
+		return false, err
+	}
+	if !info.Expired && !info.Tombstone {
+		return false, nil
+	}
+	ups, err := gc.tracker.GetUpstream(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	for _, upID := range ups {
+		if !deleted[upID] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Reference is a chain of references from a root object (an object that is not
+// referenced by any other object) to an object.
+type Reference struct {
+	Root *ObjectInfo
+	// Path is the ids of the objects in the chain, starting with the root.
+	Path []string
+}
+
+// Explanation explains why an object has (or has not yet) been garbage collected.
+type Explanation struct {
+	Object *ObjectInfo
+	// References are the chains of references to the object from each of the
+	// root objects that (transitively) reference it. An object is kept alive by
+	// any unexpired root that references it.
+	References []*Reference
+}
+
+// Explain explains the liveness of the object with id, by walking the objects
+// upstream of it back to the root objects that reference it.
+func Explain(ctx context.Context, tracker Tracker, id string) (*Explanation, error) {
+	info, err := tracker.GetObject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	explanation := &Explanation{Object: info}
+	// parents records the object each visited object was reached from, so the
+	// chain of references can be reconstructed from each root.
+	parents := map[string]string{id: ""}
+	queue := []string{id}
+	for len(queue) > 0 {
+		curID := queue[0]
+		queue = queue[1:]
+		ups, err := tracker.GetUpstream(ctx, curID)
+		if err != nil {
+			return nil, err
+		}
+		if len(ups) == 0 {
+			if curID == id {
+				continue
+			}
+			root, err := tracker.GetObject(ctx, curID)
+			if err != nil {
+				if err == ErrObjectNotExists {
+					continue
+				}
+				return nil, err
+			}
+			var path []string
+			for p := curID; p != ""; p = parents[p] {
+				path = append(path, p)
+			}
+			explanation.References = append(explanation.References, &Reference{
+				Root: root,
+				Path: path,
+			})
+			continue
+		}
+		for _, upID := range ups {
+			if _, ok := parents[upID]; ok {
+				continue
+			}
+			parents[upID] = curID
+			queue = append(queue, upID)
+		}
+	}
+	return explanation, nil
 }
@@ -110,6 +110,29 @@ func (t *postgresTracker) GetUpstream(ctx context.Context, id string) ([]string,
 	return ups, nil
 }
 
+func (t *postgresTracker) GetObject(ctx context.Context, id string) (*ObjectInfo, error) {
+	var row struct {
+		ExpiresAt pq.NullTime `db:"expires_at"`
+		Expired   bool        `db:"expired"`
+		Tombstone bool        `db:"tombstone"`
+	}
+	if err := t.db.GetContext(ctx, &row,
+		`SELECT expires_at, COALESCE(expires_at <= CURRENT_TIMESTAMP, FALSE) AS expired, tombstone
+		FROM storage.tracker_objects
+		WHERE str_id = $1`, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrObjectNotExists
+		}
+		return nil, err
+	}
+	return &ObjectInfo{
+		ID:        id,
+		ExpiresAt: row.ExpiresAt.Time,
+		Expired:   row.Expired,
+		Tombstone: row.Tombstone,
+	}, nil
+}
+
 func (t *postgresTracker) MarkTombstone(ctx context.Context, id string) error {
 	var tombstones []bool
 	if err := t.db.SelectContext(ctx, &tombstones, `	
@@ -21,8 +21,19 @@ var (
 	ErrNotTombstone = errors.Errorf("object cannot be deleted because it is not marked as a tombstone")
 	// ErrSelfReference object cannot reference itself
 	ErrSelfReference = errors.Errorf("object cannot reference itself")
+	// ErrObjectNotExists the object does not exist
+	ErrObjectNotExists = errors.Errorf("object does not exist")
 )
 
+// ObjectInfo is information about a tracked object.
+type ObjectInfo struct {
+	ID string
+	// ExpiresAt is the time the object expires, or the zero time if it never expires.
+	ExpiresAt time.Time
+	Expired   bool
+	Tombstone bool
+}
+
 // Tracker tracks objects and their references to one another.
 type Tracker interface {
 	// CreateObject creates an object with id=id, and pointers to everything in pointsTo
@@ -46,6 +57,10 @@ type Tracker interface {
 	// GetUpstream gets all objects immediately upstream of (pointing to) the object with id
 	GetUpstream(ctx context.Context, id string) ([]string, error)
 
+	// GetObject gets information about the object with id
+	// It errors with ErrObjectNotExists if the object does not exist
+	GetObject(ctx context.Context, id string) (*ObjectInfo, error)
+
 	// MarkTombstone causes the object to appear deleted.  It cannot be created, and objects referencing it cannot
 	// be created until it is deleted.
 	// It errors if id has other objects referencing it.
@@ -110,6 +125,27 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 				require.ElementsEqual(t, []string{"3"}, ups)
 			},
 		},
+		{
+			"GetObject",
+			func(t *testing.T, tracker Tracker) {
+				require.Nil(t, tracker.CreateObject(ctx, "keep", []string{}, 0))
+				require.Nil(t, tracker.CreateObject(ctx, "expire", []string{}, time.Microsecond))
+				time.Sleep(time.Millisecond)
+
+				info, err := tracker.GetObject(ctx, "keep")
+				require.Nil(t, err)
+				require.Equal(t, "keep", info.ID)
+				require.True(t, info.ExpiresAt.IsZero())
+				require.False(t, info.Expired)
+				info, err = tracker.GetObject(ctx, "expire")
+				require.Nil(t, err)
+				require.False(t, info.ExpiresAt.IsZero())
+				require.True(t, info.Expired)
+				require.False(t, info.Tombstone)
+				_, err = tracker.GetObject(ctx, "none")
+				require.Equal(t, ErrObjectNotExists, err)
+			},
+		},
 		{
 			"DeleteSingleObject",
 			func(t *testing.T, tracker Tracker) {
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 )
@@ -204,48 +205,87 @@ func writeFile(filePath string, r io.Reader) (retErr error) {
 	return err
 }
 
+// Export exports the files in storageRoot to a tar stream.
+// Symlinks are dereferenced, so the files (or directories) they point to are
+// exported in their place.
 func Export(storageRoot string, w io.Writer, cb ...func(*tar.Header) error) error {
 	return WithWriter(w, func(tw *tar.Writer) error {
-		return filepath.Walk(storageRoot, func(file string, fi os.FileInfo, err error) (retErr error) {
+		root, err := filepath.EvalSymlinks(storageRoot)
+		if err != nil {
+			return err
+		}
+		return export(tw, storageRoot, "/", map[string]bool{root: true}, cb...)
+	})
+}
+
+// export exports the files in storageRoot under prefix. exporting contains the
+// (resolved) directories that are being exported by the callers of export, so
+// that symlinks that form a cycle are rejected rather than followed forever.
+func export(tw *tar.Writer, storageRoot, prefix string, exporting map[string]bool, cb ...func(*tar.Header) error) error {
+	return filepath.Walk(storageRoot, func(file string, fi os.FileInfo, err error) (retErr error) {
+		if err != nil {
+			return err
+		}
+		if file == storageRoot {
+			return nil
+		}
+		name, err := filepath.Rel(storageRoot, file)
+		if err != nil {
+			return err
+		}
+		// TODO: Remove when path cleaning is in.
+		name = filepath.Join(prefix, name)
+		link := fi.Mode()&os.ModeSymlink != 0
+		if link {
+			target, err := filepath.EvalSymlinks(file)
 			if err != nil {
 				return err
 			}
-			if file == storageRoot {
-				return nil
+			if strings.HasPrefix(file, target+string(filepath.Separator)) {
+				return errors.Errorf("symlink %v points to a parent directory", name)
 			}
-			// TODO: link name?
-			hdr, err := tar.FileInfoHeader(fi, "")
+			file = target
+			fi, err = os.Stat(file)
 			if err != nil {
 				return err
 			}
-			hdr.Name, err = filepath.Rel(storageRoot, file)
-			if err != nil {
-				return err
+			if fi.IsDir() && exporting[file] {
+				return errors.Errorf("symlink %v creates a cycle", name)
 			}
-			// TODO: Remove when path cleaning is in.
-			hdr.Name = filepath.Join("/", hdr.Name)
-			if len(cb) > 0 {
-				if err := cb[0](hdr); err != nil {
-					return err
-				}
-			}
-			if err := tw.WriteHeader(hdr); err != nil {
+		}
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = name
+		if len(cb) > 0 {
+			if err := cb[0](hdr); err != nil {
 				return err
 			}
-			if hdr.Typeflag == tar.TypeDir {
-				return nil
-			}
-			f, err := os.Open(file)
-			if err != nil {
-				return err
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			// The walk does not follow symlinks, so the directories they
+			// point to are walked separately.
+			if link {
+				exporting[file] = true
+				defer delete(exporting, file)
+				return export(tw, file, name, exporting, cb...)
 			}
-			defer func() {
-				if err := f.Close(); retErr == nil {
-					retErr = err
-				}
-			}()
-			_, err = io.Copy(tw, f)
+			return nil
+		}
+		f, err := os.Open(file)
+		if err != nil {
 			return err
-		})
+		}
+		defer func() {
+			if err := f.Close(); retErr == nil {
+				retErr = err
+			}
+		}()
+		_, err = io.Copy(tw, f)
+		return err
 	})
 }
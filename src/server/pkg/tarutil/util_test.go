@@ -0,0 +1,51 @@
+package tarutil
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func exportNames(t *testing.T, storageRoot string) ([]string, error) {
+	var names []string
+	err := Export(storageRoot, &bytes.Buffer{}, func(hdr *tar.Header) error {
+		names = append(names, hdr.Name)
+		return nil
+	})
+	return names, err
+}
+
+func TestExportSymlinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tarutil")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "a"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a", "file"), []byte("content"), 0600))
+	// Symlinks are exported as the files they point to, and the same
+	// directory can be linked more than once.
+	require.NoError(t, os.Symlink(filepath.Join(dir, "a"), filepath.Join(dir, "b")))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "a"), filepath.Join(dir, "c")))
+	names, err := exportNames(t, dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/a", "/a/file", "/b", "/b/file", "/c", "/c/file"}, names)
+
+	// A symlink to a parent directory is a cycle.
+	require.NoError(t, os.Symlink(dir, filepath.Join(dir, "a", "parent")))
+	_, err = exportNames(t, dir)
+	require.YesError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(dir, "a", "parent")))
+
+	// So are directories that link to each other.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "d"), 0700))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "e"), 0700))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "e"), filepath.Join(dir, "d", "l")))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "d"), filepath.Join(dir, "e", "l")))
+	_, err = exportNames(t, dir)
+	require.YesError(t, err)
+	require.Matches(t, "cycle", err.Error())
+}
@@ -427,6 +427,8 @@ type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
 type createFilesetFunc func(pfs.API_CreateFilesetServer) error
 type renewFilesetFunc func(context.Context, *pfs.RenewFilesetRequest) (*types.Empty, error)
+type garbageCollectPFSFunc func(*pfs.GarbageCollectRequest, pfs.API_GarbageCollectServer) error
+type explainStorageObjectFunc func(context.Context, *pfs.ExplainStorageObjectRequest) (*pfs.ExplainStorageObjectResponse, error)
 
 type mockCreateRepo struct{ handler createRepoFunc }
 type mockInspectRepo struct{ handler inspectRepoFunc }
@@ -456,70 +458,76 @@ type mockDeleteAllPFS struct{ handler deleteAllPFSFunc }
 type mockFsck struct{ handler fsckFunc }
 type mockCreateFileset struct{ handler createFilesetFunc }
 type mockRenewFileset struct{ handler renewFilesetFunc }
-
-func (mock *mockCreateRepo) Use(cb createRepoFunc)           { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)         { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)               { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)           { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)         { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)       { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)     { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)           { mock.handler = cb }
-func (mock *mockDeleteCommit) Use(cb deleteCommitFunc)       { mock.handler = cb }
-func (mock *mockFlushCommit) Use(cb flushCommitFunc)         { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc) { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)         { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)       { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)     { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)           { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)       { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)           { mock.handler = cb }
-func (mock *mockCopyFile) Use(cb copyFileFunc)               { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                 { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)         { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)               { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)               { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)               { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)               { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)       { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                       { mock.handler = cb }
-func (mock *mockCreateFileset) Use(cb createFilesetFunc)     { mock.handler = cb }
-func (mock *mockRenewFileset) Use(cb renewFilesetFunc)       { mock.handler = cb }
+type mockGarbageCollectPFS struct{ handler garbageCollectPFSFunc }
+type mockExplainStorageObject struct{ handler explainStorageObjectFunc }
+
+func (mock *mockCreateRepo) Use(cb createRepoFunc)                     { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)                   { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                         { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                     { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)                   { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)                 { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)               { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)                     { mock.handler = cb }
+func (mock *mockDeleteCommit) Use(cb deleteCommitFunc)                 { mock.handler = cb }
+func (mock *mockFlushCommit) Use(cb flushCommitFunc)                   { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)           { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)                   { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)                 { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)               { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)                     { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)                 { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)                     { mock.handler = cb }
+func (mock *mockCopyFile) Use(cb copyFileFunc)                         { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                           { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)                   { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                         { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                         { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                         { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                         { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)                 { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                                 { mock.handler = cb }
+func (mock *mockCreateFileset) Use(cb createFilesetFunc)               { mock.handler = cb }
+func (mock *mockRenewFileset) Use(cb renewFilesetFunc)                 { mock.handler = cb }
+func (mock *mockGarbageCollectPFS) Use(cb garbageCollectPFSFunc)       { mock.handler = cb }
+func (mock *mockExplainStorageObject) Use(cb explainStorageObjectFunc) { mock.handler = cb }
 
 type pfsServerAPI struct {
 	mock *mockPFSServer
 }
 
 type mockPFSServer struct {
-	api             pfsServerAPI
-	CreateRepo      mockCreateRepo
-	InspectRepo     mockInspectRepo
-	ListRepo        mockListRepo
-	DeleteRepo      mockDeleteRepo
-	StartCommit     mockStartCommit
-	FinishCommit    mockFinishCommit
-	InspectCommit   mockInspectCommit
-	ListCommit      mockListCommit
-	DeleteCommit    mockDeleteCommit
-	FlushCommit     mockFlushCommit
-	SubscribeCommit mockSubscribeCommit
-	ClearCommit     mockClearCommit
-	CreateBranch    mockCreateBranch
-	InspectBranch   mockInspectBranch
-	ListBranch      mockListBranch
-	DeleteBranch    mockDeleteBranch
-	ModifyFile      mockModifyFile
-	CopyFile        mockCopyFile
-	GetFile         mockGetFile
-	InspectFile     mockInspectFile
-	ListFile        mockListFile
-	WalkFile        mockWalkFile
-	GlobFile        mockGlobFile
-	DiffFile        mockDiffFile
-	DeleteAll       mockDeleteAllPFS
-	Fsck            mockFsck
-	CreateFileset   mockCreateFileset
-	RenewFileset    mockRenewFileset
+	api                  pfsServerAPI
+	CreateRepo           mockCreateRepo
+	InspectRepo          mockInspectRepo
+	ListRepo             mockListRepo
+	DeleteRepo           mockDeleteRepo
+	StartCommit          mockStartCommit
+	FinishCommit         mockFinishCommit
+	InspectCommit        mockInspectCommit
+	ListCommit           mockListCommit
+	DeleteCommit         mockDeleteCommit
+	FlushCommit          mockFlushCommit
+	SubscribeCommit      mockSubscribeCommit
+	ClearCommit          mockClearCommit
+	CreateBranch         mockCreateBranch
+	InspectBranch        mockInspectBranch
+	ListBranch           mockListBranch
+	DeleteBranch         mockDeleteBranch
+	ModifyFile           mockModifyFile
+	CopyFile             mockCopyFile
+	GetFile              mockGetFile
+	InspectFile          mockInspectFile
+	ListFile             mockListFile
+	WalkFile             mockWalkFile
+	GlobFile             mockGlobFile
+	DiffFile             mockDiffFile
+	DeleteAll            mockDeleteAllPFS
+	Fsck                 mockFsck
+	CreateFileset        mockCreateFileset
+	RenewFileset         mockRenewFileset
+	GarbageCollect       mockGarbageCollectPFS
+	ExplainStorageObject mockExplainStorageObject
 }
 
 func (api *pfsServerAPI) CreateRepo(ctx context.Context, req *pfs.CreateRepoRequest) (*types.Empty, error) {
@@ -690,6 +698,18 @@ func (api *pfsServerAPI) RenewFileset(ctx context.Context, req *pfs.RenewFileset
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.RenewFileset")
 }
+func (api *pfsServerAPI) GarbageCollect(req *pfs.GarbageCollectRequest, serv pfs.API_GarbageCollectServer) error {
+	if api.mock.GarbageCollect.handler != nil {
+		return api.mock.GarbageCollect.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.GarbageCollect")
+}
+func (api *pfsServerAPI) ExplainStorageObject(ctx context.Context, req *pfs.ExplainStorageObjectRequest) (*pfs.ExplainStorageObjectResponse, error) {
+	if api.mock.ExplainStorageObject.handler != nil {
+		return api.mock.ExplainStorageObject.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ExplainStorageObject")
+}
 
 /* PPS Server Mocks */
 
@@ -716,6 +736,7 @@ type deleteSecretFunc func(context.Context, *pps.DeleteSecretRequest) (*types.Em
 type inspectSecretFunc func(context.Context, *pps.InspectSecretRequest) (*pps.SecretInfo, error)
 type listSecretFunc func(context.Context, *types.Empty) (*pps.SecretInfos, error)
 type deleteAllPPSFunc func(context.Context, *types.Empty) (*types.Empty, error)
+type getLogsFunc func(*pps.GetLogsRequest, pps.API_GetLogsServer) error
 type activateAuthPPSFunc func(context.Context, *pps.ActivateAuthRequest) (*pps.ActivateAuthResponse, error)
 
 type mockCreateJob struct{ handler createJobFunc }
@@ -741,6 +762,7 @@ type mockDeleteSecret struct{ handler deleteSecretFunc }
 type mockInspectSecret struct{ handler inspectSecretFunc }
 type mockListSecret struct{ handler listSecretFunc }
 type mockDeleteAllPPS struct{ handler deleteAllPPSFunc }
+type mockGetLogs struct{ handler getLogsFunc }
 type mockActivateAuthPPS struct{ handler activateAuthPPSFunc }
 
 func (mock *mockCreateJob) Use(cb createJobFunc)             { mock.handler = cb }
@@ -766,6 +788,7 @@ func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)       { mock.handler = cb
 func (mock *mockInspectSecret) Use(cb inspectSecretFunc)     { mock.handler = cb }
 func (mock *mockListSecret) Use(cb listSecretFunc)           { mock.handler = cb }
 func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)       { mock.handler = cb }
+func (mock *mockGetLogs) Use(cb getLogsFunc)                 { mock.handler = cb }
 func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc) { mock.handler = cb }
 
 type ppsServerAPI struct {
@@ -797,6 +820,7 @@ type mockPPSServer struct {
 	InspectSecret   mockInspectSecret
 	ListSecret      mockListSecret
 	DeleteAll       mockDeleteAllPPS
+	GetLogs         mockGetLogs
 	ActivateAuth    mockActivateAuthPPS
 }
 
@@ -938,6 +962,12 @@ func (api *ppsServerAPI) DeleteAll(ctx context.Context, req *types.Empty) (*type
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.DeleteAll")
 }
+func (api *ppsServerAPI) GetLogs(req *pps.GetLogsRequest, serv pps.API_GetLogsServer) error {
+	if api.mock.GetLogs.handler != nil {
+		return api.mock.GetLogs.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pps.GetLogs")
+}
 func (api *ppsServerAPI) ActivateAuth(ctx context.Context, req *pps.ActivateAuthRequest) (*pps.ActivateAuthResponse, error) {
 	if api.mock.ActivateAuth.handler != nil {
 		return api.mock.ActivateAuth.handler(ctx, req)
@@ -110,5 +110,6 @@ func NewDefaultConfig() *serviceenv.PachdFullConfiguration {
 	config.StorageLevelZeroSize = units.MB
 	config.StorageGCPolling = "30s"
 	config.StorageCompactionMaxFanIn = 50
+	config.StorageMaxReadahead = 16
 	return config
 }
@@ -2,6 +2,7 @@ package transactionenv
 
 import (
 	"context"
+	"io"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -165,6 +166,10 @@ type PfsTransactionServer interface {
 	CreateBranchInTransaction(*TransactionContext, *pfs.CreateBranchRequest) error
 	InspectBranchInTransaction(*TransactionContext, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 	DeleteBranchInTransaction(*TransactionContext, *pfs.DeleteBranchRequest) error
+
+	// GetOpenCommitFile is not transactional, it lets PPS read the files
+	// written to an open commit so far.
+	GetOpenCommitFile(*client.APIClient, *pfs.Commit, string, io.Writer) error
 }
 
 // PpsTransactionServer is an interface for the transactionally-supported
@@ -201,6 +206,12 @@ func (env *TransactionEnv) Initialize(
 	env.ppsServer = ppsServer
 }
 
+// GetOpenCommitFile reads the files written to a (possibly open) commit
+// through the PFS server.
+func (env *TransactionEnv) GetOpenCommitFile(pachClient *client.APIClient, commit *pfs.Commit, glob string, w io.Writer) error {
+	return env.pfsServer.GetOpenCommitFile(pachClient, commit, glob, w)
+}
+
 // Transaction is an interface to unify the code that may either perform an
 // action directly or append an action to an existing transaction (depending on
 // if there is an active transaction in the client context metadata).  There
@@ -1,6 +1,9 @@
 package transactionenv
 
 import (
+	"io"
+
+	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
@@ -119,6 +122,11 @@ func (mpts *MockPfsTransactionServer) DeleteBranchInTransaction(*TransactionCont
 	return unimplementedError("PfsTransactionServer.DeleteBranchInTransaction")
 }
 
+// GetOpenCommitFile always errors
+func (mpts *MockPfsTransactionServer) GetOpenCommitFile(*client.APIClient, *pfs.Commit, string, io.Writer) error {
+	return unimplementedError("PfsTransactionServer.GetOpenCommitFile")
+}
+
 // MockPpsTransactionServer is a simple mock that can be used to satisfy the
 // PpsTransactionServer interface
 type MockPpsTransactionServer struct{}
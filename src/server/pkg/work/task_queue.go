@@ -8,6 +8,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 
 	"github.com/cevaris/ordered_map"
+	"golang.org/x/sync/semaphore"
 )
 
 const (
@@ -57,22 +58,26 @@ type taskQueue struct {
 	tasksDeletedSinceRemap int
 }
 
-func newTaskQueue(ctx context.Context) *taskQueue {
+// newTaskQueue creates a task queue that processes at most maxConcurrent
+// subtasks at a time.
+func newTaskQueue(ctx context.Context, maxConcurrent int) *taskQueue {
 	tq := &taskQueue{
 		tasks: ordered_map.NewOrderedMap(),
 	}
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+	sem := semaphore.NewWeighted(int64(maxConcurrent))
 	// The next subtask to process is determined by iterating through the ordered map and checking the
 	// subtask function channel for each task entry to see if the next subtask is ready to be processed.
-	// If a subtask function is received, then it is executed.
-	// After processing a subtask, the iteration starts from the beginning (new subtasks from earlier
+	// If a subtask function is received, then it is executed once a processing slot is available.
+	// After starting a subtask, the iteration starts from the beginning (new subtasks from earlier
 	// tasks should be processed first).
 	go func() {
 	NextSubtask:
 		for {
-			select {
-			case <-ctx.Done():
+			if err := sem.Acquire(ctx, 1); err != nil {
 				return
-			default:
 			}
 			tq.mu.Lock()
 			iter := tq.tasks.IterFunc()
@@ -81,12 +86,16 @@ func newTaskQueue(ctx context.Context) *taskQueue {
 				select {
 				case f := <-te.subtaskFuncChan:
 					tq.mu.Unlock()
-					f(te.ctx)
+					go func() {
+						defer sem.Release(1)
+						f(te.ctx)
+					}()
 					continue NextSubtask
 				default:
 				}
 			}
 			tq.mu.Unlock()
+			sem.Release(1)
 			time.Sleep(waitTime)
 		}
 	}()
@@ -3,10 +3,12 @@ package work
 import (
 	"context"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"golang.org/x/sync/errgroup"
 )
 
 type testTask struct {
@@ -24,7 +26,7 @@ func TestTaskQueue(t *testing.T) {
 			subtaskChan: make(chan struct{}),
 		})
 	}
-	tq := newTaskQueue(context.Background())
+	tq := newTaskQueue(context.Background(), 1)
 	var readyChans, doneChans []chan struct{}
 	for i := 0; i < numSubtasks; i++ {
 		readyChans = append(readyChans, make(chan struct{}))
@@ -63,3 +65,34 @@ func TestTaskQueue(t *testing.T) {
 		}
 	}
 }
+
+func TestTaskQueueMaxConcurrent(t *testing.T) {
+	maxConcurrent := 2
+	numSubtasks := 10
+	tq := newTaskQueue(context.Background(), maxConcurrent)
+	var running, maxRunning int64
+	done := make(chan struct{})
+	require.NoError(t, tq.runTask(context.Background(), "task", func(taskEntry *taskEntry) {
+		defer close(done)
+		var eg errgroup.Group
+		for i := 0; i < numSubtasks; i++ {
+			eg.Go(func() error {
+				return taskEntry.runSubtaskBlock(func(_ context.Context) error {
+					n := atomic.AddInt64(&running, 1)
+					defer atomic.AddInt64(&running, -1)
+					for {
+						max := atomic.LoadInt64(&maxRunning)
+						if n <= max || atomic.CompareAndSwapInt64(&maxRunning, max, n) {
+							break
+						}
+					}
+					time.Sleep(100 * time.Millisecond)
+					return nil
+				})
+			})
+		}
+		require.NoError(t, eg.Wait())
+	}))
+	<-done
+	require.Equal(t, int64(maxConcurrent), atomic.LoadInt64(&maxRunning))
+}
@@ -39,7 +39,7 @@ type taskEtcd struct {
 func NewTaskQueue(ctx context.Context, etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) (*TaskQueue, error) {
 	tq := &TaskQueue{
 		taskEtcd:  newTaskEtcd(etcdClient, etcdPrefix, taskNamespace),
-		taskQueue: newTaskQueue(ctx),
+		taskQueue: newTaskQueue(ctx, 1),
 	}
 	// Clear etcd key space.
 	// TODO: Multiple storage task queues are setup, so deleting the existing tasks is problematic.
@@ -259,11 +259,30 @@ func (tq *TaskQueue) deleteAllTasks() error {
 // in the task.
 type Worker struct {
 	*taskEtcd
+	maxConcurrentSubtasks int
+}
+
+// WorkerOption configures a worker.
+type WorkerOption func(*Worker)
+
+// WithMaxConcurrentSubtasks sets the maximum number of subtasks that a worker
+// will claim and process at the same time (the default is one).
+func WithMaxConcurrentSubtasks(max int) WorkerOption {
+	return func(w *Worker) {
+		w.maxConcurrentSubtasks = max
+	}
 }
 
 // NewWorker creates a new worker.
-func NewWorker(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *Worker {
-	return &Worker{taskEtcd: newTaskEtcd(etcdClient, etcdPrefix, taskNamespace)}
+func NewWorker(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string, opts ...WorkerOption) *Worker {
+	w := &Worker{
+		taskEtcd:              newTaskEtcd(etcdClient, etcdPrefix, taskNamespace),
+		maxConcurrentSubtasks: 1,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
 }
 
 // ProcessFunc is a callback that is used for processing a subtask in a task.
@@ -272,7 +291,7 @@ type ProcessFunc func(context.Context, *Task) error
 // Run runs the worker with the given context.
 // The worker will continue to watch the task collection until the context is canceled.
 func (w *Worker) Run(ctx context.Context, processFunc ProcessFunc) error {
-	taskQueue := newTaskQueue(ctx)
+	taskQueue := newTaskQueue(ctx, w.maxConcurrentSubtasks)
 	return w.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
 		var taskID string
 		task := &Task{}
@@ -2,12 +2,15 @@ package cmds
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	pachdclient "github.com/pachyderm/pachyderm/src/client"
@@ -29,10 +32,13 @@ import (
 	txncmds "github.com/pachyderm/pachyderm/src/server/transaction/cmds"
 
 	prompt "github.com/c-bata/go-prompt"
+	"github.com/fatih/color"
 	docker "github.com/fsouza/go-dockerclient"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/itchyny/gojq"
 	glob "github.com/pachyderm/ohmyglob"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -371,11 +377,22 @@ each datum.`,
 	}
 	commands = append(commands, cmdutil.CreateAlias(restartDatum, "restart datum"))
 
+	var pageSize int64
+	var page int64
 	listDatum := &cobra.Command{
 		Use:   "{{alias}} <job>",
 		Short: "Return the datums in a job.",
 		Long:  "Return the datums in a job.",
 		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) (retErr error) {
+			if pageSize < 0 {
+				return errors.Errorf("--page-size must be nonnegative")
+			}
+			if page < 0 {
+				return errors.Errorf("--page must be nonnegative")
+			}
+			if page > 0 && pageSize == 0 {
+				return errors.Errorf("--page can only be used with --page-size")
+			}
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -405,17 +422,23 @@ each datum.`,
 			if len(args) != 1 {
 				return errors.Errorf("must specify one job")
 			}
+			if pageSize > 0 {
+				_, err := client.ListDatumPage(args[0], pageSize, page, "", printF)
+				return err
+			}
 			return client.ListDatum(args[0], printF)
 		}),
 	}
 	listDatum.Flags().AddFlagSet(outputFlags)
+	listDatum.Flags().Int64Var(&pageSize, "page-size", 0, "Specify the number of datums to return, 0 returns all of them.")
+	listDatum.Flags().Int64Var(&page, "page", 0, "Specify the page of datums to return, starting at 0; needs to be used with --page-size.")
 	shell.RegisterCompletionFunc(listDatum, shell.JobCompletion)
 	commands = append(commands, cmdutil.CreateAlias(listDatum, "list datum"))
 
 	inspectDatum := &cobra.Command{
 		Use:   "{{alias}} <job> <datum>",
 		Short: "Display detailed info about a single datum.",
-		Long:  "Display detailed info about a single datum. Requires the pipeline to have stats enabled.",
+		Long:  "Display detailed info about a single datum, including the logs written while processing it. Requires the pipeline to have stats enabled.",
 		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
@@ -438,126 +461,125 @@ each datum.`,
 	inspectDatum.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectDatum, "inspect datum"))
 
-	//	var (
-	//		jobID       string
-	//		datumID     string
-	//		commaInputs string // comma-separated list of input files of interest
-	//		master      bool
-	//		worker      bool
-	//		follow      bool
-	//		tail        int64
-	//	)
-	//
-	//	// prettyLogsPrinter helps to print the logs recieved in different colours
-	//	prettyLogsPrinter := func(message string) {
-	//		informationArray := strings.Split(message, " ")
-	//		if len(informationArray) > 1 {
-	//			debugString := informationArray[1]
-	//			debugLevel := strings.ToLower(debugString)
-	//			var debugLevelColoredString string
-	//			if debugLevel == "info" {
-	//				debugLevelColoredString = color.New(color.FgGreen).Sprint(debugString)
-	//			} else if debugLevel == "warning" {
-	//				debugLevelColoredString = color.New(color.FgYellow).Sprint(debugString)
-	//			} else if debugLevel == "error" {
-	//				debugLevelColoredString = color.New(color.FgRed).Sprint(debugString)
-	//			} else {
-	//				debugLevelColoredString = debugString
-	//			}
-	//			informationArray[1] = debugLevelColoredString
-	//			coloredMessage := strings.Join(informationArray, " ")
-	//			fmt.Println(coloredMessage)
-	//		} else {
-	//			fmt.Println(message)
-	//		}
-	//
-	//	}
-	//
-	// TODO: Make logs work with V2.
-	//	getLogs := &cobra.Command{
-	//		Use:   "{{alias}} [--pipeline=<pipeline>|--job=<job>] [--datum=<datum>]",
-	//		Short: "Return logs from a job.",
-	//		Long:  "Return logs from a job.",
-	//		Example: `
-	//# Return logs emitted by recent jobs in the "filter" pipeline
-	//$ {{alias}} --pipeline=filter
-	//
-	//# Return logs emitted by the job aedfa12aedf
-	//$ {{alias}} --job=aedfa12aedf
-	//
-	//# Return logs emitted by the pipeline \"filter\" while processing /apple.txt and a file with the hash 123aef
-	//$ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
-	//		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
-	//			client, err := pachdclient.NewOnUserMachine("user")
-	//			if err != nil {
-	//				return errors.Wrapf(err, "error connecting to pachd")
-	//			}
-	//			defer client.Close()
-	//
-	//			// Break up comma-separated input paths, and filter out empty entries
-	//			data := strings.Split(commaInputs, ",")
-	//			for i := 0; i < len(data); {
-	//				if len(data[i]) == 0 {
-	//					if i+1 < len(data) {
-	//						copy(data[i:], data[i+1:])
-	//					}
-	//					data = data[:len(data)-1]
-	//				} else {
-	//					i++
-	//				}
-	//			}
-	//
-	//			// Issue RPC
-	//			iter := client.GetLogs(pipelineName, jobID, data, datumID, master, follow, tail)
-	//			var buf bytes.Buffer
-	//			encoder := json.NewEncoder(&buf)
-	//			for iter.Next() {
-	//				if raw {
-	//					buf.Reset()
-	//					if err := encoder.Encode(iter.Message()); err != nil {
-	//						fmt.Fprintf(os.Stderr, "error marshalling \"%v\": %s\n", iter.Message(), err)
-	//					}
-	//					fmt.Println(buf.String())
-	//				} else if iter.Message().User && !master && !worker {
-	//					prettyLogsPrinter(iter.Message().Message)
-	//				} else if iter.Message().Master && master {
-	//					prettyLogsPrinter(iter.Message().Message)
-	//				} else if !iter.Message().User && !iter.Message().Master && worker {
-	//					prettyLogsPrinter(iter.Message().Message)
-	//				} else if pipelineName == "" && jobID == "" {
-	//					prettyLogsPrinter(iter.Message().Message)
-	//				}
-	//			}
-	//			return iter.Err()
-	//		}),
-	//	}
-	//	getLogs.Flags().StringVarP(&pipelineName, "pipeline", "p", "", "Filter the log "+
-	//		"for lines from this pipeline (accepts pipeline name)")
-	//	getLogs.MarkFlagCustom("pipeline", "__pachctl_get_pipeline")
-	//	getLogs.Flags().StringVarP(&jobID, "job", "j", "", "Filter for log lines from "+
-	//		"this job (accepts job ID)")
-	//	getLogs.MarkFlagCustom("job", "__pachctl_get_job")
-	//	getLogs.Flags().StringVar(&datumID, "datum", "", "Filter for log lines for this datum (accepts datum ID)")
-	//	getLogs.Flags().StringVar(&commaInputs, "inputs", "", "Filter for log lines "+
-	//		"generated while processing these files (accepts PFS paths or file hashes)")
-	//	getLogs.Flags().BoolVar(&master, "master", false, "Return log messages from the master process (pipeline must be set).")
-	//	getLogs.Flags().BoolVar(&worker, "worker", false, "Return log messages from the worker process.")
-	//	getLogs.Flags().BoolVar(&raw, "raw", false, "Return log messages verbatim from server.")
-	//	getLogs.Flags().BoolVarP(&follow, "follow", "f", false, "Follow logs as more are created.")
-	//	getLogs.Flags().Int64VarP(&tail, "tail", "t", 0, "Lines of recent logs to display.")
-	//	shell.RegisterCompletionFunc(getLogs,
-	//		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
-	//			if flag == "--pipeline" || flag == "-p" {
-	//				cs, cf := shell.PipelineCompletion(flag, text, maxCompletions)
-	//				return cs, shell.AndCacheFunc(cf, shell.SameFlag(flag))
-	//			}
-	//			if flag == "--job" || flag == "-j" {
-	//				cs, cf := shell.JobCompletion(flag, text, maxCompletions)
-	//				return cs, shell.AndCacheFunc(cf, shell.SameFlag(flag))
-	//			}
-	//			return nil, shell.SameFlag(flag)
-	//		})
-	//	commands = append(commands, cmdutil.CreateAlias(getLogs, "logs"))
+	var (
+		jobID       string
+		datumID     string
+		commaInputs string // comma-separated list of input files of interest
+		master      bool
+		worker      bool
+		follow      bool
+		tail        int64
+	)
+
+	// prettyLogsPrinter helps to print the logs recieved in different colours
+	prettyLogsPrinter := func(message string) {
+		informationArray := strings.Split(message, " ")
+		if len(informationArray) > 1 {
+			debugString := informationArray[1]
+			debugLevel := strings.ToLower(debugString)
+			var debugLevelColoredString string
+			if debugLevel == "info" {
+				debugLevelColoredString = color.New(color.FgGreen).Sprint(debugString)
+			} else if debugLevel == "warning" {
+				debugLevelColoredString = color.New(color.FgYellow).Sprint(debugString)
+			} else if debugLevel == "error" {
+				debugLevelColoredString = color.New(color.FgRed).Sprint(debugString)
+			} else {
+				debugLevelColoredString = debugString
+			}
+			informationArray[1] = debugLevelColoredString
+			coloredMessage := strings.Join(informationArray, " ")
+			fmt.Println(coloredMessage)
+		} else {
+			fmt.Println(message)
+		}
+
+	}
+
+	getLogs := &cobra.Command{
+		Use:   "{{alias}} [--pipeline=<pipeline>|--job=<job>] [--datum=<datum>]",
+		Short: "Return logs from a job.",
+		Long:  "Return logs from a job.",
+		Example: `
+# Return logs emitted by recent jobs in the "filter" pipeline
+$ {{alias}} --pipeline=filter
+
+# Return logs emitted by the job aedfa12aedf
+$ {{alias}} --job=aedfa12aedf
+
+# Return logs emitted by the pipeline \"filter\" while processing /apple.txt and a file with the hash 123aef
+$ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "error connecting to pachd")
+			}
+			defer client.Close()
+
+			// Break up comma-separated input paths, and filter out empty entries
+			data := strings.Split(commaInputs, ",")
+			for i := 0; i < len(data); {
+				if len(data[i]) == 0 {
+					if i+1 < len(data) {
+						copy(data[i:], data[i+1:])
+					}
+					data = data[:len(data)-1]
+				} else {
+					i++
+				}
+			}
+
+			// Issue RPC
+			iter := client.GetLogs(pipelineName, jobID, data, datumID, master, follow, tail)
+			var buf bytes.Buffer
+			encoder := json.NewEncoder(&buf)
+			for iter.Next() {
+				if raw {
+					buf.Reset()
+					if err := encoder.Encode(iter.Message()); err != nil {
+						fmt.Fprintf(os.Stderr, "error marshalling \"%v\": %s\n", iter.Message(), err)
+					}
+					fmt.Println(buf.String())
+				} else if iter.Message().User && !master && !worker {
+					prettyLogsPrinter(iter.Message().Message)
+				} else if iter.Message().Master && master {
+					prettyLogsPrinter(iter.Message().Message)
+				} else if !iter.Message().User && !iter.Message().Master && worker {
+					prettyLogsPrinter(iter.Message().Message)
+				} else if pipelineName == "" && jobID == "" {
+					prettyLogsPrinter(iter.Message().Message)
+				}
+			}
+			return iter.Err()
+		}),
+	}
+	getLogs.Flags().StringVarP(&pipelineName, "pipeline", "p", "", "Filter the log "+
+		"for lines from this pipeline (accepts pipeline name)")
+	getLogs.MarkFlagCustom("pipeline", "__pachctl_get_pipeline")
+	getLogs.Flags().StringVarP(&jobID, "job", "j", "", "Filter for log lines from "+
+		"this job (accepts job ID)")
+	getLogs.MarkFlagCustom("job", "__pachctl_get_job")
+	getLogs.Flags().StringVar(&datumID, "datum", "", "Filter for log lines for this datum (accepts datum ID)")
+	getLogs.Flags().StringVar(&commaInputs, "inputs", "", "Filter for log lines "+
+		"generated while processing these files (accepts PFS paths or file hashes)")
+	getLogs.Flags().BoolVar(&master, "master", false, "Return log messages from the master process (pipeline must be set).")
+	getLogs.Flags().BoolVar(&worker, "worker", false, "Return log messages from the worker process.")
+	getLogs.Flags().BoolVar(&raw, "raw", false, "Return log messages verbatim from server.")
+	getLogs.Flags().BoolVarP(&follow, "follow", "f", false, "Follow logs as more are created.")
+	getLogs.Flags().Int64VarP(&tail, "tail", "t", 0, "Lines of recent logs to display.")
+	shell.RegisterCompletionFunc(getLogs,
+		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
+			if flag == "--pipeline" || flag == "-p" {
+				cs, cf := shell.PipelineCompletion(flag, text, maxCompletions)
+				return cs, shell.AndCacheFunc(cf, shell.SameFlag(flag))
+			}
+			if flag == "--job" || flag == "-j" {
+				cs, cf := shell.JobCompletion(flag, text, maxCompletions)
+				return cs, shell.AndCacheFunc(cf, shell.SameFlag(flag))
+			}
+			return nil, shell.SameFlag(flag)
+		})
+	commands = append(commands, cmdutil.CreateAlias(getLogs, "logs"))
 
 	pipelineDocs := &cobra.Command{
 		Short: "Docs for pipelines.",
@@ -606,7 +628,152 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
 	commands = append(commands, cmdutil.CreateAlias(updatePipeline, "update pipeline"))
 
-	var jobID string
+	var editor string
+	editPipeline := &cobra.Command{
+		Use:   "{{alias}} <pipeline>",
+		Short: "Edit the manifest for a pipeline in your text editor.",
+		Long:  "Edit the manifest for a pipeline in your text editor. The current spec is opened in $EDITOR, and any changes are submitted as a pipeline update once the editor exits.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			pipelineInfo, err := client.InspectPipeline(args[0])
+			if err != nil {
+				return err
+			}
+			if pipelineInfo.Transform == nil && pipelineInfo.TFJob == nil {
+				return errors.Errorf("could not retrieve the spec of pipeline %q", args[0])
+			}
+			createPipelineRequest := ppsutil.PipelineReqFromInfo(pipelineInfo)
+			f, err := ioutil.TempFile("", args[0])
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := os.Remove(f.Name()); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			if err := encoder(output, f).EncodeProto(createPipelineRequest); err != nil {
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			if editor == "" {
+				editor = os.Getenv("EDITOR")
+			}
+			if editor == "" {
+				editor = "vim"
+			}
+			editorArgs := append(strings.Fields(editor), f.Name())
+			if err := cmdutil.RunIO(cmdutil.IO{
+				Stdin:  os.Stdin,
+				Stdout: os.Stdout,
+				Stderr: os.Stderr,
+			}, editorArgs...); err != nil {
+				return err
+			}
+			pipelineReader, err := ppsutil.NewPipelineManifestReader(f.Name())
+			if err != nil {
+				return err
+			}
+			request, err := pipelineReader.NextCreatePipelineRequest()
+			if err != nil {
+				return err
+			}
+			if request.Pipeline == nil || request.Pipeline.Name != args[0] {
+				return errors.Errorf("the edited spec must be for pipeline %q; use 'create pipeline' to create a new pipeline", args[0])
+			}
+			if proto.Equal(createPipelineRequest, request) {
+				fmt.Println("Pipeline unchanged, no update will be performed.")
+				return nil
+			}
+			request.Update = true
+			request.Reprocess = reprocess
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				_, err := txClient.PpsAPIClient.CreatePipeline(
+					txClient.Ctx(),
+					request,
+				)
+				return grpcutil.ScrubGRPC(err)
+			})
+		}),
+	}
+	editPipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
+	editPipeline.Flags().StringVar(&editor, "editor", "", "Editor to use for modifying the manifest (default $EDITOR, or vim).")
+	editPipeline.Flags().StringVarP(&output, "output", "o", "", "Output format: \"json\" or \"yaml\" (default \"json\")")
+	commands = append(commands, cmdutil.CreateAlias(editPipeline, "edit pipeline"))
+
+	var versions string
+	diffPipeline := &cobra.Command{
+		Use:   "{{alias}} <pipeline>",
+		Short: "Show the differences between two versions of a pipeline's spec.",
+		Long:  "Show the differences between two versions of a pipeline's spec, as stored in the pipeline's spec history. By default, the current version is compared with the previous one.",
+		Example: `
+		# Compare the current version of pipeline "foo" with the previous version
+		$ {{alias}} foo
+
+		# Compare versions 2 and 4 of pipeline "foo"
+		$ {{alias}} foo --version 2..4
+
+		# Compare version 2 of pipeline "foo" with the current version
+		$ {{alias}} foo --version 2`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			pipelineInfos, err := client.ListPipelineHistory(args[0], -1)
+			if err != nil {
+				return err
+			}
+			if len(pipelineInfos) == 0 {
+				return errors.Errorf("pipeline %q not found", args[0])
+			}
+			// pipelineInfos are ordered from the current version to the oldest
+			byVersion := make(map[uint64]*ppsclient.PipelineInfo)
+			for _, pipelineInfo := range pipelineInfos {
+				byVersion[pipelineInfo.Version] = pipelineInfo
+			}
+			current := pipelineInfos[0].Version
+			from, to, err := parseVersionRange(versions, current)
+			if err != nil {
+				return err
+			}
+			var specs [2]string
+			for i, version := range []uint64{from, to} {
+				pipelineInfo, ok := byVersion[version]
+				if !ok {
+					return errors.Errorf("version %d of pipeline %q not found (current version is %d)", version, args[0], current)
+				}
+				var buf bytes.Buffer
+				if err := encoder(output, &buf).EncodeProto(ppsutil.PipelineReqFromInfo(pipelineInfo)); err != nil {
+					return err
+				}
+				specs[i] = buf.String()
+			}
+			diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(specs[0]),
+				B:        difflib.SplitLines(specs[1]),
+				FromFile: fmt.Sprintf("%s (version %d)", args[0], from),
+				ToFile:   fmt.Sprintf("%s (version %d)", args[0], to),
+				Context:  3,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Print(diff)
+			return nil
+		}),
+	}
+	diffPipeline.Flags().StringVar(&versions, "version", "", "The versions to compare, as '<from>..<to>', or '<from>' to compare with the current version (default: the previous version and the current version).")
+	diffPipeline.Flags().StringVarP(&output, "output", "o", "", "Format to compare the specs in: \"json\" or \"yaml\" (default \"json\")")
+	commands = append(commands, cmdutil.CreateAlias(diffPipeline, "diff pipeline"))
+
 	runPipeline := &cobra.Command{
 		Use:   "{{alias}} <pipeline> [<repo>@[<branch>|<commit>|<branch>=<commit>]...]",
 		Short: "Run an existing Pachyderm pipeline on the specified commits-branch pairs.",
@@ -1406,3 +1573,28 @@ func ParsePipelineStates(stateStrs []string) (string, error) {
 	}
 	return validateJQConditionString(strings.Join(conditions, " or "))
 }
+
+// parseVersionRange parses the argument to 'diff pipeline --version', which is
+// either '<from>..<to>' or '<from>' (in which case 'to' is the current version
+// of the pipeline). If 'versions' is empty, the previous and current versions
+// are returned.
+func parseVersionRange(versions string, current uint64) (from, to uint64, retErr error) {
+	if versions == "" {
+		if current <= 1 {
+			return 0, 0, errors.Errorf("pipeline only has one version")
+		}
+		return current - 1, current, nil
+	}
+	parts := strings.SplitN(versions, "..", 2)
+	from, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid version range %q, must be '<from>..<to>' or '<from>'", versions)
+	}
+	to = current
+	if len(parts) == 2 {
+		if to, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+			return 0, 0, errors.Errorf("invalid version range %q, must be '<from>..<to>' or '<from>'", versions)
+		}
+	}
+	return from, to, nil
+}
@@ -457,16 +457,14 @@ func TestYAMLError(t *testing.T) {
 	).Run())
 }
 
-// TODO: Make work with V2?
 func TestTFJobBasic(t *testing.T) {
-	t.Skip("TFJob not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
 	require.NoError(t, tu.BashCmd(`
 		yes | pachctl delete all
 		pachctl create repo input
-		( pachctl create pipeline -f - 2>&1 <<EOF || true
+		pachctl create pipeline -f - <<EOF
 		pipeline:
 		  name: first
 		input:
@@ -510,7 +508,7 @@ func TestTFJobBasic(t *testing.T) {
 		                - --batch_size=32
 		                - --training_steps=1000
 		EOF
-		) | match "not supported yet"
+		pachctl list pipeline | match first
 		`,
 	).Run())
 }
@@ -584,9 +582,7 @@ func TestYAMLTimestamp(t *testing.T) {
 	).Run())
 }
 
-// TODO: Make work with V2?
 func TestEditPipeline(t *testing.T) {
-	t.Skip("Edit pipeline not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -617,6 +613,59 @@ func TestEditPipeline(t *testing.T) {
 		`).Run())
 }
 
+func TestDiffPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	require.NoError(t, tu.BashCmd(`
+		yes | pachctl delete all
+	`).Run())
+	require.NoError(t, tu.BashCmd(`
+		pachctl create repo data
+		pachctl create pipeline <<EOF
+		  pipeline:
+		    name: my-pipeline
+		  input:
+		    pfs:
+		      glob: /*
+		      repo: data
+		  transform:
+		    cmd: [ /bin/bash ]
+		    stdin:
+		      - "cp /pfs/data/* /pfs/out"
+		EOF
+		`).Run())
+	// A pipeline with a single version has nothing to compare
+	require.YesError(t, tu.BashCmd(`pachctl diff pipeline my-pipeline`).Run())
+	require.NoError(t, tu.BashCmd(`
+		pachctl update pipeline <<EOF
+		  pipeline:
+		    name: my-pipeline
+		  input:
+		    pfs:
+		      glob: /
+		      repo: data
+		  transform:
+		    cmd: [ /bin/bash ]
+		    stdin:
+		      - "cp -r /pfs/data/* /pfs/out"
+		EOF
+		`).Run())
+	require.NoError(t, tu.BashCmd(`
+		pachctl diff pipeline my-pipeline -o yaml \
+		| match -- '--- my-pipeline \(version 1\)' \
+		| match '\+\+\+ my-pipeline \(version 2\)' \
+		| match -- '- *glob: /\*' \
+		| match '\+ *glob: /$' \
+		| match '\+.*cp -r /pfs/data/\* /pfs/out'
+		`).Run())
+	require.NoError(t, tu.BashCmd(`
+		pachctl diff pipeline my-pipeline --version 2..2 | match -v glob
+		`).Run())
+	require.YesError(t, tu.BashCmd(`pachctl diff pipeline my-pipeline --version 1..3`).Run())
+	require.YesError(t, tu.BashCmd(`pachctl diff pipeline my-pipeline --version a..b`).Run())
+}
+
 func TestPipelineBuildLifecyclePython(t *testing.T) {
 	t.Skip("not implemented in V2")
 	require.NoError(t, tu.BashCmd("yes | pachctl delete all").Run())
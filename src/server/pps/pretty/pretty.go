@@ -67,7 +67,7 @@ func PrintJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo, fullTimestamps bool)
 
 // PrintPipelineInfo pretty-prints pipeline info.
 func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo, fullTimestamps bool) {
-	if pipelineInfo.Transform == nil {
+	if pipelineInfo.Transform == nil && pipelineInfo.TFJob == nil {
 		fmt.Fprintf(w, "%s\t", pipelineInfo.Pipeline.Name)
 		fmt.Fprint(w, "-\t")
 		fmt.Fprint(w, "-\t")
@@ -313,6 +313,12 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 		PrintFile(tw, d.File)
 	}
 	tw.Flush()
+	if len(datumInfo.Logs) > 0 {
+		fmt.Fprintf(w, "Logs:\n")
+		for _, msg := range datumInfo.Logs {
+			fmt.Fprintf(w, "  %s\n", msg.Message)
+		}
+	}
 }
 
 // PrintSecretInfo pretty-prints secret info.
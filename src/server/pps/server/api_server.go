@@ -1,11 +1,15 @@
 package server
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -25,6 +29,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	ppath "github.com/pachyderm/pachyderm/src/server/pkg/path"
@@ -43,6 +48,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/worker/datum"
 	workerserver "github.com/pachyderm/pachyderm/src/server/worker/server"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/robfig/cron"
@@ -904,13 +910,29 @@ func (a *apiServer) ListJob(request *pps.ListJobRequest, resp pps.API_ListJobSer
 		a.Log(request, fmt.Sprintf("stream containing %d JobInfos", sent), retErr, time.Since(start))
 	}(time.Now())
 	pachClient := a.env.GetPachClient(resp.Context())
-	return a.listJob(pachClient, request.Pipeline, request.OutputCommit, request.InputCommit, request.History, request.Full, request.JqFilter, func(ji *pps.JobInfo) error {
+	// Jobs are listed from newest to oldest, so the jobs up to (and including)
+	// the cursor are skipped.
+	skipping := request.StartAfter != ""
+	if err := a.listJob(pachClient, request.Pipeline, request.OutputCommit, request.InputCommit, request.History, request.Full, request.JqFilter, func(ji *pps.JobInfo) error {
+		if skipping {
+			skipping = ji.Job.ID != request.StartAfter
+			return nil
+		}
 		if err := resp.Send(ji); err != nil {
 			return err
 		}
 		sent++
+		if request.PageSize > 0 && int64(sent) >= request.PageSize {
+			return errutil.ErrBreak
+		}
 		return nil
-	})
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return err
+	}
+	if skipping {
+		return errors.Errorf("job %s not found", request.StartAfter)
+	}
+	return nil
 }
 
 // FlushJob implements the protobuf pps.FlushJob RPC
@@ -1043,37 +1065,171 @@ func (a *apiServer) InspectDatum(ctx context.Context, request *pps.InspectDatumR
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	// TODO: Auth?
-	if err := a.collectDatums(ctx, request.Datum.Job, func(meta *datum.Meta, pfsState *pfs.File) error {
-		if common.DatumID(meta.Inputs) == request.Datum.ID {
-			response = convertDatumMetaToInfo(meta)
-			response.PfsState = pfsState
+	if err := a.collectDatums(ctx, request.Datum.Job, func(di *pps.DatumInfo) error {
+		if di.Datum.ID == request.Datum.ID {
+			response = di
 		}
 		return nil
 	}); err != nil {
 		return nil, err
 	}
+	if response == nil {
+		return nil, errors.Errorf("datum %v not found in job %v", request.Datum.ID, request.Datum.Job.ID)
+	}
+	logs, err := a.getDatumLogs(a.env.GetPachClient(ctx), response.PfsState.Commit, request.Datum.ID)
+	if err != nil {
+		return nil, err
+	}
+	response.Logs = logs
 	return response, nil
 }
 
+// getDatumLogs reads the logs persisted for a datum in the meta commit.
+func (a *apiServer) getDatumLogs(pachClient *client.APIClient, metaCommit *pfs.Commit, datumID string) ([]*pps.LogMessage, error) {
+	buf := &bytes.Buffer{}
+	logFile := "/" + path.Join(datum.MetaPrefix, datumID, datum.LogFileName)
+	if err := pachClient.GetFile(metaCommit.Repo.Name, metaCommit.ID, logFile, buf); err != nil {
+		// The logs of a running job's datums are readable once it finishes.
+		if pfsServer.IsFileNotFoundErr(err) || pfsServer.IsCommitNotFinishedErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var logs []*pps.LogMessage
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		msg := &pps.LogMessage{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), msg); err != nil {
+			return nil, err
+		}
+		logs = append(logs, msg)
+	}
+	return logs, scanner.Err()
+}
+
 func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_ListDatumServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	// TODO: Auth?
-	return a.collectDatums(server.Context(), request.Job, func(meta *datum.Meta, _ *pfs.File) error {
-		return server.Send(convertDatumMetaToInfo(meta))
+	if request.PageSize == 0 && request.Page == 0 && request.StartAfter == "" {
+		return a.collectDatums(server.Context(), request.Job, func(di *pps.DatumInfo) error {
+			return server.Send(di)
+		})
+	}
+	return a.listDatumPage(server.Context(), request, func(total int64) error {
+		return server.SendHeader(metadata.Pairs(pps.DatumTotalHeader, strconv.FormatInt(total, 10)))
+	}, func(di *pps.DatumInfo) error {
+		return server.Send(di)
 	})
 }
 
-func convertDatumMetaToInfo(meta *datum.Meta) *pps.DatumInfo {
+// listDatumPage calls cb with the datums in the page of a job's datums that
+// is selected by request. The datums of a finished job are counted (and
+// selected) by globbing their meta files, which only reads the file set
+// index, so only the meta files in the page are read. totalCb is called with
+// the total number of datums in the job before cb is called.
+func (a *apiServer) listDatumPage(ctx context.Context, request *pps.ListDatumRequest, totalCb func(int64) error, cb func(*pps.DatumInfo) error) error {
+	skip := request.Page * request.PageSize
+	var total, selected int64
+	selectDatum := func(id string) bool {
+		total++
+		if request.StartAfter != "" && id <= request.StartAfter {
+			return false
+		}
+		if skip > 0 {
+			skip--
+			return false
+		}
+		if request.PageSize > 0 && selected >= request.PageSize {
+			return false
+		}
+		selected++
+		return true
+	}
+	checkPage := func() error {
+		if request.Page > 0 && selected == 0 {
+			return errors.Errorf("page %d is out of bounds, there are %d datums in job %v", request.Page, total, request.Job.ID)
+		}
+		return totalCb(total)
+	}
+	jobInfo, metaCommitInfo, err := a.inspectMetaCommit(ctx, request.Job)
+	if err != nil {
+		return err
+	}
+	if metaCommitInfo.Finished == nil {
+		// The datums of a running job aren't all in its meta commit yet, so
+		// the page is collected from all of them.
+		var dis []*pps.DatumInfo
+		if err := a.collectDatums(ctx, request.Job, func(di *pps.DatumInfo) error {
+			if selectDatum(di.Datum.ID) {
+				dis = append(dis, di)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := checkPage(); err != nil {
+			return err
+		}
+		for _, di := range dis {
+			if err := cb(di); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	metaCommit := metaCommitInfo.Commit
+	var metaFiles []string
+	if err := pachClient.GlobFile(metaCommit.Repo.Name, metaCommit.ID, path.Join("/", datum.MetaPrefix, "*", datum.MetaFileName), func(fi *pfs.FileInfo) error {
+		if selectDatum(path.Base(path.Dir(fi.File.Path))) {
+			metaFiles = append(metaFiles, fi.File.Path)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := checkPage(); err != nil {
+		return err
+	}
+	for _, metaFile := range metaFiles {
+		buf := &bytes.Buffer{}
+		if err := pachClient.GetFile(metaCommit.Repo.Name, metaCommit.ID, metaFile, buf); err != nil {
+			return err
+		}
+		meta := &datum.Meta{}
+		if err := jsonpb.Unmarshal(buf, meta); err != nil {
+			return err
+		}
+		if err := cb(convertDatumMetaToInfo(meta, jobInfo.Job, metaCommit)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertDatumMetaToInfo converts the meta of a datum in the meta commit of
+// job to a DatumInfo. Datums that were processed by a previous job were
+// skipped by job.
+func convertDatumMetaToInfo(meta *datum.Meta, job *pps.Job, metaCommit *pfs.Commit) *pps.DatumInfo {
+	datumID := common.DatumID(meta.Inputs)
 	di := &pps.DatumInfo{
 		Datum: &pps.Datum{
 			Job: &pps.Job{
-				ID: meta.JobID,
+				ID: job.ID,
 			},
-			ID: common.DatumID(meta.Inputs),
+			ID: datumID,
 		},
 		State: convertDatumState(meta.State),
 		Stats: meta.Stats,
+		// TODO: Potentially refactor into datum package (at least the path).
+		PfsState: &pfs.File{
+			Commit: metaCommit,
+			Path:   "/" + path.Join(datum.PFSPrefix, datumID),
+		},
+	}
+	if meta.JobID != job.ID {
+		di.State = pps.DatumState_SKIPPED
 	}
 	for _, input := range meta.Inputs {
 		di.Data = append(di.Data, input.FileInfo)
@@ -1093,383 +1249,366 @@ func convertDatumState(state datum.State) pps.DatumState {
 	}
 }
 
-func (a *apiServer) collectDatums(ctx context.Context, job *pps.Job, cb func(*datum.Meta, *pfs.File) error) error {
+// datumHasher hashes datums the same way that the workers of a pipeline do.
+type datumHasher struct {
+	name, salt string
+}
+
+func (h *datumHasher) Hash(inputs []*common.Input) string {
+	return common.HashDatum(h.name, h.salt, inputs)
+}
+
+// inspectMetaCommit returns the info of a job, and of its meta commit, which
+// holds the metadata of the datums that the job has processed.
+func (a *apiServer) inspectMetaCommit(ctx context.Context, job *pps.Job) (*pps.JobInfo, *pfs.CommitInfo, error) {
 	jobInfo, err := a.InspectJob(ctx, &pps.InspectJobRequest{
 		Job: &pps.Job{
 			ID: job.ID,
 		},
+		Full: true,
 	})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	if jobInfo.StatsCommit == nil {
-		return errors.Errorf("job not finished")
+		return nil, nil, errors.Errorf("job %v has no meta commit", job.ID)
+	}
+	metaCommitInfo, err := a.env.GetPachClient(ctx).InspectCommit(jobInfo.StatsCommit.Repo.Name, jobInfo.StatsCommit.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return jobInfo, metaCommitInfo, nil
+}
+
+// collectDatums calls cb with the info of each datum in a job. The datums of
+// a finished job are read from its meta commit. The datums of a running job
+// are the datums of its input merged with the datums in its (open) meta
+// commit, which includes the datums of the previous job. A datum that the job
+// hasn't processed yet is STARTING, unless the job will skip it because the
+// previous job processed it.
+func (a *apiServer) collectDatums(ctx context.Context, job *pps.Job, cb func(*pps.DatumInfo) error) error {
+	jobInfo, metaCommitInfo, err := a.inspectMetaCommit(ctx, job)
+	if err != nil {
+		return err
 	}
 	pachClient := a.env.GetPachClient(ctx)
-	fsi := datum.NewFileSetIterator(pachClient, jobInfo.StatsCommit.Repo.Name, jobInfo.StatsCommit.ID)
-	return fsi.Iterate(func(meta *datum.Meta) error {
-		// TODO: Potentially refactor into datum package (at least the path).
-		pfsState := &pfs.File{
-			Commit: jobInfo.StatsCommit,
-			Path:   "/" + path.Join(datum.PFSPrefix, common.DatumID(meta.Inputs)),
+	metaCommit := metaCommitInfo.Commit
+	if metaCommitInfo.Finished != nil {
+		metaDit := datum.NewFileSetIterator(pachClient, metaCommit.Repo.Name, metaCommit.ID)
+		return metaDit.Iterate(func(meta *datum.Meta) error {
+			return cb(convertDatumMetaToInfo(meta, jobInfo.Job, metaCommit))
+		})
+	}
+	dit, err := datum.NewIterator(pachClient, jobInfo.Input)
+	if err != nil {
+		return err
+	}
+	dit = datum.NewJobIterator(dit, jobInfo.Job.ID, &datumHasher{
+		name: jobInfo.Pipeline.Name,
+		salt: jobInfo.Salt,
+	})
+	// The meta commit of a running job is open, so the datums it has
+	// processed so far are read from it through the internal PFS method.
+	metaDit := datum.NewFileSetIteratorFromReader(func(glob string) (io.Reader, error) {
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(a.txnEnv.GetOpenCommitFile(pachClient, metaCommit, glob, pw))
+		}()
+		return pr, nil
+	})
+	return datum.Merge([]datum.Iterator{dit, metaDit}, func(metas []*datum.Meta) error {
+		if len(metas) == 1 {
+			// Datums of the previous job that aren't in this job are skipped.
+			if metas[0].JobID != jobInfo.Job.ID {
+				return nil
+			}
+			di := convertDatumMetaToInfo(metas[0], jobInfo.Job, metaCommit)
+			di.State = pps.DatumState_STARTING
+			return cb(di)
+		}
+		if metas[1].JobID == jobInfo.Job.ID {
+			return cb(convertDatumMetaToInfo(metas[1], jobInfo.Job, metaCommit))
+		}
+		di := convertDatumMetaToInfo(metas[0], jobInfo.Job, metaCommit)
+		di.State = pps.DatumState_STARTING
+		// This is the same check that the job chain uses to skip datums.
+		if metas[0].Hash == metas[1].Hash && metas[1].State == datum.State_PROCESSED {
+			di.State = pps.DatumState_SKIPPED
 		}
-		return cb(meta, pfsState)
+		return cb(di)
 	})
 }
 
-// TODO: Figure out logs for V2.
-// func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
-// 	if a.env.LokiLogging || request.UseLokiBackend {
-// 		return a.getLogsLoki(request, apiGetLogsServer)
-// 	}
-// 	func() { a.Log(request, nil, nil, 0) }()
-// 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
-// 	pachClient := a.env.GetPachClient(apiGetLogsServer.Context())
-// 	ctx := pachClient.Ctx() // pachClient will propagate auth info
-
-// 	// Authorize request and get list of pods containing logs we're interested in
-// 	// (based on pipeline and job filters)
-// 	var rcName, containerName string
-// 	if request.Pipeline == nil && request.Job == nil {
-// 		if len(request.DataFilters) > 0 || request.Datum != nil {
-// 			return errors.Errorf("must specify the Job or Pipeline that the datum is from to get logs for it")
-// 		}
-
-// 		// Users must be authenticated to access logs from master, but permissions don't matter
-// 		_, err := checkLoggedIn(pachClient)
-// 		if err != nil {
-// 			return err
-// 		}
-// 		containerName, rcName = "pachd", "pachd"
-// 	} else {
-// 		containerName = client.PPSWorkerUserContainerName
-
-// 		// 1) Lookup the PipelineInfo for this pipeline/job, for auth and to get the
-// 		// RC name
-// 		var pipelineInfo *pps.PipelineInfo
-// 		var statsCommit *pfs.Commit
-// 		var err error
-// 		if request.Pipeline != nil {
-// 			pipelineInfo, err = a.inspectPipeline(pachClient, request.Pipeline.Name)
-// 			if err != nil {
-// 				return errors.Wrapf(err, "could not get pipeline information for %s", request.Pipeline.Name)
-// 			}
-// 		} else if request.Job != nil {
-// 			// If user provides a job, lookup the pipeline from the job info, and then
-// 			// get the pipeline RC
-// 			var jobPtr pps.EtcdJobInfo
-// 			err = a.jobs.ReadOnly(ctx).Get(request.Job.ID, &jobPtr)
-// 			if err != nil {
-// 				return errors.Wrapf(err, "could not get job information for \"%s\"", request.Job.ID)
-// 			}
-// 			statsCommit = jobPtr.StatsCommit
-// 			pipelineInfo, err = a.inspectPipeline(pachClient, jobPtr.Pipeline.Name)
-// 			if err != nil {
-// 				return errors.Wrapf(err, "could not get pipeline information for %s", jobPtr.Pipeline.Name)
-// 			}
-// 		}
-
-// 		// 2) Check whether the caller is authorized to get logs from this pipeline/job
-// 		if err := a.authorizePipelineOp(pachClient, pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-// 			return err
-// 		}
-
-// 		// If the job had stats enabled, we use the logs from the stats
-// 		// commit since that's likely to yield better results.
-// 		if statsCommit != nil {
-// 			ci, err := pachClient.InspectCommit(statsCommit.Repo.Name, statsCommit.ID)
-// 			if err != nil {
-// 				return err
-// 			}
-// 			if ci.Finished != nil {
-// 				return a.getLogsFromStats(pachClient, request, apiGetLogsServer, statsCommit)
-// 			}
-// 		}
-
-// 		// 3) Get rcName for this pipeline
-// 		rcName = ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
-// 		if err != nil {
-// 			return err
-// 		}
-// 	}
-
-// 	// Get pods managed by the RC we're scraping (either pipeline or pachd)
-// 	pods, err := a.rcPods(rcName)
-// 	if err != nil {
-// 		return errors.Wrapf(err, "could not get pods in rc \"%s\" containing logs", rcName)
-// 	}
-// 	if len(pods) == 0 {
-// 		return errors.Errorf("no pods belonging to the rc \"%s\" were found", rcName)
-// 	}
-
-// 	// Spawn one goroutine per pod. Each goro writes its pod's logs to a channel
-// 	// and channels are read into the output server in a stable order.
-// 	// (sort the pods to make sure that the order of log lines is stable)
-// 	sort.Sort(podSlice(pods))
-// 	logCh := make(chan *pps.LogMessage)
-// 	var eg errgroup.Group
-// 	var mu sync.Mutex
-// 	eg.Go(func() error {
-// 		for _, pod := range pods {
-// 			pod := pod
-// 			if !request.Follow {
-// 				mu.Lock()
-// 			}
-// 			eg.Go(func() (retErr error) {
-// 				if !request.Follow {
-// 					defer mu.Unlock()
-// 				}
-// 				tailLines := &request.Tail
-// 				if *tailLines <= 0 {
-// 					tailLines = nil
-// 				}
-// 				// Get full set of logs from pod i
-// 				stream, err := a.env.GetKubeClient().CoreV1().Pods(a.namespace).GetLogs(
-// 					pod.ObjectMeta.Name, &v1.PodLogOptions{
-// 						Container: containerName,
-// 						Follow:    request.Follow,
-// 						TailLines: tailLines,
-// 					}).Timeout(10 * time.Second).Stream()
-// 				if err != nil {
-// 					return err
-// 				}
-// 				defer func() {
-// 					if err := stream.Close(); err != nil && retErr == nil {
-// 						retErr = err
-// 					}
-// 				}()
-
-// 				// Parse pods' log lines, and filter out irrelevant ones
-// 				scanner := bufio.NewScanner(stream)
-// 				for scanner.Scan() {
-// 					msg := new(pps.LogMessage)
-// 					if containerName == "pachd" {
-// 						msg.Message = scanner.Text()
-// 					} else {
-// 						logBytes := scanner.Bytes()
-// 						if err := jsonpb.Unmarshal(bytes.NewReader(logBytes), msg); err != nil {
-// 							continue
-// 						}
-
-// 						// Filter out log lines that don't match on pipeline or job
-// 						if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
-// 							continue
-// 						}
-// 						if request.Job != nil && request.Job.ID != msg.JobID {
-// 							continue
-// 						}
-// 						if request.Datum != nil && request.Datum.ID != msg.DatumID {
-// 							continue
-// 						}
-// 						if request.Master != msg.Master {
-// 							continue
-// 						}
-// 						if !workercommon.MatchDatum(request.DataFilters, msg.Data) {
-// 							continue
-// 						}
-// 					}
-// 					msg.Message = strings.TrimSuffix(msg.Message, "\n")
-
-// 					// Log message passes all filters -- return it
-// 					select {
-// 					case logCh <- msg:
-// 					case <-ctx.Done():
-// 						return nil
-// 					}
-// 				}
-// 				return nil
-// 			})
-// 		}
-// 		return nil
-// 	})
-// 	var egErr error
-// 	go func() {
-// 		egErr = eg.Wait()
-// 		close(logCh)
-// 	}()
-
-// 	for msg := range logCh {
-// 		if err := apiGetLogsServer.Send(msg); err != nil {
-// 			return err
-// 		}
-// 	}
-// 	return egErr
-// }
-
-//func (a *apiServer) getLogsFromStats(pachClient *client.APIClient, request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer, statsCommit *pfs.Commit) error {
-//	pfsClient := pachClient.PfsAPIClient
-//	fs, err := pfsClient.GlobFileStream(pachClient.Ctx(), &pfs.GlobFileRequest{
-//		Commit:  statsCommit,
-//		Pattern: "*/logs", // this is the path where logs reside
-//	})
-//	if err != nil {
-//		return grpcutil.ScrubGRPC(err)
-//	}
-//
-//	limiter := limit.New(20)
-//	var eg errgroup.Group
-//	var mu sync.Mutex
-//	for {
-//		fileInfo, err := fs.Recv()
-//		if errors.Is(err, io.EOF) {
-//			break
-//		}
-//		eg.Go(func() error {
-//			if err != nil {
-//				return err
-//			}
-//			limiter.Acquire()
-//			defer limiter.Release()
-//			var buf bytes.Buffer
-//			if err := pachClient.GetFile(fileInfo.File.Commit.Repo.Name, fileInfo.File.Commit.ID, fileInfo.File.Path, 0, 0, &buf); err != nil {
-//				return err
-//			}
-//			// Parse pods' log lines, and filter out irrelevant ones
-//			scanner := bufio.NewScanner(&buf)
-//			for scanner.Scan() {
-//				logBytes := scanner.Bytes()
-//				msg := new(pps.LogMessage)
-//				if err := jsonpb.Unmarshal(bytes.NewReader(logBytes), msg); err != nil {
-//					continue
-//				}
-//				if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
-//					continue
-//				}
-//				if request.Job != nil && request.Job.ID != msg.JobID {
-//					continue
-//				}
-//				if request.Datum != nil && request.Datum.ID != msg.DatumID {
-//					continue
-//				}
-//				if request.Master != msg.Master {
-//					continue
-//				}
-//				if !workercommon.MatchDatum(request.DataFilters, msg.Data) {
-//					continue
-//				}
-//
-//				mu.Lock()
-//				if err := apiGetLogsServer.Send(msg); err != nil {
-//					mu.Unlock()
-//					return err
-//				}
-//				mu.Unlock()
-//			}
-//			return nil
-//		})
-//	}
-//	return eg.Wait()
-//}
-//
-//func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
-//	func() { a.Log(request, nil, nil, 0) }()
-//	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
-//	pachClient := a.env.GetPachClient(apiGetLogsServer.Context())
-//	ctx := pachClient.Ctx() // pachClient will propagate auth info
-//
-//	// Authorize request and get list of pods containing logs we're interested in
-//	// (based on pipeline and job filters)
-//	loki, err := a.env.GetLokiClient()
-//	if err != nil {
-//		return err
-//	}
-//	if request.Pipeline == nil && request.Job == nil {
-//		if len(request.DataFilters) > 0 || request.Datum != nil {
-//			return errors.Errorf("must specify the Job or Pipeline that the datum is from to get logs for it")
-//		}
-//		// no authorization is done to get logs from master
-//		return lokiutil.QueryRange(loki, `{app="pachd"}`, time.Time{}, time.Now(), func(t time.Time, line string) error {
-//			return apiGetLogsServer.Send(&pps.LogMessage{
-//				Message: strings.TrimSuffix(line, "\n"),
-//			})
-//		})
-//	}
-//
-//	// 1) Lookup the PipelineInfo for this pipeline/job, for auth and to get the
-//	// RC name
-//	var pipelineInfo *pps.PipelineInfo
-//	if request.Pipeline != nil {
-//		pipelineInfo, err = a.inspectPipeline(pachClient, request.Pipeline.Name)
-//		if err != nil {
-//			return errors.Wrapf(err, "could not get pipeline information for %s", request.Pipeline.Name)
-//		}
-//	} else if request.Job != nil {
-//		// If user provides a job, lookup the pipeline from the job info, and then
-//		// get the pipeline RC
-//		var jobPtr pps.EtcdJobInfo
-//		err = a.jobs.ReadOnly(ctx).Get(request.Job.ID, &jobPtr)
-//		if err != nil {
-//			return errors.Wrapf(err, "could not get job information for \"%s\"", request.Job.ID)
-//		}
-//		pipelineInfo, err = a.inspectPipeline(pachClient, jobPtr.Pipeline.Name)
-//		if err != nil {
-//			return errors.Wrapf(err, "could not get pipeline information for %s", jobPtr.Pipeline.Name)
-//		}
-//	}
-//
-//	// 2) Check whether the caller is authorized to get logs from this pipeline/job
-//	if err := a.authorizePipelineOp(pachClient, pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-//		return err
-//	}
-//	query := fmt.Sprintf(`{pipelineName=%q, container="user"}`, pipelineInfo.Pipeline.Name)
-//	if request.Master {
-//		query += contains("master")
-//	}
-//	if request.Job != nil {
-//		query += contains(request.Job.ID)
-//	}
-//	if request.Datum != nil {
-//		query += contains(request.Datum.ID)
-//	}
-//	for _, filter := range request.DataFilters {
-//		query += contains(filter)
-//	}
-//	return lokiutil.QueryRange(loki, query, time.Time{}, time.Now(), func(t time.Time, line string) error {
-//		msg := &pps.LogMessage{}
-//		// These filters are almost always unnecessary because we apply
-//		// them in the Loki request, but many of them are just done with
-//		// string matching so there technically could be some false
-//		// positive matches (although it's pretty unlikely), checking here
-//		// just makes sure we don't accidentally intersperse unrelated log
-//		// messages.
-//		if err := jsonpb.Unmarshal(strings.NewReader(line), msg); err != nil {
-//			return nil
-//		}
-//		if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
-//			return nil
-//		}
-//		if request.Job != nil && request.Job.ID != msg.JobID {
-//			return nil
-//		}
-//		if request.Datum != nil && request.Datum.ID != msg.DatumID {
-//			return nil
-//		}
-//		if request.Master != msg.Master {
-//			return nil
-//		}
-//		if !workercommon.MatchDatum(request.DataFilters, msg.Data) {
-//			return nil
-//		}
-//		msg.Message = strings.TrimSuffix(msg.Message, "\n")
-//		return apiGetLogsServer.Send(msg)
-//	})
-//}
-//
-//func contains(s string) string {
-//	return fmt.Sprintf(" |= %q", s)
-//}
-//
-//type podSlice []v1.Pod
-//
-//func (s podSlice) Len() int {
-//	return len(s)
-//}
-//func (s podSlice) Swap(i, j int) {
-//	s[i], s[j] = s[j], s[i]
-//}
-//func (s podSlice) Less(i, j int) bool {
-//	return s[i].ObjectMeta.Name < s[j].ObjectMeta.Name
-//}
+func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
+	if a.env.LokiLogging || request.UseLokiBackend {
+		return a.getLogsLoki(request, apiGetLogsServer)
+	}
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(apiGetLogsServer.Context())
+	ctx := pachClient.Ctx() // pachClient will propagate auth info
+
+	// Authorize request and get list of pods containing logs we're interested in
+	// (based on pipeline and job filters)
+	var rcName, containerName string
+	if request.Pipeline == nil && request.Job == nil {
+		if len(request.DataFilters) > 0 || request.Datum != nil {
+			return errors.Errorf("must specify the Job or Pipeline that the datum is from to get logs for it")
+		}
+
+		// Users must be authenticated to access logs from master, but permissions don't matter
+		_, err := checkLoggedIn(pachClient)
+		if err != nil {
+			return err
+		}
+		containerName, rcName = "pachd", "pachd"
+	} else {
+		containerName = client.PPSWorkerUserContainerName
+
+		// 1) Lookup the PipelineInfo for this pipeline/job, for auth and to get the
+		// RC name
+		var pipelineInfo *pps.PipelineInfo
+		var err error
+		if request.Pipeline != nil {
+			pipelineInfo, err = a.inspectPipeline(pachClient, request.Pipeline.Name)
+			if err != nil {
+				return errors.Wrapf(err, "could not get pipeline information for %s", request.Pipeline.Name)
+			}
+		} else if request.Job != nil {
+			// If user provides a job, lookup the pipeline from the job info, and then
+			// get the pipeline RC
+			var jobPtr pps.EtcdJobInfo
+			err = a.jobs.ReadOnly(ctx).Get(request.Job.ID, &jobPtr)
+			if err != nil {
+				return errors.Wrapf(err, "could not get job information for \"%s\"", request.Job.ID)
+			}
+			pipelineInfo, err = a.inspectPipeline(pachClient, jobPtr.Pipeline.Name)
+			if err != nil {
+				return errors.Wrapf(err, "could not get pipeline information for %s", jobPtr.Pipeline.Name)
+			}
+		}
+
+		// 2) Check whether the caller is authorized to get logs from this pipeline/job
+		if err := a.authorizePipelineOp(pachClient, pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+			return err
+		}
+
+		// 3) Get rcName for this pipeline
+		rcName = ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	}
+
+	// Get pods managed by the RC we're scraping (either pipeline or pachd)
+	pods, err := a.rcPods(rcName)
+	if err != nil {
+		return errors.Wrapf(err, "could not get pods in rc \"%s\" containing logs", rcName)
+	}
+	if len(pods) == 0 {
+		return errors.Errorf("no pods belonging to the rc \"%s\" were found", rcName)
+	}
+
+	// Spawn one goroutine per pod. Each goro writes its pod's logs to a channel
+	// and channels are read into the output server in a stable order.
+	// (sort the pods to make sure that the order of log lines is stable)
+	sort.Sort(podSlice(pods))
+	logCh := make(chan *pps.LogMessage)
+	var eg errgroup.Group
+	var mu sync.Mutex
+	eg.Go(func() error {
+		for _, pod := range pods {
+			pod := pod
+			if !request.Follow {
+				mu.Lock()
+			}
+			eg.Go(func() (retErr error) {
+				if !request.Follow {
+					defer mu.Unlock()
+				}
+				tailLines := &request.Tail
+				if *tailLines <= 0 {
+					tailLines = nil
+				}
+				// Get full set of logs from pod i
+				stream, err := a.env.GetKubeClient().CoreV1().Pods(a.namespace).GetLogs(
+					pod.ObjectMeta.Name, &v1.PodLogOptions{
+						Container: containerName,
+						Follow:    request.Follow,
+						TailLines: tailLines,
+					}).Timeout(10 * time.Second).Stream()
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := stream.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+
+				// Parse pods' log lines, and filter out irrelevant ones
+				scanner := bufio.NewScanner(stream)
+				for scanner.Scan() {
+					msg := new(pps.LogMessage)
+					if containerName == "pachd" {
+						msg.Message = scanner.Text()
+					} else {
+						logBytes := scanner.Bytes()
+						if err := jsonpb.Unmarshal(bytes.NewReader(logBytes), msg); err != nil {
+							continue
+						}
+
+						// Filter out log lines that don't match on pipeline or job
+						if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
+							continue
+						}
+						if request.Job != nil && request.Job.ID != msg.JobID {
+							continue
+						}
+						if request.Datum != nil && request.Datum.ID != msg.DatumID {
+							continue
+						}
+						if request.Master != msg.Master {
+							continue
+						}
+						if !common.MatchDatum(request.DataFilters, msg.Data) {
+							continue
+						}
+					}
+					msg.Message = strings.TrimSuffix(msg.Message, "\n")
+
+					// Log message passes all filters -- return it
+					select {
+					case logCh <- msg:
+					case <-ctx.Done():
+						return nil
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	var egErr error
+	go func() {
+		egErr = eg.Wait()
+		close(logCh)
+	}()
+
+	for msg := range logCh {
+		if err := apiGetLogsServer.Send(msg); err != nil {
+			return err
+		}
+	}
+	return egErr
+}
+
+func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(apiGetLogsServer.Context())
+	ctx := pachClient.Ctx() // pachClient will propagate auth info
+
+	// Authorize request and get list of pods containing logs we're interested in
+	// (based on pipeline and job filters)
+	loki, err := a.env.GetLokiClient()
+	if err != nil {
+		return err
+	}
+	if request.Pipeline == nil && request.Job == nil {
+		if len(request.DataFilters) > 0 || request.Datum != nil {
+			return errors.Errorf("must specify the Job or Pipeline that the datum is from to get logs for it")
+		}
+		// no authorization is done to get logs from master
+		return queryLoki(ctx, loki, `{app="pachd"}`, request.Tail, request.Follow, lokiFollowInterval, func(line string) *pps.LogMessage {
+			return &pps.LogMessage{
+				Message: strings.TrimSuffix(line, "\n"),
+			}
+		}, apiGetLogsServer.Send)
+	}
+
+	// 1) Lookup the PipelineInfo for this pipeline/job, for auth and to get the
+	// RC name
+	var pipelineInfo *pps.PipelineInfo
+	if request.Pipeline != nil {
+		pipelineInfo, err = a.inspectPipeline(pachClient, request.Pipeline.Name)
+		if err != nil {
+			return errors.Wrapf(err, "could not get pipeline information for %s", request.Pipeline.Name)
+		}
+	} else if request.Job != nil {
+		// If user provides a job, lookup the pipeline from the job info, and then
+		// get the pipeline RC
+		var jobPtr pps.EtcdJobInfo
+		err = a.jobs.ReadOnly(ctx).Get(request.Job.ID, &jobPtr)
+		if err != nil {
+			return errors.Wrapf(err, "could not get job information for \"%s\"", request.Job.ID)
+		}
+		pipelineInfo, err = a.inspectPipeline(pachClient, jobPtr.Pipeline.Name)
+		if err != nil {
+			return errors.Wrapf(err, "could not get pipeline information for %s", jobPtr.Pipeline.Name)
+		}
+	}
+
+	// 2) Check whether the caller is authorized to get logs from this pipeline/job
+	if err := a.authorizePipelineOp(pachClient, pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`{pipelineName=%q, container="user"}`, pipelineInfo.Pipeline.Name)
+	if request.Master {
+		query += contains("master")
+	}
+	if request.Job != nil {
+		query += contains(request.Job.ID)
+	}
+	if request.Datum != nil {
+		query += contains(request.Datum.ID)
+	}
+	for _, filter := range request.DataFilters {
+		query += contains(filter)
+	}
+	return queryLoki(ctx, loki, query, request.Tail, request.Follow, lokiFollowInterval, func(line string) *pps.LogMessage {
+		msg := &pps.LogMessage{}
+		// These filters are almost always unnecessary because we apply
+		// them in the Loki request, but many of them are just done with
+		// string matching so there technically could be some false
+		// positive matches (although it's pretty unlikely), checking here
+		// just makes sure we don't accidentally intersperse unrelated log
+		// messages.
+		if err := jsonpb.Unmarshal(strings.NewReader(line), msg); err != nil {
+			return nil
+		}
+		if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
+			return nil
+		}
+		if request.Job != nil && request.Job.ID != msg.JobID {
+			return nil
+		}
+		if request.Datum != nil && request.Datum.ID != msg.DatumID {
+			return nil
+		}
+		if request.Master != msg.Master {
+			return nil
+		}
+		if !common.MatchDatum(request.DataFilters, msg.Data) {
+			return nil
+		}
+		msg.Message = strings.TrimSuffix(msg.Message, "\n")
+		return msg
+	}, apiGetLogsServer.Send)
+}
+
+func contains(s string) string {
+	return fmt.Sprintf(" |= %q", s)
+}
+
+type podSlice []v1.Pod
+
+func (s podSlice) Len() int {
+	return len(s)
+}
+func (s podSlice) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+func (s podSlice) Less(i, j int) bool {
+	return s[i].ObjectMeta.Name < s[j].ObjectMeta.Name
+}
 
 func now() *types.Timestamp {
 	t, err := types.TimestampProto(time.Now())
@@ -1499,10 +1638,11 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 		return errors.Errorf("pipeline name is %d characters long, but must have at most 63: %q",
 			len(request.Pipeline.Name), request.Pipeline.Name)
 	}
-	// TODO(msteffen) eventually TFJob and Transform will be alternatives, but
-	// currently TFJob isn't supported
-	if request.TFJob != nil {
-		return errors.New("embedding TFJobs in pipelines is not supported yet")
+	if request.TFJob != nil && request.Transform != nil {
+		return errors.New("pipeline may specify a transform or a TFJob, but not both")
+	}
+	if request.TFJob != nil && (request.Service != nil || request.Spout != nil) {
+		return errors.New("TFJobs are not supported in spouts or services")
 	}
 	if request.S3Out && ((request.Service != nil) || (request.Spout != nil)) {
 		return errors.New("s3 output is not supported in spouts or services")
@@ -1510,44 +1650,22 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.S3Out && request.EnableStats {
 		return errors.New("stats are not supported for pipelines that output via Pachyderm's S3 gateway")
 	}
-	if request.Transform == nil {
-		return errors.Errorf("pipeline must specify a transform")
+	if request.Transform == nil && request.TFJob == nil {
+		return errors.Errorf("pipeline must specify a transform or a TFJob")
 	}
 	return nil
 }
 
 // TODO: Implement the appropriate features.
 func (a *apiServer) validateV2Features(request *pps.CreatePipelineRequest) (*pps.CreatePipelineRequest, error) {
-	if request.TFJob != nil {
-		return nil, errors.Errorf("TFJob not implemented")
-	}
-	if request.Egress != nil {
-		return nil, errors.Errorf("Egress not implemented")
-	}
+	// S3 output needs a per-job S3 gateway in the worker sidecar that exposes
+	// the job's output commit, which V2 workers don't run yet.
 	if request.S3Out {
-		return nil, errors.Errorf("S3Out not implemented")
+		return nil, errors.Errorf("S3Out is not supported yet, as workers do not run an S3 gateway for the output commit")
 	}
-	var err error
-	pps.VisitInput(request.Input, func(input *pps.Input) {
-		if input.Join != nil {
-			err = errors.Errorf("Join input not implemented")
-		}
-	})
-	if err != nil {
-		return nil, err
-	}
-	if request.CacheSize != "" {
-		return nil, errors.Errorf("CacheSize not implemented")
-	}
-	request.EnableStats = true
-	if request.MaxQueueSize != 0 {
-		return nil, errors.Errorf("MaxQueueSize not implemented")
-	}
-	if request.Service != nil {
-		return nil, errors.Errorf("Service not implemented")
-	}
-	if request.Spout != nil {
-		return nil, errors.Errorf("Spout not implemented")
+	// Spouts are not allowed to have a stats branch.
+	if request.Spout == nil {
+		request.EnableStats = true
 	}
 	return request, nil
 }
@@ -1570,7 +1688,11 @@ func (a *apiServer) validatePipelineInTransaction(txnCtx *txnenv.TransactionCont
 		return errors.Errorf("pipeline name is %d characters long, but must have at most 63: %q",
 			len(pipelineInfo.Pipeline.Name), pipelineInfo.Pipeline.Name)
 	}
-	if err := validateTransform(pipelineInfo.Transform); err != nil {
+	if pipelineInfo.TFJob != nil {
+		if err := validateTFJob(pipelineInfo.TFJob); err != nil {
+			return errors.Wrapf(err, "invalid TFJob")
+		}
+	} else if err := validateTransform(pipelineInfo.Transform); err != nil {
 		return errors.Wrapf(err, "invalid transform")
 	}
 	if err := a.validateInputInTransaction(txnCtx, pipelineInfo.Pipeline.Name, pipelineInfo.Input); err != nil {
@@ -1592,8 +1714,10 @@ func (a *apiServer) validatePipelineInTransaction(txnCtx *txnenv.TransactionCont
 	if pipelineInfo.OutputBranch == "" {
 		return errors.New("pipeline needs to specify an output branch")
 	}
-	if _, err := resource.ParseQuantity(pipelineInfo.CacheSize); err != nil {
-		return errors.Wrapf(err, "could not parse cacheSize '%s'", pipelineInfo.CacheSize)
+	if pipelineInfo.CacheSize != "" {
+		if _, err := resource.ParseQuantity(pipelineInfo.CacheSize); err != nil {
+			return errors.Wrapf(err, "could not parse cacheSize '%s'", pipelineInfo.CacheSize)
+		}
 	}
 	if pipelineInfo.JobTimeout != nil {
 		_, err := types.DurationFromProto(pipelineInfo.JobTimeout)
@@ -2442,7 +2566,7 @@ func (a *apiServer) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContex
 
 // setPipelineDefaults sets the default values for a pipeline info
 func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
-	if pipelineInfo.Transform.Image == "" {
+	if pipelineInfo.Transform != nil && pipelineInfo.Transform.Image == "" {
 		pipelineInfo.Transform.Image = DefaultUserImage
 	}
 	setInputDefaults(pipelineInfo.Pipeline.Name, pipelineInfo.Input)
@@ -2450,9 +2574,6 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
 		// Output branches default to master
 		pipelineInfo.OutputBranch = "master"
 	}
-	if pipelineInfo.CacheSize == "" {
-		pipelineInfo.CacheSize = "64M"
-	}
 	if pipelineInfo.MaxQueueSize < 1 {
 		pipelineInfo.MaxQueueSize = 1
 	}
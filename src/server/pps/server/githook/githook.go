@@ -174,7 +174,7 @@ func (s *gitHookServer) handlePush(pl github.PushPayload) (retErr error) {
 		}
 		triggeredRepos[input.Name] = true
 	}
-	return nil
+	return retErr
 }
 
 func (s *gitHookServer) commitPayload(repoName string, branchName string, rawPayload []byte) (retErr error) {
@@ -191,11 +191,6 @@ func (s *gitHookServer) commitPayload(repoName string, branchName string, rawPay
 		}
 		retErr = s.client.FinishCommit(repoName, commit.ID)
 	}()
-	if err = s.client.DeleteFile(repoName, commit.ID, "commit.json"); err != nil {
-		return err
-	}
-	if err = s.client.PutFile(repoName, commit.ID, "commit.json", bytes.NewReader(rawPayload)); err != nil {
-		return err
-	}
-	return nil
+	// Each push replaces the payload from the previous push.
+	return s.client.PutFileOverwrite(repoName, commit.ID, "commit.json", bytes.NewReader(rawPayload))
 }
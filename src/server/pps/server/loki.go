@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	loki "github.com/grafana/loki/pkg/logcli/client"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/lokiutil"
+)
+
+// lokiFollowInterval is how often loki is polled for new log lines when logs
+// are followed.
+const lokiFollowInterval = 2 * time.Second
+
+// queryLoki calls send with the log messages that match query. parse converts
+// a log line into a log message, or returns nil to skip the line. If tail is
+// positive, only the last tail messages are sent. If follow is set, loki is
+// then polled every interval for new messages until ctx is done.
+func queryLoki(ctx context.Context, lokiClient *loki.Client, query string, tail int64, follow bool, interval time.Duration, parse func(string) *pps.LogMessage, send func(*pps.LogMessage) error) error {
+	var tailMsgs []*pps.LogMessage
+	// from is the start of the next query, which is past the last line seen.
+	var from time.Time
+	cb := func(t time.Time, line string) error {
+		if !t.Before(from) {
+			from = t.Add(time.Nanosecond)
+		}
+		msg := parse(line)
+		if msg == nil {
+			return nil
+		}
+		if tail > 0 {
+			tailMsgs = append(tailMsgs, msg)
+			if int64(len(tailMsgs)) > tail {
+				tailMsgs = tailMsgs[1:]
+			}
+			return nil
+		}
+		return send(msg)
+	}
+	through := time.Now()
+	if err := lokiutil.QueryRange(lokiClient, query, time.Time{}, through, cb); err != nil {
+		return err
+	}
+	for _, msg := range tailMsgs {
+		if err := send(msg); err != nil {
+			return err
+		}
+	}
+	if !follow {
+		return nil
+	}
+	// New messages are sent as they're found.
+	tail = 0
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		// The lines before through have already been queried.
+		if from.Before(through) {
+			from = through
+		}
+		through = time.Now()
+		if err := lokiutil.QueryRange(lokiClient, query, from, through, cb); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	loki "github.com/grafana/loki/pkg/logcli/client"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// fakeLoki serves the loki query_range API for a set of log lines.
+type fakeLoki struct {
+	mu    sync.Mutex
+	times []time.Time
+	lines []string
+}
+
+func (fl *fakeLoki) add(line string) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	fl.times = append(fl.times, time.Now())
+	fl.lines = append(fl.lines, line)
+}
+
+func (fl *fakeLoki) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	start, _ := strconv.ParseInt(r.URL.Query().Get("start"), 10, 64)
+	end, _ := strconv.ParseInt(r.URL.Query().Get("end"), 10, 64)
+	values := [][]string{}
+	for i, t := range fl.times {
+		if t.UnixNano() >= start && t.UnixNano() < end {
+			values = append(values, []string{strconv.FormatInt(t.UnixNano(), 10), fl.lines[i]})
+		}
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"resultType": "streams",
+			"result": []interface{}{
+				map[string]interface{}{
+					"stream": map[string]string{"app": "pachd"},
+					"values": values,
+				},
+			},
+		},
+	})
+}
+
+func parseLokiLine(line string) *pps.LogMessage {
+	return &pps.LogMessage{Message: line}
+}
+
+func TestQueryLokiTail(t *testing.T) {
+	fl := &fakeLoki{}
+	for i := 0; i < 10; i++ {
+		fl.add(fmt.Sprintf("line %d", i))
+	}
+	server := httptest.NewServer(fl)
+	defer server.Close()
+	lokiClient := &loki.Client{Address: server.URL}
+	var msgs []string
+	send := func(msg *pps.LogMessage) error {
+		msgs = append(msgs, msg.Message)
+		return nil
+	}
+	// Without tail, all the lines are sent.
+	require.NoError(t, queryLoki(context.Background(), lokiClient, `{app="pachd"}`, 0, false, lokiFollowInterval, parseLokiLine, send))
+	require.Equal(t, 10, len(msgs))
+	// With tail, only the last lines are sent, in order.
+	msgs = nil
+	require.NoError(t, queryLoki(context.Background(), lokiClient, `{app="pachd"}`, 3, false, lokiFollowInterval, parseLokiLine, send))
+	require.Equal(t, []string{"line 7", "line 8", "line 9"}, msgs)
+	// Skipped lines don't count towards the tail.
+	msgs = nil
+	require.NoError(t, queryLoki(context.Background(), lokiClient, `{app="pachd"}`, 2, false, lokiFollowInterval, func(line string) *pps.LogMessage {
+		if line == "line 9" {
+			return nil
+		}
+		return parseLokiLine(line)
+	}, send))
+	require.Equal(t, []string{"line 7", "line 8"}, msgs)
+}
+
+func TestQueryLokiFollow(t *testing.T) {
+	fl := &fakeLoki{}
+	fl.add("line 0")
+	fl.add("line 1")
+	server := httptest.NewServer(fl)
+	defer server.Close()
+	lokiClient := &loki.Client{Address: server.URL}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgs := make(chan string)
+	done := make(chan error)
+	go func() {
+		done <- queryLoki(ctx, lokiClient, `{app="pachd"}`, 1, true, 10*time.Millisecond, parseLokiLine, func(msg *pps.LogMessage) error {
+			select {
+			case msgs <- msg.Message:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	next := func() string {
+		select {
+		case msg := <-msgs:
+			return msg
+		case <-time.After(time.Minute):
+			t.Fatal("timed out waiting for a log message")
+			return ""
+		}
+	}
+	// The tail of the existing lines is sent first, then new lines are sent
+	// as they're written.
+	require.Equal(t, "line 1", next())
+	fl.add("line 2")
+	require.Equal(t, "line 2", next())
+	fl.add("line 3")
+	fl.add("line 4")
+	require.Equal(t, "line 3", next())
+	require.Equal(t, "line 4", next())
+	// Following stops when the context is canceled.
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -205,6 +205,13 @@ func (m *ppsMaster) deletePipelineResources(pipelineName string) (retErr error)
 		}
 	}
 
+	// Delete any TFJobs associated with op.pipeline. The pipeline's spec may
+	// no longer exist, so this runs for every pipeline (deleteAll is a no-op
+	// in clusters without Kubeflow).
+	if err := m.a.tfJobOperator().deleteAll(pipelineName); err != nil {
+		return err
+	}
+
 	// Finally, delete op.pipeline's RC, which will cause pollPipelines to stop
 	// polling it.
 	rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(metav1.ListOptions{LabelSelector: selector})
@@ -194,6 +194,14 @@ func (m *ppsMaster) monitorPipeline(pachClient *client.APIClient, pipelineInfo *
 			})
 		}
 	})
+	if pipelineInfo.TFJob != nil {
+		eg.Go(func() error {
+			return backoff.RetryNotify(func() error {
+				return m.runTFJobs(pachClient, pipelineInfo)
+			}, backoff.NewInfiniteBackOff(),
+				backoff.NotifyCtx(pachClient.Ctx(), "runTFJobs for "+pipeline))
+		})
+	}
 	if pipelineInfo.Standby {
 		// Capacity 1 gives us a bit of buffer so we don't needlessly go into
 		// standby when SubscribeCommit takes too long to return.
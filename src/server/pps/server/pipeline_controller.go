@@ -99,6 +99,10 @@ func (m *ppsMaster) step(pipeline string, keyVer, keyRev int64) (retErr error) {
 		return m.a.setPipelineFailure(opCtx, pipeline,
 			fmt.Sprintf("couldn't initialize pipeline op: %v", err))
 	}
+	if op.pipelineInfo.TFJob != nil {
+		// TFJob pipelines have no RC
+		return op.runTFJobPipeline()
+	}
 	// set op.rc
 	// TODO(msteffen) should this fail the pipeline? (currently getRC will restart
 	// the pipeline indefinitely)
@@ -228,6 +232,40 @@ func (op *pipelineOp) run() error {
 	return nil
 }
 
+// runTFJobPipeline is the counterpart of run() for TFJob pipelines. TFJob
+// pipelines don't have an RC to create or scale; instead, their pipeline
+// monitor creates a TFJob for each job (see runTFJobs), so this mostly starts
+// and stops the monitor.
+func (op *pipelineOp) runTFJobPipeline() error {
+	switch op.ptr.State {
+	case pps.PipelineState_PIPELINE_STARTING, pps.PipelineState_PIPELINE_RESTARTING:
+		if err := op.createPipelineResources(); err != nil {
+			return err
+		}
+		if op.pipelineInfo.Stopped {
+			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, "")
+		}
+		return op.setPipelineState(pps.PipelineState_PIPELINE_RUNNING, "")
+	case pps.PipelineState_PIPELINE_RUNNING, pps.PipelineState_PIPELINE_STANDBY,
+		pps.PipelineState_PIPELINE_CRASHING:
+		if op.pipelineInfo.Stopped {
+			return op.setPipelineState(pps.PipelineState_PIPELINE_PAUSED, "")
+		}
+		op.startPipelineMonitor()
+	case pps.PipelineState_PIPELINE_PAUSED:
+		if !op.pipelineInfo.Stopped {
+			return op.setPipelineState(pps.PipelineState_PIPELINE_RUNNING, "")
+		}
+		op.stopPipelineMonitor()
+	case pps.PipelineState_PIPELINE_FAILURE:
+		if err := op.finishPipelineOutputCommits(); err != nil {
+			return err
+		}
+		return op.deletePipelineResources()
+	}
+	return nil
+}
+
 // getPipelineInfo reads the pipelineInfo associated with 'op's pipeline. This
 // should be one of the first calls made on 'op', as most other methods (e.g.
 // getRC, though not failPipeline) assume that op.pipelineInfo is set.
@@ -0,0 +1,462 @@
+package server
+
+// tfjob.go contains the logic for running TFJob pipelines. Rather than
+// creating a ReplicationController of workers, the PPS master creates one
+// Kubeflow TFJob for each of a TFJob pipeline's jobs, and maps the TFJob's
+// status onto the job's state.
+//
+// Pachyderm doesn't depend on Kubeflow's generated clients, so TFJobs are
+// manipulated as unstructured objects through the dynamic kubernetes client
+// (see tfJobOperator).
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/client/version"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
+)
+
+const (
+	tfJobKind          = "TFJob"
+	tfJobAPIVersion    = "kubeflow.org/v1"
+	tfJobPollInterval  = 5 * time.Second
+	jobIDLabel         = "jobID"
+	tfJobSecretPrefix  = "tfjob-pachctl-secret-"
+	tfJobPachctlConfig = "/pachctl/config.json"
+	// tfJobContainerName is the name of the container that Kubeflow expects
+	// to run TensorFlow in each replica
+	tfJobContainerName = "tensorflow"
+	// tfJobRunReplica is the command that runs a replica's command and then
+	// uploads its output (see etc/worker/init.go)
+	tfJobRunReplica = "/pach-bin/init run-tfjob-replica"
+)
+
+// tfJobResource identifies the Kubeflow TFJob custom resource
+var tfJobResource = schema.GroupVersionResource{
+	Group:    "kubeflow.org",
+	Version:  "v1",
+	Resource: "tfjobs",
+}
+
+// tfJobOperator creates, inspects and deletes the TFJobs in a namespace.
+type tfJobOperator struct {
+	tfJobs dynamic.ResourceInterface
+}
+
+func newTFJobOperator(dynamicClient dynamic.Interface, namespace string) *tfJobOperator {
+	return &tfJobOperator{
+		tfJobs: dynamicClient.Resource(tfJobResource).Namespace(namespace),
+	}
+}
+
+func (a *apiServer) tfJobOperator() *tfJobOperator {
+	return newTFJobOperator(a.env.GetDynamicKubeClient(), a.namespace)
+}
+
+// create creates 'tfJob', unless a TFJob with the same name exists already
+// (e.g. because a previous PPS master created it).
+func (o *tfJobOperator) create(tfJob *unstructured.Unstructured) error {
+	if _, err := o.tfJobs.Create(tfJob, metav1.CreateOptions{}); err != nil && !isAlreadyExistsErr(err) {
+		return errors.Wrapf(err, "could not create TFJob %q", tfJob.GetName())
+	}
+	return nil
+}
+
+// state returns the job state corresponding to the status of the TFJob
+// 'name', along with a reason if the TFJob failed.
+func (o *tfJobOperator) state(name string) (pps.JobState, string, error) {
+	tfJob, err := o.tfJobs.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "could not get TFJob %q", name)
+	}
+	state, reason := tfJobState(tfJob)
+	return state, reason, nil
+}
+
+// deleteAll deletes all of the TFJobs created for 'pipeline'.
+func (o *tfJobOperator) deleteAll(pipeline string) error {
+	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, pipeline)
+	tfJobs, err := o.tfJobs.List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		if isNotFoundErr(err) || kerrors.IsForbidden(err) || meta.IsNoMatchError(err) {
+			// TFJobs aren't installed in this cluster (or pachd can't access
+			// them), so there's nothing to delete
+			return nil
+		}
+		return errors.Wrapf(err, "could not list TFJobs")
+	}
+	for _, tfJob := range tfJobs.Items {
+		if err := o.tfJobs.Delete(tfJob.GetName(), &metav1.DeleteOptions{}); err != nil && !isNotFoundErr(err) {
+			return errors.Wrapf(err, "could not delete TFJob %q", tfJob.GetName())
+		}
+	}
+	return nil
+}
+
+// tfJobState maps the conditions in a TFJob's status onto a job state. The
+// most recent condition that holds determines the state.
+func tfJobState(tfJob *unstructured.Unstructured) (pps.JobState, string) {
+	conditions, _, _ := unstructured.NestedSlice(tfJob.Object, "status", "conditions")
+	for i := len(conditions) - 1; i >= 0; i-- {
+		condition, ok := conditions[i].(map[string]interface{})
+		if !ok || condition["status"] != string(v1.ConditionTrue) {
+			continue
+		}
+		switch condition["type"] {
+		case "Succeeded":
+			return pps.JobState_JOB_SUCCESS, ""
+		case "Failed":
+			message, _ := condition["message"].(string)
+			return pps.JobState_JOB_FAILURE, fmt.Sprintf("TFJob failed: %s", message)
+		case "Running", "Restarting":
+			return pps.JobState_JOB_RUNNING, ""
+		case "Created":
+			return pps.JobState_JOB_STARTING, ""
+		}
+	}
+	return pps.JobState_JOB_STARTING, ""
+}
+
+// parseTFJob parses the TFJob spec embedded in a pipeline.
+func parseTFJob(tfJob *pps.TFJob) (*unstructured.Unstructured, error) {
+	result := &unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(tfJob.TFJob), &result.Object); err != nil {
+		return nil, errors.Wrapf(err, "could not parse TFJob spec")
+	}
+	if kind := result.GetKind(); kind != "" && kind != tfJobKind {
+		return nil, errors.Errorf("TFJob spec has kind %q, but must have kind %q", kind, tfJobKind)
+	}
+	replicaSpecs, _, err := unstructured.NestedMap(result.Object, "spec", "tfReplicaSpecs")
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read spec.tfReplicaSpecs")
+	}
+	if len(replicaSpecs) == 0 {
+		return nil, errors.New("TFJob spec must contain at least one replica in spec.tfReplicaSpecs")
+	}
+	for replicaType := range replicaSpecs {
+		unstructuredPodSpec, ok, err := unstructured.NestedMap(replicaSpecs, replicaType, "template", "spec")
+		if err != nil || !ok {
+			return nil, errors.Errorf("TFJob replica %q must contain a pod template", replicaType)
+		}
+		var podSpec v1.PodSpec
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPodSpec, &podSpec); err != nil {
+			return nil, errors.Wrapf(err, "could not parse pod template of TFJob replica %q", replicaType)
+		}
+		container := tfJobMainContainer(&podSpec)
+		if container == nil {
+			return nil, errors.Errorf("TFJob replica %q must contain a container", replicaType)
+		}
+		if len(container.Command) == 0 {
+			// The command is wrapped so that the replica's output is uploaded
+			// once it's done, which isn't possible for the image's entrypoint
+			return nil, errors.Errorf("container %q of TFJob replica %q must specify a command", container.Name, replicaType)
+		}
+	}
+	return result, nil
+}
+
+// tfJobMainContainer returns the container that runs TensorFlow in the pods of
+// a TFJob replica, which is the container named "tensorflow" or, if there is
+// none, the first container.
+func tfJobMainContainer(podSpec *v1.PodSpec) *v1.Container {
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == tfJobContainerName {
+			return &podSpec.Containers[i]
+		}
+	}
+	if len(podSpec.Containers) > 0 {
+		return &podSpec.Containers[0]
+	}
+	return nil
+}
+
+func validateTFJob(tfJob *pps.TFJob) error {
+	_, err := parseTFJob(tfJob)
+	return err
+}
+
+func tfJobName(jobID string) string {
+	return "tfjob-" + jobID
+}
+
+// tfJobSpec generates the TFJob that runs 'jobInfo', based on the TFJob spec in
+// 'pipelineInfo'. In addition to the user's spec, every replica's pods get:
+// - The job's PFS inputs, which are downloaded to /pfs/<input name> by init
+//   containers before the replica starts
+// - pachctl, at /pach-bin/pachctl, configured to talk to pachd as the pipeline
+// - Environment variables identifying the pipeline, job and output commit
+// - An output uploader: the command of each replica's main container is
+//   wrapped, so that once the TFJob's chief replica's command succeeds, the
+//   contents of /pfs/out are uploaded to the output commit (and the replica
+//   fails if they can't be)
+// The output commit is finished once the TFJob succeeds, at which point the
+// chief has uploaded its output.
+func (a *apiServer) tfJobSpec(pipelineInfo *pps.PipelineInfo, jobInfo *pps.JobInfo) (*unstructured.Unstructured, error) {
+	tfJob, err := parseTFJob(pipelineInfo.TFJob)
+	if err != nil {
+		return nil, err
+	}
+	pipelineName := pipelineInfo.Pipeline.Name
+	if tfJob.GetAPIVersion() == "" {
+		tfJob.SetAPIVersion(tfJobAPIVersion)
+	}
+	tfJob.SetKind(tfJobKind)
+	tfJob.SetName(tfJobName(jobInfo.Job.ID))
+	tfJob.SetGenerateName("")
+	tfJob.SetNamespace(a.namespace)
+	tfJobLabels := labels(ppsutil.PipelineRcName(pipelineName, pipelineInfo.Version))
+	for k, v := range tfJob.GetLabels() {
+		tfJobLabels[k] = v
+	}
+	tfJobLabels[pipelineNameLabel] = pipelineName
+	tfJobLabels[jobIDLabel] = jobInfo.Job.ID
+	tfJob.SetLabels(tfJobLabels)
+	annotations := tfJob.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[pachVersionAnnotation] = version.PrettyVersion()
+	annotations[specCommitAnnotation] = pipelineInfo.SpecCommit.ID
+	tfJob.SetAnnotations(annotations)
+
+	replicaSpecs, _, err := unstructured.NestedMap(tfJob.Object, "spec", "tfReplicaSpecs")
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read spec.tfReplicaSpecs")
+	}
+	for replicaType := range replicaSpecs {
+		fields := []string{"spec", "tfReplicaSpecs", replicaType, "template", "spec"}
+		unstructuredPodSpec, _, err := unstructured.NestedMap(tfJob.Object, fields...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read pod template of TFJob replica %q", replicaType)
+		}
+		var podSpec v1.PodSpec
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPodSpec, &podSpec); err != nil {
+			return nil, errors.Wrapf(err, "could not parse pod template of TFJob replica %q", replicaType)
+		}
+		a.addTFJobPodOptions(&podSpec, pipelineInfo, jobInfo)
+		unstructuredPodSpec, err = runtime.DefaultUnstructuredConverter.ToUnstructured(&podSpec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not serialize pod template of TFJob replica %q", replicaType)
+		}
+		if err := unstructured.SetNestedMap(tfJob.Object, unstructuredPodSpec, fields...); err != nil {
+			return nil, errors.Wrapf(err, "could not set pod template of TFJob replica %q", replicaType)
+		}
+	}
+	return tfJob, nil
+}
+
+// addTFJobPodOptions adds the volumes, init containers and environment
+// described in tfJobSpec to the pod spec of a TFJob replica.
+func (a *apiServer) addTFJobPodOptions(podSpec *v1.PodSpec, pipelineInfo *pps.PipelineInfo, jobInfo *pps.JobInfo) {
+	pullPolicy := a.workerImagePullPolicy
+	if pullPolicy == "" {
+		pullPolicy = "IfNotPresent"
+	}
+	pachctlSecretVolume, pachctlSecretMount := getPachctlSecretVolumeAndMount(tfJobSecretPrefix + pipelineInfo.Pipeline.Name)
+	pachBinMount := v1.VolumeMount{
+		Name:      "pach-bin",
+		MountPath: "/pach-bin",
+	}
+	pfsMount := v1.VolumeMount{
+		Name:      client.PPSWorkerVolume,
+		MountPath: client.PPSInputPrefix,
+	}
+	podSpec.Volumes = append(podSpec.Volumes, pachctlSecretVolume, v1.Volume{
+		Name: pachBinMount.Name,
+		VolumeSource: v1.VolumeSource{
+			EmptyDir: &v1.EmptyDirVolumeSource{},
+		},
+	}, v1.Volume{
+		Name: pfsMount.Name,
+		VolumeSource: v1.VolumeSource{
+			EmptyDir: &v1.EmptyDirVolumeSource{},
+		},
+	})
+	configEnv := v1.EnvVar{
+		Name:  "PACH_CONFIG",
+		Value: tfJobPachctlConfig,
+	}
+
+	// Copy pachctl into the pod, and download each input
+	initContainers := []v1.Container{{
+		Name:            "init",
+		Image:           a.workerImage,
+		Command:         []string{"/app/init"},
+		ImagePullPolicy: v1.PullPolicy(pullPolicy),
+		VolumeMounts:    []v1.VolumeMount{pachBinMount},
+	}}
+	pps.VisitInput(jobInfo.Input, func(input *pps.Input) {
+		if input.Pfs == nil {
+			return
+		}
+		initContainers = append(initContainers, v1.Container{
+			Name:  fmt.Sprintf("input-%d", len(initContainers)),
+			Image: a.workerImage,
+			Command: []string{"/app/pachctl", "get", "file", "-r",
+				fmt.Sprintf("%s@%s:/", input.Pfs.Repo, input.Pfs.Commit),
+				"-o", path.Join(client.PPSInputPrefix, input.Pfs.Name)},
+			ImagePullPolicy: v1.PullPolicy(pullPolicy),
+			Env:             []v1.EnvVar{configEnv},
+			VolumeMounts:    []v1.VolumeMount{pfsMount, pachctlSecretMount},
+		})
+	})
+	podSpec.InitContainers = append(initContainers, podSpec.InitContainers...)
+
+	env := []v1.EnvVar{configEnv, {
+		Name:  client.PPSPipelineNameEnv,
+		Value: pipelineInfo.Pipeline.Name,
+	}, {
+		Name:  client.JobIDEnv,
+		Value: jobInfo.Job.ID,
+	}, {
+		Name:  client.OutputCommitIDEnv,
+		Value: jobInfo.OutputCommit.ID,
+	}}
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		container.Env = append(container.Env, env...)
+		container.VolumeMounts = append(container.VolumeMounts, pfsMount, pachBinMount, pachctlSecretMount)
+	}
+	if container := tfJobMainContainer(podSpec); container != nil {
+		container.Command = append(strings.Fields(tfJobRunReplica), container.Command...)
+	}
+	if a.imagePullSecret != "" {
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, v1.LocalObjectReference{Name: a.imagePullSecret})
+	}
+}
+
+// runTFJobs runs a TFJob for each of the output commits of 'pipelineInfo', one
+// at a time. It's run by monitorPipeline for TFJob pipelines.
+func (m *ppsMaster) runTFJobs(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+	pipeline := pipelineInfo.Pipeline.Name
+	return pachClient.SubscribeCommitF(pipeline, "",
+		client.NewCommitProvenance(ppsconsts.SpecRepo, pipeline, pipelineInfo.SpecCommit.ID),
+		"", pfs.CommitState_READY, func(ci *pfs.CommitInfo) error {
+			if ci.Finished != nil {
+				return nil
+			}
+			return m.runTFJob(pachClient, pipelineInfo, ci)
+		})
+}
+
+// runTFJob creates the TFJob for the job of 'commitInfo', and updates the job
+// as the TFJob progresses, until the TFJob succeeds or fails.
+func (m *ppsMaster) runTFJob(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, commitInfo *pfs.CommitInfo) error {
+	jobInfo, err := ensureTFJobJob(pachClient, pipelineInfo, commitInfo)
+	if err != nil {
+		return err
+	}
+	if ppsutil.IsTerminal(jobInfo.State) {
+		return nil
+	}
+	tfJob, err := m.a.tfJobSpec(pipelineInfo, jobInfo)
+	if err != nil {
+		return finishTFJobJob(pachClient, jobInfo, pps.JobState_JOB_FAILURE, err.Error())
+	}
+	operator := m.a.tfJobOperator()
+	log.Infof("PPS master: creating TFJob %q for job %q", tfJob.GetName(), jobInfo.Job.ID)
+	if err := operator.create(tfJob); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(tfJobPollInterval)
+	defer ticker.Stop()
+	for {
+		state, reason, err := operator.state(tfJob.GetName())
+		if err != nil {
+			return err
+		}
+		if ppsutil.IsTerminal(state) {
+			return finishTFJobJob(pachClient, jobInfo, state, reason)
+		}
+		if state != jobInfo.State {
+			if _, err := pachClient.PpsAPIClient.UpdateJobState(pachClient.Ctx(), &pps.UpdateJobStateRequest{
+				Job:   jobInfo.Job,
+				State: state,
+			}); err != nil {
+				return err
+			}
+			jobInfo.State = state
+		}
+		select {
+		case <-ticker.C:
+		case <-pachClient.Ctx().Done():
+			return pachClient.Ctx().Err()
+		}
+	}
+}
+
+// ensureTFJobJob loads the job for 'commitInfo', or creates it if there is
+// none.
+func ensureTFJobJob(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, commitInfo *pfs.CommitInfo) (*pps.JobInfo, error) {
+	jobInfos, err := pachClient.ListJob("", nil, commitInfo.Commit, -1, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobInfos) > 1 {
+		return nil, errors.Errorf("multiple jobs found for commit: %s/%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
+	} else if len(jobInfos) == 1 {
+		return pachClient.InspectJob(jobInfos[0].Job.ID, false)
+	}
+	var statsCommit *pfs.Commit
+	for _, commitRange := range commitInfo.Subvenance {
+		if commitRange.Lower.Repo.Name == commitInfo.Commit.Repo.Name {
+			statsCommit = commitRange.Lower
+			break
+		}
+	}
+	job, err := pachClient.CreateJob(pipelineInfo.Pipeline.Name, commitInfo.Commit, statsCommit)
+	if err != nil {
+		return nil, err
+	}
+	return pachClient.InspectJob(job.ID, false)
+}
+
+// finishTFJobJob finishes the output and stats commits of a TFJob's job, and
+// moves the job into 'state'. If the TFJob succeeded, the output commit
+// contains the output uploaded by the TFJob's chief replica (which uploads it
+// before exiting, see tfJobSpec). If the TFJob failed, the commits are marked
+// empty.
+func finishTFJobJob(pachClient *client.APIClient, jobInfo *pps.JobInfo, state pps.JobState, reason string) error {
+	for _, commit := range []*pfs.Commit{jobInfo.StatsCommit, jobInfo.OutputCommit} {
+		if commit == nil {
+			continue
+		}
+		if _, err := pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+			Commit: commit,
+			Empty:  state != pps.JobState_JOB_SUCCESS,
+		}); err != nil {
+			if !pfsserver.IsCommitFinishedErr(err) && !pfsserver.IsCommitNotFoundErr(err) && !pfsserver.IsCommitDeletedErr(err) {
+				return err
+			}
+		}
+	}
+	if _, err := pachClient.PpsAPIClient.UpdateJobState(pachClient.Ctx(), &pps.UpdateJobStateRequest{
+		Job:    jobInfo.Job,
+		State:  state,
+		Reason: reason,
+	}); err != nil && !ppsserver.IsJobFinishedErr(err) {
+		return err
+	}
+	return nil
+}
@@ -1,74 +1,208 @@
 package server
 
-// TODO: Make work with V2.
-//func YAMLToJSONString(t *testing.T, yamlStr string) string {
-//	holder := make(map[string]interface{})
-//	if err := serde.DecodeYAML([]byte(yamlStr), &holder); err != nil {
-//		t.Fatalf("error parsing TFJob: %v", err)
-//	}
-//	result, err := json.Marshal(holder)
-//	if err != nil {
-//		t.Fatalf("error marshalling TFJob to JSON: %v", err)
-//	}
-//	return string(result)
-//}
-//
-//func TestTFJobBasic(t *testing.T) {
-//	if testing.Short() {
-//		t.Skip("Skipping integration tests in short mode")
-//	}
-//
-//	c := tu.GetPachClient(t)
-//	require.NoError(t, c.DeleteAll())
-//
-//	dataRepo := tu.UniqueString("TestSimplePipeline_data")
-//	require.NoError(t, c.CreateRepo(dataRepo))
-//
-//	pipeline := tu.UniqueString("pipeline1")
-//	tfJobString := YAMLToJSONString(t, `
-//    apiVersion: kubeflow.org/v1
-//    kind: TFJob
-//    metadata:
-//      generateName: tfjob
-//      namespace: kubeflow
-//    spec:
-//      tfReplicaSpecs:
-//        PS:
-//          replicas: 1
-//          restartPolicy: OnFailure
-//          template:
-//            spec:
-//              containers:
-//              - name: tensorflow
-//                image: gcr.io/your-project/your-image
-//                command:
-//                  - python
-//                  - -m
-//                  - trainer.task
-//                  - --batch_size=32
-//                  - --training_steps=1000
-//        Worker:
-//          replicas: 3
-//          restartPolicy: OnFailure
-//          template:
-//            spec:
-//              containers:
-//              - name: tensorflow
-//                image: gcr.io/your-project/your-image
-//                command:
-//                  - python
-//                  - -m
-//                  - trainer.task
-//                  - --batch_size=32
-//                  - --training_steps=1000
-//    `)
-//	_, err := c.PpsAPIClient.CreatePipeline(
-//		context.Background(),
-//		&pps.CreatePipelineRequest{
-//			Pipeline: client.NewPipeline(pipeline),
-//			Input:    client.NewPFSInput(dataRepo, "/*"),
-//			TFJob:    &pps.TFJob{TFJob: tfJobString},
-//		})
-//	require.YesError(t, err)
-//	require.Matches(t, "not supported yet", err.Error())
-//}
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serde"
+)
+
+func YAMLToJSONString(t *testing.T, yamlStr string) string {
+	holder := make(map[string]interface{})
+	if err := serde.DecodeYAML([]byte(yamlStr), &holder); err != nil {
+		t.Fatalf("error parsing TFJob: %v", err)
+	}
+	result, err := json.Marshal(holder)
+	if err != nil {
+		t.Fatalf("error marshalling TFJob to JSON: %v", err)
+	}
+	return string(result)
+}
+
+func testTFJobInfos(t *testing.T) (*pps.PipelineInfo, *pps.JobInfo) {
+	tfJobString := YAMLToJSONString(t, `
+    apiVersion: kubeflow.org/v1
+    kind: TFJob
+    metadata:
+      generateName: tfjob
+      namespace: kubeflow
+    spec:
+      tfReplicaSpecs:
+        PS:
+          replicas: 1
+          restartPolicy: OnFailure
+          template:
+            spec:
+              containers:
+              - name: tensorflow
+                image: gcr.io/your-project/your-image
+                command:
+                  - python
+                  - -m
+                  - trainer.task
+        Worker:
+          replicas: 3
+          restartPolicy: OnFailure
+          template:
+            spec:
+              containers:
+              - name: tensorflow
+                image: gcr.io/your-project/your-image
+                command:
+                  - python
+                  - -m
+                  - trainer.task
+    `)
+	input := client.NewCrossInput(
+		client.NewPFSInput("images", "/*"),
+		client.NewPFSInput("labels", "/"),
+	)
+	input.Cross[0].Pfs.Name = "images"
+	input.Cross[0].Pfs.Commit = "imagesCommit"
+	input.Cross[1].Pfs.Name = "labels"
+	input.Cross[1].Pfs.Commit = "labelsCommit"
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline:   client.NewPipeline("train"),
+		Version:    1,
+		TFJob:      &pps.TFJob{TFJob: tfJobString},
+		Input:      input,
+		SpecCommit: client.NewCommit("__spec__", "specCommit"),
+	}
+	jobInfo := &pps.JobInfo{
+		Job:          client.NewJob("0123456789abcdef"),
+		Pipeline:     pipelineInfo.Pipeline,
+		Input:        input,
+		OutputCommit: client.NewCommit("train", "outputCommit"),
+	}
+	return pipelineInfo, jobInfo
+}
+
+func TestTFJobSpec(t *testing.T) {
+	a := &apiServer{namespace: "default", workerImage: "pachyderm/worker"}
+	pipelineInfo, jobInfo := testTFJobInfos(t)
+	tfJob, err := a.tfJobSpec(pipelineInfo, jobInfo)
+	require.NoError(t, err)
+	require.Equal(t, "tfjob-0123456789abcdef", tfJob.GetName())
+	require.Equal(t, "", tfJob.GetGenerateName())
+	require.Equal(t, "default", tfJob.GetNamespace())
+	require.Equal(t, "train", tfJob.GetLabels()[pipelineNameLabel])
+	require.Equal(t, "0123456789abcdef", tfJob.GetLabels()[jobIDLabel])
+	require.Equal(t, "specCommit", tfJob.GetAnnotations()[specCommitAnnotation])
+
+	for _, replicaType := range []string{"PS", "Worker"} {
+		unstructuredPodSpec, ok, err := unstructured.NestedMap(tfJob.Object, "spec", "tfReplicaSpecs", replicaType, "template", "spec")
+		require.NoError(t, err)
+		require.True(t, ok)
+		var podSpec v1.PodSpec
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPodSpec, &podSpec))
+
+		// pachctl is copied in, then each input is downloaded
+		require.Equal(t, 3, len(podSpec.InitContainers))
+		require.Equal(t, []string{"/app/init"}, podSpec.InitContainers[0].Command)
+		require.Equal(t, []string{"/app/pachctl", "get", "file", "-r", "images@imagesCommit:/", "-o", "/pfs/images"},
+			podSpec.InitContainers[1].Command)
+		require.Equal(t, []string{"/app/pachctl", "get", "file", "-r", "labels@labelsCommit:/", "-o", "/pfs/labels"},
+			podSpec.InitContainers[2].Command)
+
+		require.Equal(t, 1, len(podSpec.Containers))
+		container := podSpec.Containers[0]
+		// The command is wrapped so that the chief uploads /pfs/out
+		require.Equal(t, []string{"/pach-bin/init", "run-tfjob-replica", "python", "-m", "trainer.task"}, container.Command)
+		env := make(map[string]string)
+		for _, e := range container.Env {
+			env[e.Name] = e.Value
+		}
+		require.Equal(t, "train", env[client.PPSPipelineNameEnv])
+		require.Equal(t, "0123456789abcdef", env[client.JobIDEnv])
+		require.Equal(t, "outputCommit", env[client.OutputCommitIDEnv])
+		mounts := make(map[string]string)
+		for _, m := range container.VolumeMounts {
+			mounts[m.MountPath] = m.Name
+		}
+		require.Equal(t, client.PPSWorkerVolume, mounts[client.PPSInputPrefix])
+		require.Equal(t, "pach-bin", mounts["/pach-bin"])
+	}
+}
+
+func TestTFJobSpecValidation(t *testing.T) {
+	require.YesError(t, validateTFJob(&pps.TFJob{TFJob: "not json"}))
+	require.YesError(t, validateTFJob(&pps.TFJob{TFJob: `{"kind": "PyTorchJob", "spec": {}}`}))
+	require.YesError(t, validateTFJob(&pps.TFJob{TFJob: `{"kind": "TFJob", "spec": {}}`}))
+	require.YesError(t, validateTFJob(&pps.TFJob{TFJob: `{"kind": "TFJob", "spec": {"tfReplicaSpecs": {"Worker": {"replicas": 1}}}}`}))
+	// The main container must have a command, so that it can be wrapped
+	require.YesError(t, validateTFJob(&pps.TFJob{TFJob: `{"kind": "TFJob", "spec": {"tfReplicaSpecs": {"Worker": {"template": {"spec": {"containers": []}}}}}}`}))
+	require.YesError(t, validateTFJob(&pps.TFJob{TFJob: `{"kind": "TFJob", "spec": {"tfReplicaSpecs": {"Worker": {"template": {"spec": {"containers": [{"name": "tensorflow", "image": "tf"}]}}}}}}`}))
+	require.NoError(t, validateTFJob(&pps.TFJob{TFJob: `{"kind": "TFJob", "spec": {"tfReplicaSpecs": {"Worker": {"template": {"spec": {"containers": [{"name": "tensorflow", "image": "tf", "command": ["python"]}]}}}}}}`}))
+	pipelineInfo, _ := testTFJobInfos(t)
+	require.NoError(t, validateTFJob(pipelineInfo.TFJob))
+}
+
+func setTFJobCondition(t *testing.T, tfJob *unstructured.Unstructured, conditionType string) {
+	conditions, _, err := unstructured.NestedSlice(tfJob.Object, "status", "conditions")
+	require.NoError(t, err)
+	conditions = append(conditions, map[string]interface{}{
+		"type":    conditionType,
+		"status":  "True",
+		"message": "TFJob is " + conditionType,
+	})
+	require.NoError(t, unstructured.SetNestedSlice(tfJob.Object, conditions, "status", "conditions"))
+}
+
+func TestTFJobOperator(t *testing.T) {
+	a := &apiServer{namespace: "default", workerImage: "pachyderm/worker"}
+	pipelineInfo, jobInfo := testTFJobInfos(t)
+	tfJob, err := a.tfJobSpec(pipelineInfo, jobInfo)
+	require.NoError(t, err)
+
+	dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+	operator := newTFJobOperator(dynamicClient, "default")
+	require.NoError(t, operator.create(tfJob))
+	// Creating the same TFJob again (e.g. after a PPS master restart) is a no-op
+	require.NoError(t, operator.create(tfJob))
+
+	state, _, err := operator.state(tfJob.GetName())
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_STARTING, state)
+
+	tfJobs := dynamicClient.Resource(tfJobResource).Namespace("default")
+	for _, c := range []struct {
+		condition string
+		state     pps.JobState
+	}{
+		{"Created", pps.JobState_JOB_STARTING},
+		{"Running", pps.JobState_JOB_RUNNING},
+		{"Restarting", pps.JobState_JOB_RUNNING},
+		{"Failed", pps.JobState_JOB_FAILURE},
+	} {
+		current, err := tfJobs.Get(tfJob.GetName(), metav1.GetOptions{})
+		require.NoError(t, err)
+		setTFJobCondition(t, current, c.condition)
+		_, err = tfJobs.Update(current, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		state, reason, err := operator.state(tfJob.GetName())
+		require.NoError(t, err)
+		require.Equal(t, c.state, state)
+		if state == pps.JobState_JOB_FAILURE {
+			require.Matches(t, "TFJob is Failed", reason)
+		}
+	}
+
+	// Deleting a pipeline's resources deletes its TFJobs
+	require.NoError(t, operator.deleteAll("other-pipeline"))
+	list, err := tfJobs.List(metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list.Items))
+	require.NoError(t, operator.deleteAll(pipelineInfo.Pipeline.Name))
+	list, err = tfJobs.List(metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list.Items))
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/chunk"
 	workerstats "github.com/pachyderm/pachyderm/src/server/worker/stats"
 
 	log "github.com/sirupsen/logrus"
@@ -87,6 +89,15 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		pullPolicy = "IfNotPresent"
 	}
 
+	chunkCacheSize, err := getChunkCacheSize(options.cacheSize)
+	if err != nil {
+		return v1.PodSpec{}, err
+	}
+	cacheSize := options.cacheSize
+	if cacheSize == "" {
+		cacheSize = defaultCacheSize
+	}
+
 	// Set up sidecar env vars
 	sidecarEnv := []v1.EnvVar{{
 		Name:  "PACH_ROOT",
@@ -99,10 +110,13 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		Value: a.namespace,
 	}, {
 		Name:  "BLOCK_CACHE_BYTES",
-		Value: options.cacheSize,
+		Value: cacheSize,
 	}, {
 		Name:  "PFS_CACHE_SIZE",
 		Value: "16",
+	}, {
+		Name:  "STORAGE_DISK_CACHE_SIZE",
+		Value: strconv.Itoa(chunkCacheSize),
 	}, {
 		Name:  "STORAGE_BACKEND",
 		Value: a.storageBackend,
@@ -259,6 +273,9 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 	options.volumes = append(options.volumes, secretVolume)
 	sidecarVolumeMounts = append(sidecarVolumeMounts, secretMount)
 	userVolumeMounts = append(userVolumeMounts, secretMount)
+	sidecarStorageVolumes, sidecarStorageMounts := getSidecarStorageVolumes()
+	options.volumes = append(options.volumes, sidecarStorageVolumes...)
+	sidecarVolumeMounts = append(sidecarVolumeMounts, sidecarStorageMounts...)
 
 	// mount secret for spouts using pachctl
 	if pipelineInfo.Spout != nil {
@@ -273,7 +290,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 	// 64M, but is overridden by the CacheSize setting for the sidecar.
 	cpuZeroQuantity := resource.MustParse("0")
 	memDefaultQuantity := resource.MustParse("64M")
-	memSidecarQuantity := resource.MustParse(options.cacheSize)
+	memSidecarQuantity := resource.MustParse(cacheSize)
 
 	// Get service account name for worker from env or use default
 	workerServiceAccountName, ok := os.LookupEnv(assets.WorkerServiceAccountEnvVar)
@@ -438,20 +455,62 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 	return podSpec, nil
 }
 
+const (
+	// defaultCacheSize is the sidecar's memory request and block cache size
+	// for pipelines that don't set a cache size.
+	defaultCacheSize = "64M"
+	// defaultChunkCacheSize is the default number of chunks that pachd caches
+	// on disk (see STORAGE_DISK_CACHE_SIZE in serviceenv).
+	defaultChunkCacheSize = 100
+)
+
+// getChunkCacheSize returns the number of chunks that the sidecar caches on
+// disk, which is the pipeline's cache size divided by the size of a chunk (but
+// at least one chunk), or pachd's default if the pipeline has no cache size.
+func getChunkCacheSize(cacheSize string) (int, error) {
+	if cacheSize == "" {
+		return defaultChunkCacheSize, nil
+	}
+	quantity, err := resource.ParseQuantity(cacheSize)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not parse cacheSize '%s'", cacheSize)
+	}
+	n := quantity.Value() / chunk.DefaultMaxChunkSize
+	if n < 1 {
+		n = 1
+	}
+	return int(n), nil
+}
+
 func getStorageEnvVars(pipelineInfo *pps.PipelineInfo) ([]v1.EnvVar, error) {
 	uploadConcurrencyLimit, ok := os.LookupEnv(assets.UploadConcurrencyLimitEnvVar)
 	if !ok {
 		return nil, errors.Errorf("%s not found", assets.UploadConcurrencyLimitEnvVar)
 	}
+	envVars := []v1.EnvVar{
+		{Name: assets.UploadConcurrencyLimitEnvVar, Value: uploadConcurrencyLimit},
+	}
+	// The sidecar encrypts and compresses the chunks it writes in the same way
+	// as pachd.
+	if keyID, ok := os.LookupEnv(assets.EncryptionKeyIDEnvVar); ok && keyID != "" {
+		envVars = append(envVars, v1.EnvVar{Name: assets.EncryptionKeyIDEnvVar, Value: keyID})
+	}
+	if algo, ok := os.LookupEnv(assets.CompressionAlgoEnvVar); ok && algo != "" {
+		envVars = append(envVars, v1.EnvVar{Name: assets.CompressionAlgoEnvVar, Value: algo})
+	}
 	if pipelineInfo.Spout != nil {
-		return []v1.EnvVar{
-			{Name: assets.UploadConcurrencyLimitEnvVar, Value: uploadConcurrencyLimit},
-			{Name: "SPOUT_PIPELINE_NAME", Value: pipelineInfo.Pipeline.Name},
-		}, nil
+		envVars = append(envVars, v1.EnvVar{Name: "SPOUT_PIPELINE_NAME", Value: pipelineInfo.Pipeline.Name})
 	}
-	return []v1.EnvVar{
-		{Name: assets.UploadConcurrencyLimitEnvVar, Value: uploadConcurrencyLimit},
-	}, nil
+	return envVars, nil
+}
+
+// getSidecarStorageVolumes returns the volumes (and their mounts) that the
+// sidecar needs to read and write chunks, but that aren't exposed to the user
+// container: the storage encryption keys, which are mounted exactly as they
+// are in pachd.
+func getSidecarStorageVolumes() ([]v1.Volume, []v1.VolumeMount) {
+	volume, mount := assets.GetStorageEncryptionSecretVolumeAndMount()
+	return []v1.Volume{volume}, []v1.VolumeMount{mount}
 }
 
 // We don't want to expose pipeline auth tokens, so we hash it. This will be
@@ -653,6 +712,12 @@ func (a *apiServer) getWorkerOptions(ptr *pps.EtcdPipelineInfo, pipelineInfo *pp
 }
 
 func (a *apiServer) createWorkerPachctlSecret(ctx context.Context, ptr *pps.EtcdPipelineInfo, pipelineInfo *pps.PipelineInfo) error {
+	return a.createPachctlSecret("spout-pachctl-secret-"+pipelineInfo.Pipeline.Name, "localhost:653", ptr, pipelineInfo)
+}
+
+// createPachctlSecret creates a secret named 'name' containing a pachctl config
+// that connects to 'pachdAddress' as the pipeline.
+func (a *apiServer) createPachctlSecret(name, pachdAddress string, ptr *pps.EtcdPipelineInfo, pipelineInfo *pps.PipelineInfo) error {
 	var cfg config.Config
 	err := cfg.InitV2()
 	if err != nil {
@@ -663,7 +728,7 @@ func (a *apiServer) createWorkerPachctlSecret(ctx context.Context, ptr *pps.Etcd
 		return errors.Wrapf(err, "error getting the active context")
 	}
 	context.SessionToken = ptr.AuthToken
-	context.PachdAddress = "localhost:653"
+	context.PachdAddress = pachdAddress
 
 	rawConfig, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
@@ -675,7 +740,7 @@ func (a *apiServer) createWorkerPachctlSecret(ctx context.Context, ptr *pps.Etcd
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   "spout-pachctl-secret-" + pipelineInfo.Pipeline.Name,
+			Name:   name,
 			Labels: labels(pipelineInfo.Pipeline.Name),
 		},
 		Data: map[string][]byte{
@@ -719,6 +784,55 @@ func (a *apiServer) createWorkerSvcAndRc(ctx context.Context, ptr *pps.EtcdPipel
 		}
 	}
 
+	if pipelineInfo.TFJob != nil {
+		// TFJob pipelines don't have workers--the PPS master creates a TFJob for
+		// each job instead (see tfjob.go). The TFJobs' pods only need pachctl.
+		pachdAddress := fmt.Sprintf("pachd.%s:%d", a.namespace, a.port)
+		if err := a.createPachctlSecret(tfJobSecretPrefix+pipelineInfo.Pipeline.Name, pachdAddress, ptr, pipelineInfo); err != nil {
+			return err
+		}
+	} else if err := a.createWorkerRCAndServices(ptr, pipelineInfo); err != nil {
+		return err
+	}
+
+	// Generate pipeline's auth token & add pipeline to the ACLs of input/output
+	// repos
+	pachClient := a.env.GetPachClient(ctx)
+	if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
+		tokenResp, err := superUserClient.GetAuthToken(superUserClient.Ctx(), &auth.GetAuthTokenRequest{
+			Subject: auth.PipelinePrefix + pipelineInfo.Pipeline.Name,
+			TTL:     -1,
+		})
+		if err != nil {
+			if auth.IsErrNotActivated(err) {
+				return nil // no auth work to do
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+		ptr.AuthToken = tokenResp.Token
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// True if the pipeline has a git input
+	var hasGitInput bool
+	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+		if input.Git != nil {
+			hasGitInput = true
+		}
+	})
+	if hasGitInput {
+		if err := a.checkOrDeployGithookService(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createWorkerRCAndServices creates the RC and services that run the workers
+// of 'pipelineInfo'.
+func (a *apiServer) createWorkerRCAndServices(ptr *pps.EtcdPipelineInfo, pipelineInfo *pps.PipelineInfo) error {
 	options, err := a.getWorkerOptions(ptr, pipelineInfo)
 	if err != nil {
 		return noValidOptionsErr{err}
@@ -824,39 +938,6 @@ func (a *apiServer) createWorkerSvcAndRc(ctx context.Context, ptr *pps.EtcdPipel
 			}
 		}
 	}
-
-	// Generate pipeline's auth token & add pipeline to the ACLs of input/output
-	// repos
-	pachClient := a.env.GetPachClient(ctx)
-	if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
-		tokenResp, err := superUserClient.GetAuthToken(superUserClient.Ctx(), &auth.GetAuthTokenRequest{
-			Subject: auth.PipelinePrefix + pipelineInfo.Pipeline.Name,
-			TTL:     -1,
-		})
-		if err != nil {
-			if auth.IsErrNotActivated(err) {
-				return nil // no auth work to do
-			}
-			return grpcutil.ScrubGRPC(err)
-		}
-		ptr.AuthToken = tokenResp.Token
-		return nil
-	}); err != nil {
-		return err
-	}
-
-	// True if the pipeline has a git input
-	var hasGitInput bool
-	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
-		if input.Git != nil {
-			hasGitInput = true
-		}
-	})
-	if hasGitInput {
-		if err := a.checkOrDeployGithookService(); err != nil {
-			return err
-		}
-	}
 	return nil
 }
 
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestGetChunkCacheSize(t *testing.T) {
+	// Pipelines without a cache size get pachd's chunk cache.
+	n, err := getChunkCacheSize("")
+	require.NoError(t, err)
+	require.Equal(t, defaultChunkCacheSize, n)
+	// Otherwise the chunk cache holds as many chunks as fit in the cache size.
+	n, err = getChunkCacheSize("64M")
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	n, err = getChunkCacheSize("4G")
+	require.NoError(t, err)
+	require.Equal(t, 200, n)
+	// The chunk cache holds at least one chunk.
+	n, err = getChunkCacheSize("1M")
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	_, err = getChunkCacheSize("not a quantity")
+	require.YesError(t, err)
+}
+
+func TestSidecarStorageEncryption(t *testing.T) {
+	for k, v := range map[string]string{
+		assets.UploadConcurrencyLimitEnvVar: "100",
+		assets.EncryptionKeyIDEnvVar:        "key-1",
+	} {
+		prev, ok := os.LookupEnv(k)
+		require.NoError(t, os.Setenv(k, v))
+		defer func(k string) {
+			if ok {
+				os.Setenv(k, prev)
+			} else {
+				os.Unsetenv(k)
+			}
+		}(k)
+	}
+	pipelineInfo := &pps.PipelineInfo{Pipeline: client.NewPipeline("pipeline")}
+	// The sidecar encrypts new chunks with pachd's key.
+	envVars, err := getStorageEnvVars(pipelineInfo)
+	require.NoError(t, err)
+	require.OneOfEquals(t, v1.EnvVar{Name: assets.EncryptionKeyIDEnvVar, Value: "key-1"}, envVars)
+	// The sidecar mounts the encryption keys where pachd expects them.
+	volumes, mounts := getSidecarStorageVolumes()
+	require.Equal(t, 1, len(volumes))
+	require.Equal(t, 1, len(mounts))
+	require.Equal(t, "pachyderm-storage-encryption", volumes[0].Secret.SecretName)
+	require.Equal(t, volumes[0].Name, mounts[0].Name)
+	require.Equal(t, "/pachyderm-storage-encryption", mounts[0].MountPath)
+	// Without a key, new chunks aren't encrypted.
+	require.NoError(t, os.Unsetenv(assets.EncryptionKeyIDEnvVar))
+	envVars, err = getStorageEnvVars(pipelineInfo)
+	require.NoError(t, err)
+	for _, envVar := range envVars {
+		require.NotEqual(t, assets.EncryptionKeyIDEnvVar, envVar.Name)
+	}
+}
+
+func TestSidecarStorageCompression(t *testing.T) {
+	for k, v := range map[string]string{
+		assets.UploadConcurrencyLimitEnvVar: "100",
+		assets.CompressionAlgoEnvVar:        "zstd",
+	} {
+		prev, ok := os.LookupEnv(k)
+		require.NoError(t, os.Setenv(k, v))
+		defer func(k string) {
+			if ok {
+				os.Setenv(k, prev)
+			} else {
+				os.Unsetenv(k)
+			}
+		}(k)
+	}
+	pipelineInfo := &pps.PipelineInfo{Pipeline: client.NewPipeline("pipeline")}
+	// The sidecar compresses new chunks with pachd's algorithm.
+	envVars, err := getStorageEnvVars(pipelineInfo)
+	require.NoError(t, err)
+	require.OneOfEquals(t, v1.EnvVar{Name: assets.CompressionAlgoEnvVar, Value: "zstd"}, envVars)
+	// Without an algorithm, new chunks aren't compressed.
+	require.NoError(t, os.Unsetenv(assets.CompressionAlgoEnvVar))
+	envVars, err = getStorageEnvVars(pipelineInfo)
+	require.NoError(t, err)
+	for _, envVar := range envVars {
+		require.NotEqual(t, assets.CompressionAlgoEnvVar, envVar.Name)
+	}
+}
@@ -10,13 +10,16 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pps"
-	"github.com/pachyderm/pachyderm/src/server/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pfssync"
 	"github.com/pachyderm/pachyderm/src/server/pkg/tarutil"
@@ -28,6 +31,8 @@ const (
 	MetaPrefix = "meta"
 	// MetaFileName is the name of the meta file.
 	MetaFileName = "meta"
+	// LogFileName is the name of the log file.
+	LogFileName = "logs"
 	// PFSPrefix is the prefix for the pfs path.
 	PFSPrefix = "pfs"
 	// OutputPrefix is the prefix for the output path.
@@ -43,27 +48,40 @@ type AppendFileTarClient interface {
 	AppendFileTar(overwrite bool, r io.Reader, datum ...string) error
 }
 
+// UploadClient is the standard interface for a client that uploads the output of a datum.
+type UploadClient interface {
+	AppendFileTarClient
+	// CopyFile copies a file from a commit by reference.
+	CopyFile(dst, srcRepo, srcCommit, srcPath string, overwrite bool, datum ...string) error
+}
+
 const defaultDatumsPerSet = 10
 
 // SetSpec specifies criteria for creating datum sets.
+// A datum set is cut when either the number of datums reaches Number or
+// the total size of the datum inputs reaches SizeBytes, whichever comes first.
+// A zero value for a field means that criterion is not used.
 type SetSpec struct {
-	Number int
+	Number    int
+	SizeBytes int64
 }
 
 // CreateSets creates datum sets from the passed in datum iterator.
-func CreateSets(dit Iterator, storageRoot string, setSpec *SetSpec, upload func(func(AppendFileTarClient) error) error) error {
-	var metas []*Meta
-	datumsPerSet := defaultDatumsPerSet
-	if setSpec != nil {
-		datumsPerSet = setSpec.Number
+func CreateSets(dit Iterator, storageRoot string, setSpec *SetSpec, upload func(func(UploadClient) error) error) error {
+	if setSpec == nil {
+		setSpec = &SetSpec{Number: defaultDatumsPerSet}
 	}
+	var metas []*Meta
+	var sizeBytes int64
 	if err := dit.Iterate(func(meta *Meta) error {
 		metas = append(metas, meta)
-		if len(metas) >= datumsPerSet {
+		sizeBytes += inputSize(meta)
+		if setSpec.full(len(metas), sizeBytes) {
 			if err := createSet(metas, storageRoot, upload); err != nil {
 				return err
 			}
 			metas = nil
+			sizeBytes = 0
 		}
 		return nil
 	}); err != nil {
@@ -72,8 +90,23 @@ func CreateSets(dit Iterator, storageRoot string, setSpec *SetSpec, upload func(
 	return createSet(metas, storageRoot, upload)
 }
 
-func createSet(metas []*Meta, storageRoot string, upload func(func(AppendFileTarClient) error) error) error {
-	return upload(func(aftc AppendFileTarClient) error {
+func (ss *SetSpec) full(numDatums int, sizeBytes int64) bool {
+	if ss.Number > 0 && numDatums >= ss.Number {
+		return true
+	}
+	return ss.SizeBytes > 0 && sizeBytes >= ss.SizeBytes
+}
+
+func inputSize(meta *Meta) int64 {
+	var size int64
+	for _, input := range meta.Inputs {
+		size += int64(input.FileInfo.SizeBytes)
+	}
+	return size
+}
+
+func createSet(metas []*Meta, storageRoot string, upload func(func(UploadClient) error) error) error {
+	return upload(func(uc UploadClient) error {
 		return WithSet(nil, storageRoot, func(s *Set) error {
 			for _, meta := range metas {
 				d := newDatum(s, meta)
@@ -82,7 +115,7 @@ func createSet(metas []*Meta, storageRoot string, upload func(func(AppendFileTar
 				}
 			}
 			return nil
-		}, WithMetaOutput(aftc))
+		}, WithMetaOutput(uc))
 	})
 }
 
@@ -90,8 +123,9 @@ func createSet(metas []*Meta, storageRoot string, upload func(func(AppendFileTar
 type Set struct {
 	pachClient                        *client.APIClient
 	storageRoot                       string
-	metaOutputClient, pfsOutputClient AppendFileTarClient
+	metaOutputClient, pfsOutputClient UploadClient
 	stats                             *Stats
+	readahead                         int
 }
 
 // WithSet provides a scoped environment for a datum set.
@@ -130,7 +164,9 @@ func (s *Set) WithDatum(ctx context.Context, meta *Meta, cb func(*Datum) error,
 					cancel()
 				}
 			}()
-			return cb(d)
+			return d.withInputs(func() error {
+				return cb(d)
+			})
 		})
 	}, &backoff.ZeroBackOff{}, func(err error, _ time.Duration) error {
 		// TODO: Tagged logger here?
@@ -148,6 +184,7 @@ type Datum struct {
 	numRetries       int
 	recoveryCallback func(context.Context) error
 	timeout          time.Duration
+	logFile          *os.File
 }
 
 func newDatum(set *Set, meta *Meta, opts ...Option) *Datum {
@@ -177,6 +214,13 @@ func (d *Datum) MetaStorageRoot() string {
 	return path.Join(d.storageRoot, MetaPrefix, d.ID)
 }
 
+// LogWriter returns a writer for the datum's log file.
+// The log file is uploaded with the meta output, and log lines from each
+// attempt at processing the datum are appended to it.
+func (d *Datum) LogWriter() io.Writer {
+	return d.logFile
+}
+
 func (d *Datum) finish(err error) (retErr error) {
 	defer func() {
 		if err := MergeProcessStats(d.set.stats.ProcessStats, d.meta.Stats); retErr == nil {
@@ -214,25 +258,63 @@ func (d *Datum) withData(cb func() error) (retErr error) {
 			retErr = err
 		}
 	}()
+	return d.withLogFile(cb)
+}
+
+func (d *Datum) withLogFile(cb func() error) (retErr error) {
+	// The meta directory is cleaned up after the meta output is uploaded.
+	if err := os.MkdirAll(d.MetaStorageRoot(), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path.Join(d.MetaStorageRoot(), LogFileName), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0700)
+	if err != nil {
+		return err
+	}
+	d.logFile = f
+	defer func() {
+		d.logFile = nil
+		if err := f.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	return cb()
+}
+
+func (d *Datum) withInputs(cb func() error) (retErr error) {
+	puller := pfssync.NewPuller()
+	// The lazy files need to be cleaned up before the output is uploaded.
+	defer func() {
+		size, err := puller.CleanUp()
+		d.meta.Stats.DownloadBytes += uint64(size)
+		if retErr == nil {
+			retErr = err
+		}
+	}()
 	// Download input files.
 	// TODO: Move to copy file for inputs to datum file set.
-	if err := d.downloadData(); err != nil {
+	if err := d.downloadData(puller); err != nil {
 		return err
 	}
 	return cb()
 }
 
-func (d *Datum) downloadData() error {
+func (d *Datum) downloadData(puller *pfssync.Puller) error {
 	start := time.Now()
 	d.meta.Stats.DownloadBytes = 0
 	defer func() {
 		d.meta.Stats.DownloadTime = types.DurationProto(time.Since(start))
 	}()
 	for _, input := range d.meta.Inputs {
-		if err := pfssync.Pull(d.set.pachClient, input.FileInfo.File, path.Join(d.PFSStorageRoot(), input.Name), func(hdr *tar.Header) error {
+		if input.GitURL != "" {
+			if err := d.downloadGitData(input, path.Join(d.PFSStorageRoot(), input.Name)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := puller.Pull(d.set.pachClient, input.FileInfo.File, path.Join(d.PFSStorageRoot(), input.Name), input.Lazy, input.EmptyFiles, pfssync.WithHeaderCallback(func(hdr *tar.Header) error {
 			d.meta.Stats.DownloadBytes += uint64(hdr.Size)
 			return nil
-		}); err != nil {
+		}), pfssync.WithReadahead(d.set.readahead)); err != nil {
 			return err
 		}
 	}
@@ -297,17 +379,100 @@ func (d *Datum) uploadOutput() error {
 	if d.set.pfsOutputClient != nil {
 		start := time.Now()
 		d.meta.Stats.UploadBytes = 0
-		if err := d.upload(d.set.pfsOutputClient, path.Join(d.PFSStorageRoot(), OutputPrefix), func(hdr *tar.Header) error {
+		outputRoot := path.Join(d.PFSStorageRoot(), OutputPrefix)
+		// Symlinks to input files are copied by reference rather than uploaded.
+		links, err := d.removeInputLinks(outputRoot)
+		if err != nil {
+			return err
+		}
+		if err := d.upload(d.set.pfsOutputClient, outputRoot, func(hdr *tar.Header) error {
+			// Named pipes (such as lazy input files copied by the user code)
+			// cannot be uploaded.
+			if hdr.Typeflag == tar.TypeFifo {
+				return errors.Errorf("cannot upload named pipe %v", hdr.Name)
+			}
 			d.meta.Stats.UploadBytes += uint64(hdr.Size)
 			return nil
 		}); err != nil {
 			return err
 		}
+		for dst, src := range links {
+			if err := d.set.pfsOutputClient.CopyFile(dst, src.Commit.Repo.Name, src.Commit.ID, src.Path, false, d.ID); err != nil {
+				return err
+			}
+			// The meta output records the files output by the datum, so the
+			// copied files are recorded there as well.
+			if d.set.metaOutputClient != nil {
+				metaDst := path.Join("/", PFSPrefix, d.ID, OutputPrefix, dst)
+				if err := d.set.metaOutputClient.CopyFile(metaDst, src.Commit.Repo.Name, src.Commit.ID, src.Path, false, d.ID); err != nil {
+					return err
+				}
+			}
+		}
 		d.meta.Stats.UploadTime = types.DurationProto(time.Since(start))
 	}
 	return d.uploadMetaOutput()
 }
 
+// removeInputLinks removes the symlinks in the output directory that point
+// into the inputs of the datum, and returns the files they point to keyed by
+// the path of the symlink in the output.
+func (d *Datum) removeInputLinks(outputRoot string) (map[string]*pfs.File, error) {
+	links := make(map[string]*pfs.File)
+	if err := filepath.Walk(outputRoot, func(file string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.Mode()&os.ModeSymlink == 0 {
+			return nil
+		}
+		target, err := os.Readlink(file)
+		if err != nil {
+			return err
+		}
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(file), target)
+		}
+		src := d.inputFile(target)
+		if src == nil {
+			return nil
+		}
+		dst, err := filepath.Rel(outputRoot, file)
+		if err != nil {
+			return err
+		}
+		links[filepath.Join("/", dst)] = src
+		return os.Remove(file)
+	}); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// inputFile returns the input file that target refers to, or nil if target
+// is not in the inputs of the datum.
+func (d *Datum) inputFile(target string) *pfs.File {
+	for _, input := range d.meta.Inputs {
+		if input.GitURL != "" || input.S3 {
+			continue
+		}
+		rel, err := filepath.Rel(path.Join(d.PFSStorageRoot(), input.Name), target)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+			continue
+		}
+		filePath := filepath.Join("/", rel)
+		file := input.FileInfo.File
+		// Inputs with the same name (such as in a group input) share a
+		// directory, so check that the file is under this input's file.
+		prefix := strings.TrimSuffix(filepath.Join("/", file.Path), "/")
+		if prefix != "" && filePath != prefix && !strings.HasPrefix(filePath, prefix+"/") {
+			continue
+		}
+		return client.NewFile(file.Commit.Repo.Name, file.Commit.ID, filePath)
+	}
+	return nil
+}
+
 func (d *Datum) upload(aftc AppendFileTarClient, storageRoot string, cb ...func(*tar.Header) error) error {
 	// TODO: Might make more sense to convert to tar on the fly.
 	f, err := os.Create(path.Join(d.set.storageRoot, TmpFileName))
@@ -350,7 +515,7 @@ func NewDeleter(metaFileWalker fileWalkerFunc, metaOutputClient, pfsOutputClient
 		outputDir := "/" + path.Join(PFSPrefix, ID, OutputPrefix)
 		files, err := metaFileWalker(outputDir)
 		if err != nil {
-			if pfs.IsFileNotFoundErr(err) {
+			if pfsserver.IsFileNotFoundErr(err) {
 				return nil
 			}
 			return err
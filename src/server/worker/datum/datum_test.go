@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
@@ -79,6 +80,78 @@ func TestSet(t *testing.T) {
 	}))
 }
 
+func TestSetLogs(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		inputRepo := tu.UniqueString(t.Name() + "_input")
+		require.NoError(t, c.CreateRepo(inputRepo))
+		outputRepo := tu.UniqueString(t.Name() + "_output")
+		require.NoError(t, c.CreateRepo(outputRepo))
+		inputCommit, err := c.StartCommit(inputRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(inputRepo, inputCommit.ID, "/foo", strings.NewReader("input")))
+		require.NoError(t, c.FinishCommit(inputRepo, inputCommit.ID))
+		in := client.NewPFSInput(inputRepo, "/*")
+		in.Pfs.Commit = inputCommit.ID
+		outputCommit, err := c.StartCommit(outputRepo, "master")
+		require.NoError(t, err)
+		var IDs []string
+		// Process the datum, failing the first attempt.
+		require.NoError(t, c.WithModifyFileClient(outputRepo, outputCommit.ID, func(mfc *client.ModifyFileClient) error {
+			return withTmpDir(func(storageRoot string) error {
+				return WithSet(c, storageRoot, func(s *Set) error {
+					di, err := NewIterator(c, in)
+					if err != nil {
+						return err
+					}
+					return di.Iterate(func(meta *Meta) error {
+						attempt := 0
+						return s.WithDatum(context.Background(), meta, func(d *Datum) error {
+							IDs = append(IDs, d.ID)
+							attempt++
+							if _, err := fmt.Fprintf(d.LogWriter(), "attempt %v\n", attempt); err != nil {
+								return err
+							}
+							if attempt == 1 {
+								return errors.Errorf("failed attempt")
+							}
+							return nil
+						})
+					})
+				}, WithMetaOutput(mfc))
+			})
+		}))
+		require.NoError(t, c.FinishCommit(outputRepo, outputCommit.ID))
+		// Check that the logs from both attempts were persisted.
+		require.Equal(t, 2, len(IDs))
+		buf := &bytes.Buffer{}
+		require.NoError(t, c.GetFile(outputRepo, outputCommit.ID, path.Join(MetaPrefix, IDs[0], LogFileName), buf))
+		require.Equal(t, "attempt 1\nattempt 2\n", buf.String())
+		return nil
+	}))
+}
+
+func TestCreateSets(t *testing.T) {
+	// The mock iterator datum at index i has an input of size i.
+	dit := NewMockIterator(&MockIteratorOptions{Length: 10})
+	countSets := func(setSpec *SetSpec) int {
+		var numSets int
+		require.NoError(t, CreateSets(dit, "", setSpec, func(_ func(UploadClient) error) error {
+			numSets++
+			return nil
+		}))
+		return numSets
+	}
+	require.Equal(t, 4, countSets(&SetSpec{Number: 3}))
+	// Sets are cut at sizes 10 (0-4), 11 (5-6), and 15 (7-8).
+	require.Equal(t, 4, countSets(&SetSpec{SizeBytes: 10}))
+	// Sets are cut at 4 datums (0-3), then at sizes 15 (4-6) and 15 (7-8).
+	require.Equal(t, 4, countSets(&SetSpec{Number: 4, SizeBytes: 10}))
+	require.Equal(t, 1, countSets(&SetSpec{Number: 20}))
+}
+
 func processFiles(outputDir, inputDir string, cb func([]byte) []byte) error {
 	return filepath.Walk(inputDir, func(file string, fi os.FileInfo, err error) (retErr error) {
 		if err != nil {
@@ -0,0 +1,66 @@
+package datum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"gopkg.in/go-playground/webhooks.v5/github"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+)
+
+// GitPayloadFileName is the name of the file the githook server writes the
+// push payload to in a git input repo.
+const GitPayloadFileName = "commit.json"
+
+func (d *Datum) downloadGitData(input *common.Input, dir string) error {
+	file := input.FileInfo.File
+	buf := &bytes.Buffer{}
+	if err := d.set.pachClient.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, buf); err != nil {
+		return err
+	}
+	return checkoutGitPayload(d.set.pachClient.Ctx(), buf.Bytes(), dir)
+}
+
+// checkoutGitPayload clones the repository referenced by a github push
+// payload into dir and checks out the pushed commit.
+func checkoutGitPayload(ctx context.Context, rawPayload []byte, dir string) error {
+	payload := &github.PushPayload{}
+	if err := json.Unmarshal(rawPayload, payload); err != nil {
+		return errors.Wrapf(err, "error parsing git hook payload")
+	}
+	switch {
+	case payload.Repository.CloneURL == "":
+		return errors.New("git hook payload does not specify the upstream URL")
+	case payload.Ref == "":
+		return errors.New("git hook payload does not specify the updated ref")
+	case payload.After == "":
+		return errors.New("git hook payload does not specify the commit SHA")
+	}
+	// Clone checks out a reference, not a SHA. Github does not support
+	// fetching an individual SHA.
+	repo, err := git.PlainCloneContext(ctx, dir, false, &git.CloneOptions{
+		URL:           payload.Repository.CloneURL,
+		SingleBranch:  true,
+		ReferenceName: plumbing.ReferenceName(payload.Ref),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error cloning repo from URL %v", payload.Repository.CloneURL)
+	}
+	// Now, find the SHA from the payload and check it out.
+	hash := plumbing.NewHash(payload.After)
+	if _, err := repo.CommitObject(hash); err != nil {
+		return errors.Wrapf(err, "commit %v not found in repo from URL %v", payload.After, payload.Repository.CloneURL)
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		return errors.Wrapf(err, "error getting worktree for repo from URL %v", payload.Repository.CloneURL)
+	}
+	if err := w.Checkout(&git.CheckoutOptions{Hash: hash}); err != nil {
+		return errors.Wrapf(err, "error checking out SHA %v for repo from URL %v", payload.After, payload.Repository.CloneURL)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package datum
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"gopkg.in/go-playground/webhooks.v5/github"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/config"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+func gitCommit(t *testing.T, repo *git.Repository, dir, file, content string) plumbing.Hash {
+	t.Helper()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, file), []byte(content), 0644))
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = w.Add(file)
+	require.NoError(t, err)
+	hash, err := w.Commit(content, &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return hash
+}
+
+func gitPayload(t *testing.T, cloneURL, ref string, hash plumbing.Hash) []byte {
+	t.Helper()
+	payload := &github.PushPayload{Ref: ref, After: hash.String()}
+	payload.Repository.CloneURL = cloneURL
+	raw, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return raw
+}
+
+func TestCheckoutGitPayload(t *testing.T) {
+	root, err := ioutil.TempDir("", "git")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	// Setup a local bare repository with two commits pushed to master.
+	bareDir := filepath.Join(root, "bare.git")
+	_, err = git.PlainInit(bareDir, true)
+	require.NoError(t, err)
+	workDir := filepath.Join(root, "work")
+	workRepo, err := git.PlainInit(workDir, false)
+	require.NoError(t, err)
+	_, err = workRepo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{bareDir}})
+	require.NoError(t, err)
+	hash1 := gitCommit(t, workRepo, workDir, "file", "foo")
+	hash2 := gitCommit(t, workRepo, workDir, "file", "bar")
+	require.NoError(t, workRepo.Push(&git.PushOptions{RemoteName: "origin"}))
+
+	ctx := context.Background()
+	checkout := func(name string, hash plumbing.Hash) string {
+		dir := filepath.Join(root, name)
+		require.NoError(t, checkoutGitPayload(ctx, gitPayload(t, bareDir, "refs/heads/master", hash), dir))
+		repo, err := git.PlainOpen(dir)
+		require.NoError(t, err)
+		head, err := repo.Head()
+		require.NoError(t, err)
+		require.Equal(t, hash.String(), head.Hash().String())
+		data, err := ioutil.ReadFile(filepath.Join(dir, "file"))
+		require.NoError(t, err)
+		return string(data)
+	}
+	t.Run("Latest", func(t *testing.T) {
+		require.Equal(t, "bar", checkout("latest", hash2))
+	})
+	t.Run("Previous", func(t *testing.T) {
+		require.Equal(t, "foo", checkout("previous", hash1))
+	})
+	t.Run("MissingCommit", func(t *testing.T) {
+		missing := plumbing.NewHash("0123456789012345678901234567890123456789")
+		require.YesError(t, checkoutGitPayload(ctx, gitPayload(t, bareDir, "refs/heads/master", missing), filepath.Join(root, "missing")))
+	})
+	t.Run("InvalidPayload", func(t *testing.T) {
+		require.YesError(t, checkoutGitPayload(ctx, gitPayload(t, "", "refs/heads/master", hash2), filepath.Join(root, "invalid")))
+		require.YesError(t, checkoutGitPayload(ctx, []byte("{"), filepath.Join(root, "invalid")))
+	})
+}
@@ -2,15 +2,25 @@ package datum
 
 import (
 	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"path"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	glob "github.com/pachyderm/ohmyglob"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/storage/renew"
 	"github.com/pachyderm/pachyderm/src/server/pkg/stream"
 	"github.com/pachyderm/pachyderm/src/server/worker/common"
 )
@@ -45,15 +55,28 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	repo := pi.input.Repo
 	commit := pi.input.Commit
 	pattern := pi.input.Glob
+	g, err := glob.Compile(cleanGlob(pattern), '/')
+	if err != nil {
+		return err
+	}
 	return pi.pachClient.GlobFile(repo, commit, pattern, func(fi *pfs.FileInfo) error {
-		// TODO: Implement joins.
-		//g := glob.MustCompile(pi.input.Glob, '/')
-		//joinOn := g.Replace(fi.File.Path, pi.input.JoinOn)
+		// The same glob used to match the path is used to extract the join
+		// and group capture groups, with any trailing slash on directories
+		// removed.
+		matchPath := strings.TrimRight(fi.File.Path, "/")
+		var joinOn, groupBy string
+		if pi.input.JoinOn != "" {
+			joinOn = g.Replace(matchPath, pi.input.JoinOn)
+		}
+		if pi.input.GroupBy != "" {
+			groupBy = g.Replace(matchPath, pi.input.GroupBy)
+		}
 		return cb(&Meta{
 			Inputs: []*common.Input{
 				&common.Input{
-					FileInfo: fi,
-					//JoinOn:     joinOn,
+					FileInfo:   fi,
+					JoinOn:     joinOn,
+					GroupBy:    groupBy,
 					Name:       pi.input.Name,
 					Lazy:       pi.input.Lazy,
 					Branch:     pi.input.Branch,
@@ -65,6 +88,10 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	})
 }
 
+func cleanGlob(pattern string) string {
+	return "/" + strings.Trim(pattern, "/")
+}
+
 type unionIterator struct {
 	iterators []Iterator
 }
@@ -118,39 +145,38 @@ func iterate(crossInputs []*common.Input, iterators []Iterator, cb func(*Meta) e
 	})
 }
 
-// TODO: Need inspect file.
-//type gitIterator struct {
-//	inputs []*common.Input
-//}
-//
-//func newGitIterator(pachClient *client.APIClient, input *pps.GitInput) (Iterator, error) {
-//	if input.Commit == "" {
-//		// this can happen if a pipeline with multiple inputs has been triggered
-//		// before all commits have inputs
-//		return &gitIterator{}, nil
-//	}
-//	fi, err := pachClient.InspectFile(input.Name, input.Commit, "/commit.json")
-//	if err != nil {
-//		return nil, err
-//	}
-//	return &gitIterator{
-//		inputs: []*common.Input{
-//			&common.Input{
-//				FileInfo: fi,
-//				Name:     input.Name,
-//				Branch:   input.Branch,
-//				GitURL:   input.URL,
-//			},
-//		},
-//	}, nil
-//}
-//
-//func (gi *gitIterator) Iterate(cb func([]*common.Input) error) error {
-//	if len(gi.inputs) == 0 {
-//		return nil
-//	}
-//	return cb(gi.inputs)
-//}
+type gitIterator struct {
+	inputs []*common.Input
+}
+
+func newGitIterator(pachClient *client.APIClient, input *pps.GitInput) (Iterator, error) {
+	if input.Commit == "" {
+		// this can happen if a pipeline with multiple inputs has been triggered
+		// before all commits have inputs
+		return &gitIterator{}, nil
+	}
+	fi, err := pachClient.InspectFile(input.Name, input.Commit, "/"+GitPayloadFileName)
+	if err != nil {
+		return nil, err
+	}
+	return &gitIterator{
+		inputs: []*common.Input{
+			&common.Input{
+				FileInfo: fi,
+				Name:     input.Name,
+				Branch:   input.Branch,
+				GitURL:   input.URL,
+			},
+		},
+	}, nil
+}
+
+func (gi *gitIterator) Iterate(cb func(*Meta) error) error {
+	if len(gi.inputs) == 0 {
+		return nil
+	}
+	return cb(&Meta{Inputs: gi.inputs})
+}
 
 func newCronIterator(pachClient *client.APIClient, input *pps.CronInput) Iterator {
 	return newPFSIterator(pachClient, &pps.PFSInput{
@@ -192,24 +218,35 @@ func (ji *jobIterator) Iterate(cb func(*Meta) error) error {
 }
 
 type fileSetIterator struct {
-	pachClient   *client.APIClient
-	repo, commit string
+	getTarFile func(glob string) (io.Reader, error)
 }
 
 // NewFileSetIterator creates a new fileset iterator.
 func NewFileSetIterator(pachClient *client.APIClient, repo, commit string) Iterator {
-	return &fileSetIterator{
-		pachClient: pachClient,
-		repo:       repo,
-		commit:     commit,
-	}
+	return NewFileSetIteratorFromReader(func(glob string) (io.Reader, error) {
+		return pachClient.GetTarFile(repo, commit, glob)
+	})
 }
 
-func (fsi *fileSetIterator) Iterate(cb func(*Meta) error) error {
-	r, err := fsi.pachClient.GetTarFile(fsi.repo, fsi.commit, path.Join("/", MetaPrefix, "*", MetaFileName))
+// NewFileSetIteratorFromReader creates a new fileset iterator that reads the
+// meta files from the tar stream returned by getTarFile. The stream is closed
+// when iteration ends if it is an io.Closer.
+func NewFileSetIteratorFromReader(getTarFile func(glob string) (io.Reader, error)) Iterator {
+	return &fileSetIterator{getTarFile: getTarFile}
+}
+
+func (fsi *fileSetIterator) Iterate(cb func(*Meta) error) (retErr error) {
+	r, err := fsi.getTarFile(path.Join("/", MetaPrefix, "*", MetaFileName))
 	if err != nil {
 		return err
 	}
+	if c, ok := r.(io.Closer); ok {
+		defer func() {
+			if err := c.Close(); retErr == nil {
+				retErr = err
+			}
+		}()
+	}
 	tr := tar.NewReader(r)
 	for {
 		_, err := tr.Next()
@@ -302,85 +339,233 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 		return newUnionIterator(pachClient, input.Union)
 	case input.Cross != nil:
 		return newCrossIterator(pachClient, input.Cross)
-	//case input.Join != nil:
-	//	return newJoinIterator(pachClient, input.Join)
+	case input.Join != nil:
+		return newJoinIterator(pachClient, input.Join)
+	case input.Group != nil:
+		return newGroupIterator(pachClient, input.Group)
 	case input.Cron != nil:
 		return newCronIterator(pachClient, input.Cron), nil
-		//case input.Git != nil:
-		//	return newGitIterator(pachClient, input.Git)
+	case input.Git != nil:
+		return newGitIterator(pachClient, input.Git)
 	}
 	return nil, errors.Errorf("unrecognized input type: %v", input)
 }
 
-// TODO: Implement joins.
-//type joinIterator struct {
-//	datums   [][]*common.Input
-//	location int
-//}
-//
-//func newJoinIterator(pachClient *client.APIClient, join []*pps.Input) (Iterator, error) {
-//	result := &joinIterator{}
-//	om := ordered_map.NewOrderedMap()
-//
-//	for i, input := range join {
-//		datumIterator, err := NewIterator(pachClient, input)
-//		if err != nil {
-//			return nil, err
-//		}
-//		for datumIterator.Next() {
-//			x := datumIterator.Datum()
-//			for _, k := range x {
-//				tupleI, ok := om.Get(k.JoinOn)
-//				var tuple [][]*common.Input
-//				if !ok {
-//					tuple = make([][]*common.Input, len(join))
-//				} else {
-//					tuple = tupleI.([][]*common.Input)
-//				}
-//				tuple[i] = append(tuple[i], k)
-//				om.Set(k.JoinOn, tuple)
-//			}
-//		}
-//	}
-//
-//	iter := om.IterFunc()
-//	for kv, ok := iter(); ok; kv, ok = iter() {
-//		tuple := kv.Value.([][]*common.Input)
-//		cross, err := newCrossListIterator(pachClient, tuple)
-//		if err != nil {
-//			return nil, err
-//		}
-//		for cross.Next() {
-//			result.datums = append(result.datums, cross.Datum())
-//		}
-//	}
-//	result.location = -1
-//	return result, nil
-//}
-//
-//func (d *joinIterator) Reset() {
-//	d.location = -1
-//}
-//
-//func (d *joinIterator) Len() int {
-//	return len(d.datums)
-//}
-//
-//func (d *joinIterator) Next() bool {
-//	if d.location < len(d.datums) {
-//		d.location++
-//	}
-//	return d.location < len(d.datums)
-//}
-//
-//func (d *joinIterator) Datum() []*common.Input {
-//	var result []*common.Input
-//	result = append(result, d.datums[d.location]...)
-//	sortInputs(result)
-//	return result
-//}
-//
-//func (d *joinIterator) DatumN(n int) []*common.Input {
-//	d.location = n
-//	return d.Datum()
-//}
+type joinIterator struct {
+	pachClient *client.APIClient
+	iterators  []Iterator
+	outerJoins []bool
+}
+
+func newJoinIterator(pachClient *client.APIClient, join []*pps.Input) (Iterator, error) {
+	ji := &joinIterator{
+		pachClient: pachClient,
+	}
+	for _, input := range join {
+		di, err := NewIterator(pachClient, input)
+		if err != nil {
+			return nil, err
+		}
+		ji.iterators = append(ji.iterators, di)
+		ji.outerJoins = append(ji.outerJoins, input.Pfs != nil && input.Pfs.OuterJoin)
+	}
+	return ji, nil
+}
+
+// Iterate writes the inputs of each child iterator into a temporary fileset
+// keyed by join key, then streams the fileset back in key order so that only
+// the inputs for a single join key are held in memory at a time. The joined
+// datums are written to a second temporary fileset keyed by datum ID, so they
+// are iterated in the same order as the other iterators.
+func (ji *joinIterator) Iterate(cb func(*Meta) error) error {
+	return ji.pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
+		pachClient := ji.pachClient.WithCtx(ctx)
+		keyed, err := withTmpFileSet(pachClient, renewer, func(ctfsc *client.CreateFilesetClient) error {
+			for i, di := range ji.iterators {
+				var seq int
+				if err := di.Iterate(func(meta *Meta) error {
+					for _, input := range meta.Inputs {
+						if err := appendProto(ctfsc, joinPath(input.JoinOn, i, seq), input); err != nil {
+							return err
+						}
+						seq++
+					}
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		joined, err := withTmpFileSet(pachClient, renewer, func(ctfsc *client.CreateFilesetClient) error {
+			return ji.join(pachClient, keyed, func(meta *Meta) error {
+				return appendProto(ctfsc, path.Join(MetaPrefix, common.DatumID(meta.Inputs), MetaFileName), meta)
+			})
+		})
+		if err != nil {
+			return err
+		}
+		return NewFileSetIterator(pachClient, client.TmpRepoName, joined).Iterate(cb)
+	})
+}
+
+func (ji *joinIterator) join(pachClient *client.APIClient, fileSet string, cb func(*Meta) error) error {
+	r, err := pachClient.GetTarFile(client.TmpRepoName, fileSet, "/*/*/*")
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	var key string
+	var joined [][]*common.Input
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return ji.emit(joined, cb)
+			}
+			return err
+		}
+		k, i, err := parseJoinPath(hdr.Name)
+		if err != nil {
+			return err
+		}
+		if joined == nil || k != key {
+			if err := ji.emit(joined, cb); err != nil {
+				return err
+			}
+			key = k
+			joined = make([][]*common.Input, len(ji.iterators))
+		}
+		input := &common.Input{}
+		if err := jsonpb.Unmarshal(tr, input); err != nil {
+			return err
+		}
+		joined[i] = append(joined[i], input)
+	}
+}
+
+// emit crosses the inputs that share a join key. A key that is missing from
+// some of the inputs only produces datums if one of the inputs it is present
+// in is an outer join.
+func (ji *joinIterator) emit(joined [][]*common.Input, cb func(*Meta) error) error {
+	if joined == nil {
+		return nil
+	}
+	var present [][]*common.Input
+	var missing, outer bool
+	for i, inputs := range joined {
+		if len(inputs) == 0 {
+			missing = true
+			continue
+		}
+		present = append(present, inputs)
+		outer = outer || ji.outerJoins[i]
+	}
+	if missing && !outer {
+		return nil
+	}
+	return crossInputs(nil, present, cb)
+}
+
+func crossInputs(prefix []*common.Input, inputs [][]*common.Input, cb func(*Meta) error) error {
+	if len(inputs) == 0 {
+		return cb(&Meta{Inputs: prefix})
+	}
+	for _, input := range inputs[0] {
+		if err := crossInputs(append(prefix[:len(prefix):len(prefix)], input), inputs[1:], cb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type groupIterator struct {
+	iterators []Iterator
+}
+
+func newGroupIterator(pachClient *client.APIClient, group []*pps.Input) (Iterator, error) {
+	gi := &groupIterator{}
+	for _, input := range group {
+		di, err := NewIterator(pachClient, input)
+		if err != nil {
+			return nil, err
+		}
+		gi.iterators = append(gi.iterators, di)
+	}
+	return gi, nil
+}
+
+// Iterate gathers the inputs from each child iterator that share a group by
+// key into a single datum. The groups are held in memory since each group
+// becomes a single datum, and the datums are iterated in datum ID order.
+func (gi *groupIterator) Iterate(cb func(*Meta) error) error {
+	groups := make(map[string]*Meta)
+	for _, di := range gi.iterators {
+		if err := di.Iterate(func(meta *Meta) error {
+			for _, input := range meta.Inputs {
+				group, ok := groups[input.GroupBy]
+				if !ok {
+					group = &Meta{}
+					groups[input.GroupBy] = group
+				}
+				group.Inputs = append(group.Inputs, input)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	var metas []*Meta
+	for _, meta := range groups {
+		metas = append(metas, meta)
+	}
+	sort.Slice(metas, func(i, j int) bool {
+		return common.DatumID(metas[i].Inputs) < common.DatumID(metas[j].Inputs)
+	})
+	for _, meta := range metas {
+		if err := cb(meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func withTmpFileSet(pachClient *client.APIClient, renewer *renew.StringSet, cb func(*client.CreateFilesetClient) error) (string, error) {
+	resp, err := pachClient.WithCreateFilesetClient(cb)
+	if err != nil {
+		return "", err
+	}
+	renewer.Add(resp.FilesetId)
+	return resp.FilesetId, nil
+}
+
+func appendProto(ctfsc *client.CreateFilesetClient, p string, pb proto.Message) error {
+	buf := &bytes.Buffer{}
+	if err := (&jsonpb.Marshaler{}).Marshal(buf, pb); err != nil {
+		return err
+	}
+	return ctfsc.AppendFile(p, false, buf)
+}
+
+// joinPath returns the path in the join fileset for the seq'th input of the
+// i'th child iterator. The join key is hex encoded so that arbitrary keys
+// (including the empty key) are valid path components while preserving their
+// sort order.
+func joinPath(joinOn string, i, seq int) string {
+	return path.Join("/", "k"+hex.EncodeToString([]byte(joinOn)), fmt.Sprintf("%016d", i), fmt.Sprintf("%016d", seq))
+}
+
+func parseJoinPath(p string) (string, int, error) {
+	parts := strings.Split(strings.Trim(p, "/"), "/")
+	if len(parts) != 3 {
+		return "", 0, errors.Errorf("invalid join path: %v", p)
+	}
+	i, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "invalid join path: %v", p)
+	}
+	return parts[0], i, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dbutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
@@ -80,34 +81,69 @@ func TestIterators(t *testing.T) {
 			require.NoError(t, err)
 			validateDI(t, cross4)
 		})
-		//      TODO: Convert these tests when join and s3 inputs are supported.
 		// in[8-9] are elements of in10, which is a join input
-		//in8 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$1$2", "", false, false, nil)
-		//in8.Pfs.Commit = commit.ID
-		//in9 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$2$1", "", false, false, nil)
-		//in9.Pfs.Commit = commit.ID
-		//in10 := client.NewJoinInput(in8, in9)
-		//t.Run("Join", func(t *testing.T) {
-		//	join1, err := NewIterator(c, in10)
-		//	require.NoError(t, err)
-		//	validateDI(t, join1,
-		//		"/foo11/foo11",
-		//		"/foo12/foo21",
-		//		"/foo13/foo31",
-		//		"/foo14/foo41",
-		//		"/foo21/foo12",
-		//		"/foo22/foo22",
-		//		"/foo23/foo32",
-		//		"/foo24/foo42",
-		//		"/foo31/foo13",
-		//		"/foo32/foo23",
-		//		"/foo33/foo33",
-		//		"/foo34/foo43",
-		//		"/foo41/foo14",
-		//		"/foo42/foo24",
-		//		"/foo43/foo34",
-		//		"/foo44/foo44")
-		//})
+		in8 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$1$2", "", false, false, nil)
+		in8.Pfs.Commit = commit.ID
+		in9 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$2$1", "", false, false, nil)
+		in9.Pfs.Commit = commit.ID
+		in10 := client.NewJoinInput(in8, in9)
+		t.Run("Join", func(t *testing.T) {
+			join1, err := NewIterator(c, in10)
+			require.NoError(t, err)
+			validateDI(t, join1,
+				"/foo11/foo11",
+				"/foo12/foo21",
+				"/foo13/foo31",
+				"/foo14/foo41",
+				"/foo21/foo12",
+				"/foo22/foo22",
+				"/foo23/foo32",
+				"/foo24/foo42",
+				"/foo31/foo13",
+				"/foo32/foo23",
+				"/foo33/foo33",
+				"/foo34/foo43",
+				"/foo41/foo14",
+				"/foo42/foo24",
+				"/foo43/foo34",
+				"/foo44/foo44")
+		})
+		// in[8-9] with in8 as an outer join.
+		inOuter := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$1$2", "", true, false, nil)
+		inOuter.Pfs.Commit = commit.ID
+		t.Run("OuterJoin", func(t *testing.T) {
+			join2, err := NewIterator(c, client.NewJoinInput(inOuter, in9))
+			require.NoError(t, err)
+			var expected []string
+			for i := 1; i < 5; i++ {
+				for j := 0; j < 10; j++ {
+					if j > 0 && j < 5 {
+						expected = append(expected, fmt.Sprintf("/foo%v%v/foo%v%v", i, j, j, i))
+						continue
+					}
+					expected = append(expected, fmt.Sprintf("/foo%v%v", i, j))
+				}
+			}
+			validateDI(t, join2, expected...)
+		})
+		// A join nested in a cross and a union.
+		in0Join := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$1$2", "", false, false, nil)
+		in0Join.Pfs.Commit = commit.ID
+		in1Join := client.NewPFSInputOpts("", dataRepo, "", "/foo(1)(1)", "$1$2", "", false, false, nil)
+		in1Join.Pfs.Commit = commit.ID
+		t.Run("NestedJoin", func(t *testing.T) {
+			cross, err := NewIterator(c, client.NewCrossInput(client.NewJoinInput(in0Join, in1Join), in1))
+			require.NoError(t, err)
+			validateDI(t, cross,
+				"/foo11/foo11/foo11",
+				"/foo11/foo11/foo21",
+				"/foo11/foo11/foo31",
+				"/foo11/foo11/foo41",
+			)
+			union, err := NewIterator(c, client.NewUnionInput(client.NewJoinInput(in0Join, in1Join), in2))
+			require.NoError(t, err)
+			validateDI(t, union, "/foo11/foo11", "/foo12", "/foo2", "/foo22", "/foo32", "/foo42")
+		})
 
 		//// in11 is an S3 input
 		//in11 := client.NewS3PFSInput("", dataRepo, "")
@@ -180,193 +216,228 @@ func TestIterators(t *testing.T) {
 		//		"checked: %v, s3Count: %v", checked, s3Count)
 		//})
 
-		//in14 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "", "$1", false, false, nil)
-		//in14.Pfs.Commit = commit.ID
-		//in15 := client.NewGroupInput(in14)
-		//t.Run("GroupSingle", func(t *testing.T) {
-		//	group1, err := NewIterator(c, in15)
-		//	require.NoError(t, err)
-		//	validateDI(t, group1,
-		//		"/foo10/foo11/foo12/foo13/foo14/foo15/foo16/foo17/foo18/foo19",
-		//		"/foo20/foo21/foo22/foo23/foo24/foo25/foo26/foo27/foo28/foo29",
-		//		"/foo30/foo31/foo32/foo33/foo34/foo35/foo36/foo37/foo38/foo39",
-		//		"/foo40/foo41/foo42/foo43/foo44/foo45/foo46/foo47/foo48/foo49")
-		//})
+		in14 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "", "$1", false, false, nil)
+		in14.Pfs.Commit = commit.ID
+		in15 := client.NewGroupInput(in14)
+		t.Run("GroupSingle", func(t *testing.T) {
+			group1, err := NewIterator(c, in15)
+			require.NoError(t, err)
+			validateDI(t, group1,
+				"/foo10/foo11/foo12/foo13/foo14/foo15/foo16/foo17/foo18/foo19",
+				"/foo20/foo21/foo22/foo23/foo24/foo25/foo26/foo27/foo28/foo29",
+				"/foo30/foo31/foo32/foo33/foo34/foo35/foo36/foo37/foo38/foo39",
+				"/foo40/foo41/foo42/foo43/foo44/foo45/foo46/foo47/foo48/foo49")
+		})
 
-		//in16 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "", "$1", false, false, nil)
-		//in16.Pfs.Commit = commit.ID
-		//in17 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "", "$2", false, false, nil)
-		//in17.Pfs.Commit = commit.ID
-		//in18 := client.NewGroupInput(in16, in17)
-		//t.Run("GroupDoubles", func(t *testing.T) {
-		//	group2, err := NewIterator(c, in18)
-		//	require.NoError(t, err)
-		//	validateDI(t, group2,
-		//		"/foo10/foo20/foo30/foo40",
-		//		"/foo10/foo11/foo12/foo13/foo14/foo15/foo16/foo17/foo18/foo19/foo11/foo21/foo31/foo41",
-		//		"/foo20/foo21/foo22/foo23/foo24/foo25/foo26/foo27/foo28/foo29/foo12/foo22/foo32/foo42",
-		//		"/foo30/foo31/foo32/foo33/foo34/foo35/foo36/foo37/foo38/foo39/foo13/foo23/foo33/foo43",
-		//		"/foo40/foo41/foo42/foo43/foo44/foo45/foo46/foo47/foo48/foo49/foo14/foo24/foo34/foo44",
-		//		"/foo15/foo25/foo35/foo45",
-		//		"/foo16/foo26/foo36/foo46",
-		//		"/foo17/foo27/foo37/foo47",
-		//		"/foo18/foo28/foo38/foo48",
-		//		"/foo19/foo29/foo39/foo49")
-		//})
+		in16 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "", "$1", false, false, nil)
+		in16.Pfs.Commit = commit.ID
+		in17 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "", "$2", false, false, nil)
+		in17.Pfs.Commit = commit.ID
+		in18 := client.NewGroupInput(in16, in17)
+		t.Run("GroupDoubles", func(t *testing.T) {
+			group2, err := NewIterator(c, in18)
+			require.NoError(t, err)
+			// Groups are iterated in datum ID order.
+			validateDI(t, group2,
+				"/foo10/foo11/foo12/foo13/foo14/foo15/foo16/foo17/foo18/foo19/foo11/foo21/foo31/foo41",
+				"/foo10/foo20/foo30/foo40",
+				"/foo15/foo25/foo35/foo45",
+				"/foo16/foo26/foo36/foo46",
+				"/foo17/foo27/foo37/foo47",
+				"/foo18/foo28/foo38/foo48",
+				"/foo19/foo29/foo39/foo49",
+				"/foo20/foo21/foo22/foo23/foo24/foo25/foo26/foo27/foo28/foo29/foo12/foo22/foo32/foo42",
+				"/foo30/foo31/foo32/foo33/foo34/foo35/foo36/foo37/foo38/foo39/foo13/foo23/foo33/foo43",
+				"/foo40/foo41/foo42/foo43/foo44/foo45/foo46/foo47/foo48/foo49/foo14/foo24/foo34/foo44")
+		})
 
-		//in19 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$1$2", "$1", false, false, nil)
-		//in19.Pfs.Commit = commit.ID
-		//in20 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$2$1", "$2", false, false, nil)
-		//in20.Pfs.Commit = commit.ID
+		in19 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$1$2", "$1", false, false, nil)
+		in19.Pfs.Commit = commit.ID
+		in20 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$2$1", "$2", false, false, nil)
+		in20.Pfs.Commit = commit.ID
 
-		//in21 := client.NewJoinInput(in19, in20)
-		//in22 := client.NewGroupInput(in21)
-		//t.Run("GroupJoin", func(t *testing.T) {
-		//	groupJoin1, err := NewIterator(c, in22)
-		//	require.NoError(t, err)
-		//	validateDI(t, groupJoin1,
-		//		"/foo11/foo11/foo12/foo21/foo13/foo31/foo14/foo41",
-		//		"/foo21/foo12/foo22/foo22/foo23/foo32/foo24/foo42",
-		//		"/foo31/foo13/foo32/foo23/foo33/foo33/foo34/foo43",
-		//		"/foo41/foo14/foo42/foo24/foo43/foo34/foo44/foo44")
-		//})
+		in21 := client.NewJoinInput(in19, in20)
+		in22 := client.NewGroupInput(in21)
+		t.Run("GroupJoin", func(t *testing.T) {
+			groupJoin1, err := NewIterator(c, in22)
+			require.NoError(t, err)
+			validateDI(t, groupJoin1,
+				"/foo11/foo11/foo12/foo21/foo13/foo31/foo14/foo41",
+				"/foo21/foo12/foo22/foo22/foo23/foo32/foo24/foo42",
+				"/foo31/foo13/foo32/foo23/foo33/foo33/foo34/foo43",
+				"/foo41/foo14/foo42/foo24/foo43/foo34/foo44/foo44")
+		})
 
-		//in23 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$1$2", "$1", false, false, nil)
-		//in23.Pfs.Commit = commit.ID
-		//in24 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$2$1", "$2", false, false, nil)
-		//in24.Pfs.Commit = commit.ID
+		in23 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$1$2", "$1", false, false, nil)
+		in23.Pfs.Commit = commit.ID
+		in24 := client.NewPFSInputOpts("", dataRepo, "", "/foo(?)(?)", "$2$1", "$2", false, false, nil)
+		in24.Pfs.Commit = commit.ID
 
-		//in25 := client.NewGroupInput(in24)
-		//in26 := client.NewUnionInput(in23, in25)
+		in25 := client.NewGroupInput(in24)
+		in26 := client.NewUnionInput(in23, in25)
 
-		//t.Run("UnionGroup", func(t *testing.T) {
-		//	unionGroup1, err := NewIterator(c, in26)
-		//	require.NoError(t, err)
-		//	validateDI(t, unionGroup1,
-		//		"/foo10",
-		//		"/foo11",
-		//		"/foo12",
-		//		"/foo13",
-		//		"/foo14",
-		//		"/foo15",
-		//		"/foo16",
-		//		"/foo17",
-		//		"/foo18",
-		//		"/foo19",
-		//		"/foo20",
-		//		"/foo21",
-		//		"/foo22",
-		//		"/foo23",
-		//		"/foo24",
-		//		"/foo25",
-		//		"/foo26",
-		//		"/foo27",
-		//		"/foo28",
-		//		"/foo29",
-		//		"/foo30",
-		//		"/foo31",
-		//		"/foo32",
-		//		"/foo33",
-		//		"/foo34",
-		//		"/foo35",
-		//		"/foo36",
-		//		"/foo37",
-		//		"/foo38",
-		//		"/foo39",
-		//		"/foo40",
-		//		"/foo41",
-		//		"/foo42",
-		//		"/foo43",
-		//		"/foo44",
-		//		"/foo45",
-		//		"/foo46",
-		//		"/foo47",
-		//		"/foo48",
-		//		"/foo49",
-		//		"/foo10/foo20/foo30/foo40",
-		//		"/foo11/foo21/foo31/foo41",
-		//		"/foo12/foo22/foo32/foo42",
-		//		"/foo13/foo23/foo33/foo43",
-		//		"/foo14/foo24/foo34/foo44",
-		//		"/foo15/foo25/foo35/foo45",
-		//		"/foo16/foo26/foo36/foo46",
-		//		"/foo17/foo27/foo37/foo47",
-		//		"/foo18/foo28/foo38/foo48",
-		//		"/foo19/foo29/foo39/foo49")
-		//})
+		t.Run("UnionGroup", func(t *testing.T) {
+			unionGroup1, err := NewIterator(c, in26)
+			require.NoError(t, err)
+			// The union merges the plain datums and the grouped datums by datum ID.
+			var expected []string
+			for i := 1; i < 5; i++ {
+				for j := 0; j < 10; j++ {
+					expected = append(expected, fmt.Sprintf("/foo%v%v", i, j))
+					if i == 1 {
+						expected = append(expected, fmt.Sprintf("/foo1%v/foo2%v/foo3%v/foo4%v", j, j, j, j))
+					}
+				}
+			}
+			validateDI(t, unionGroup1, expected...)
+		})
 		return nil
 	}))
 }
 
-//      TODO: Convert these tests when join and s3 inputs are supported.
-// TestJoinOnTrailingSlash tests that the same glob pattern is used for
+func TestGroupIterator(t *testing.T) {
+	single := func() Iterator {
+		return &groupIterator{
+			iterators: []Iterator{NewMockIterator(&MockIteratorOptions{Length: 6, Groups: 3})},
+		}
+	}
+	t.Run("Single", func(t *testing.T) {
+		validateDI(t, single(),
+			"/path-00000000/path-00000003",
+			"/path-00000001/path-00000004",
+			"/path-00000002/path-00000005",
+		)
+	})
+	t.Run("Doubles", func(t *testing.T) {
+		group := &groupIterator{
+			iterators: []Iterator{
+				NewMockIterator(&MockIteratorOptions{Length: 4, Groups: 2}),
+				NewMockIterator(&MockIteratorOptions{Start: 4, Length: 3, Groups: 3}),
+			},
+		}
+		validateDI(t, group,
+			"/path-00000000/path-00000002/path-00000006",
+			"/path-00000001/path-00000003/path-00000004",
+			"/path-00000005",
+		)
+	})
+	t.Run("MultipleInputs", func(t *testing.T) {
+		group := &groupIterator{
+			iterators: []Iterator{NewMockIterator(&MockIteratorOptions{Length: 4, Inputs: 2, Groups: 2})},
+		}
+		validateDI(t, group,
+			"/path-00000000/path-00000000/path-00000002/path-00000002",
+			"/path-00000001/path-00000001/path-00000003/path-00000003",
+		)
+	})
+	t.Run("Cross", func(t *testing.T) {
+		cross := &crossIterator{
+			iterators: []Iterator{
+				single(),
+				NewMockIterator(&MockIteratorOptions{Start: 10, Length: 2}),
+			},
+		}
+		validateDI(t, cross,
+			"/path-00000000/path-00000003/path-00000010",
+			"/path-00000000/path-00000003/path-00000011",
+			"/path-00000001/path-00000004/path-00000010",
+			"/path-00000001/path-00000004/path-00000011",
+			"/path-00000002/path-00000005/path-00000010",
+			"/path-00000002/path-00000005/path-00000011",
+		)
+	})
+	t.Run("Union", func(t *testing.T) {
+		union := &unionIterator{
+			iterators: []Iterator{
+				single(),
+				NewMockIterator(&MockIteratorOptions{Start: 10, Length: 2}),
+			},
+		}
+		validateDI(t, union,
+			"/path-00000000/path-00000003",
+			"/path-00000001/path-00000004",
+			"/path-00000002/path-00000005",
+			"/path-00000010",
+			"/path-00000011",
+		)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		group := &groupIterator{
+			iterators: []Iterator{NewMockIterator(&MockIteratorOptions{Groups: 2})},
+		}
+		validateDI(t, group)
+	})
+}
+
+// TestJoinTrailingSlash tests that the same glob pattern is used for
 // extracting JoinOn and GroupBy capture groups as is used to match paths. Tests
 // the fix for https://github.com/pachyderm/pachyderm/issues/5365
-//func TestJoinTrailingSlash(t *testing.T) {
-//	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
-//		c := env.PachClient
-//		repo := []string{ // singular name b/c we only refer to individual elements
-//			tu.UniqueString(t.Name() + "_0"),
-//			tu.UniqueString(t.Name() + "_1"),
-//		}
-//		input := []*pps.Input{ // singular name b/c only use individual elements
-//			client.NewPFSInputOpts("", repo[0],
-//				/* commit--set below */ "", "/*", "$1", "", false, false, nil),
-//			client.NewPFSInputOpts("", repo[1],
-//				/* commit--set below */ "", "/*", "$1", "", false, false, nil),
-//		}
-//		require.NoError(t, c.CreateRepo(repo[0]))
-//		require.NoError(t, c.CreateRepo(repo[1]))
-//
-//		// put files in structured in a way so that there are many ways to glob it
-//		for i := 0; i < 2; i++ {
-//			commit, err := c.StartCommit(repo[i], "master")
-//			require.NoError(t, err)
-//			for j := 0; j < 10; j++ {
-//				_, err = c.PutFile(repo[i], commit.ID, fmt.Sprintf("foo-%v", j), strings.NewReader("bar"))
-//				require.NoError(t, err)
-//			}
-//			require.NoError(t, err)
-//			require.NoError(t, c.FinishCommit(repo[i], commit.ID))
-//			input[i].Pfs.Commit = commit.ID
-//		}
-//
-//		// Test without trailing slashes
-//		input[0].Pfs.Glob = "/(*)"
-//		input[1].Pfs.Glob = "/(*)"
-//		itr, err := NewIterator(c, client.NewJoinInput(input...))
-//		require.NoError(t, err)
-//		validateDI(t, itr,
-//			"/foo-0/foo-0",
-//			"/foo-1/foo-1",
-//			"/foo-2/foo-2",
-//			"/foo-3/foo-3",
-//			"/foo-4/foo-4",
-//			"/foo-5/foo-5",
-//			"/foo-6/foo-6",
-//			"/foo-7/foo-7",
-//			"/foo-8/foo-8",
-//			"/foo-9/foo-9",
-//		)
-//		// Test with trailing slashes
-//		input[0].Pfs.Glob = "/(*)/"
-//		input[1].Pfs.Glob = "/(*)/"
-//		itr, err = NewIterator(c, client.NewJoinInput(input...))
-//		require.NoError(t, err)
-//		validateDI(t, itr,
-//			"/foo-0/foo-0",
-//			"/foo-1/foo-1",
-//			"/foo-2/foo-2",
-//			"/foo-3/foo-3",
-//			"/foo-4/foo-4",
-//			"/foo-5/foo-5",
-//			"/foo-6/foo-6",
-//			"/foo-7/foo-7",
-//			"/foo-8/foo-8",
-//			"/foo-9/foo-9",
-//		)
-//		return nil
-//	}))
+func TestJoinTrailingSlash(t *testing.T) {
+	t.Parallel()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, testpachd.WithRealEnv(db, func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		repo := []string{ // singular name b/c we only refer to individual elements
+			tu.UniqueString(t.Name() + "_0"),
+			tu.UniqueString(t.Name() + "_1"),
+		}
+		input := []*pps.Input{ // singular name b/c only use individual elements
+			client.NewPFSInputOpts("", repo[0],
+				/* commit--set below */ "", "/*", "$1", "", false, false, nil),
+			client.NewPFSInputOpts("", repo[1],
+				/* commit--set below */ "", "/*", "$1", "", false, false, nil),
+		}
+		require.NoError(t, c.CreateRepo(repo[0]))
+		require.NoError(t, c.CreateRepo(repo[1]))
+
+		// put files in structured in a way so that there are many ways to glob it
+		for i := 0; i < 2; i++ {
+			commit, err := c.StartCommit(repo[i], "master")
+			require.NoError(t, err)
+			for j := 0; j < 10; j++ {
+				require.NoError(t, c.PutFile(repo[i], commit.ID, fmt.Sprintf("foo-%v", j), strings.NewReader("bar")))
+			}
+			require.NoError(t, c.FinishCommit(repo[i], commit.ID))
+			input[i].Pfs.Commit = commit.ID
+		}
+
+		// Test without trailing slashes
+		input[0].Pfs.Glob = "/(*)"
+		input[1].Pfs.Glob = "/(*)"
+		itr, err := NewIterator(c, client.NewJoinInput(input...))
+		require.NoError(t, err)
+		validateDI(t, itr,
+			"/foo-0/foo-0",
+			"/foo-1/foo-1",
+			"/foo-2/foo-2",
+			"/foo-3/foo-3",
+			"/foo-4/foo-4",
+			"/foo-5/foo-5",
+			"/foo-6/foo-6",
+			"/foo-7/foo-7",
+			"/foo-8/foo-8",
+			"/foo-9/foo-9",
+		)
+		// Test with trailing slashes
+		input[0].Pfs.Glob = "/(*)/"
+		input[1].Pfs.Glob = "/(*)/"
+		itr, err = NewIterator(c, client.NewJoinInput(input...))
+		require.NoError(t, err)
+		validateDI(t, itr,
+			"/foo-0/foo-0",
+			"/foo-1/foo-1",
+			"/foo-2/foo-2",
+			"/foo-3/foo-3",
+			"/foo-4/foo-4",
+			"/foo-5/foo-5",
+			"/foo-6/foo-6",
+			"/foo-7/foo-7",
+			"/foo-8/foo-8",
+			"/foo-9/foo-9",
+		)
+		return nil
+	}))
+}
 
 func validateDI(t testing.TB, di Iterator, datums ...string) {
 	t.Helper()
@@ -8,17 +8,17 @@ import (
 // SetOption configures a set.
 type SetOption func(*Set)
 
-// WithMetaOutput sets the AppendFileTarClient for the meta output.
-func WithMetaOutput(aftc AppendFileTarClient) SetOption {
+// WithMetaOutput sets the UploadClient for the meta output.
+func WithMetaOutput(uc UploadClient) SetOption {
 	return func(s *Set) {
-		s.metaOutputClient = aftc
+		s.metaOutputClient = uc
 	}
 }
 
-// WithPFSOutput sets the AppendFileTarClient for the pfs output.
-func WithPFSOutput(aftc AppendFileTarClient) SetOption {
+// WithPFSOutput sets the UploadClient for the pfs output.
+func WithPFSOutput(uc UploadClient) SetOption {
 	return func(s *Set) {
-		s.pfsOutputClient = aftc
+		s.pfsOutputClient = uc
 	}
 }
 
@@ -29,6 +29,14 @@ func WithStats(stats *Stats) SetOption {
 	}
 }
 
+// WithReadahead sets the number of chunks that are fetched from object storage
+// in parallel while downloading the input data for the datums in the set.
+func WithReadahead(n int) SetOption {
+	return func(s *Set) {
+		s.readahead = n
+	}
+}
+
 // Option configures a datum.
 type Option func(*Datum)
 
@@ -13,8 +13,8 @@ import (
 type MockIterator struct {
 	start  int
 	end    int
-	index  int
 	inputs int
+	groups int
 }
 
 // MockIteratorOptions specifies the behavior of the MockIterator.
@@ -22,6 +22,9 @@ type MockIteratorOptions struct {
 	Start  uint
 	Length uint
 	Inputs uint
+	// Groups, if set, assigns the Inputs for each index to one of Groups
+	// group by keys (index modulo Groups).
+	Groups uint
 }
 
 // NewMockIterator constructs a mock iterator that will consistently return
@@ -30,8 +33,8 @@ func NewMockIterator(options *MockIteratorOptions) *MockIterator {
 	result := &MockIterator{
 		start:  int(options.Start),
 		end:    int(options.Start + options.Length),
-		index:  int(options.Start) - 1,
 		inputs: int(options.Inputs),
+		groups: int(options.Groups),
 	}
 
 	if result.inputs == 0 {
@@ -41,27 +44,19 @@ func NewMockIterator(options *MockIteratorOptions) *MockIterator {
 	return result
 }
 
-// Reset returns the current index to the start of the Iterator.
-func (mi *MockIterator) Reset() {
-	mi.index = mi.start - 1
-}
-
 // Len returns the number of items in the Iterator.
 func (mi *MockIterator) Len() int {
 	return int(mi.end - mi.start)
 }
 
-// Next steps the Iterator to the next item.
-func (mi *MockIterator) Next() bool {
-	if mi.index < mi.end {
-		mi.index++
+// Iterate iterates over the datums in the Iterator.
+func (mi *MockIterator) Iterate(cb func(*Meta) error) error {
+	for i := 0; i < mi.Len(); i++ {
+		if err := cb(&Meta{Inputs: mi.DatumN(i)}); err != nil {
+			return err
+		}
 	}
-	return mi.index < mi.end
-}
-
-// Datum returns the set of Inputs for the current index of the Iterator.
-func (mi *MockIterator) Datum() []*common.Input {
-	return mi.DatumN(mi.index - mi.start)
+	return nil
 }
 
 // DatumN returns the set of Inputs for the selected index in the Iterator.
@@ -74,15 +69,19 @@ func (mi *MockIterator) DatumN(index int) []*common.Input {
 	result := []*common.Input{}
 	for i := 0; i < mi.inputs; i++ {
 		// Warning: this might break some assumptions about the format of this data
-		result = append(result, &common.Input{
+		input := &common.Input{
 			FileInfo: &pfs.FileInfo{
-				File:      client.NewFile("dummy-repo", "dummy-commit", "/path"),
+				File:      client.NewFile("dummy-repo", "dummy-commit", fmt.Sprintf("/path-%08d", index)),
 				FileType:  pfs.FileType_FILE,
 				SizeBytes: uint64(index),
 				Hash:      []byte(fmt.Sprintf("%d", index)),
 			},
 			Name: fmt.Sprintf("source-%d", i),
-		})
+		}
+		if mi.groups > 0 {
+			input.GroupBy = fmt.Sprintf("%d", index%mi.groups)
+		}
+		result = append(result, input)
 	}
 	return result
 }
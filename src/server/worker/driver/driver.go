@@ -32,7 +32,6 @@ import (
 
 // TODO:
 // s3 input / gateway stuff (need more information here).
-// egress (maybe should be a part of the datum package).
 // Prometheus stats? (refer to old driver code and tests)
 // capture logs (refer to old driver code and tests).
 // In general, need to spend some time walking through the old driver
@@ -63,6 +62,10 @@ type Driver interface {
 	// Returns the path that will contain the input filesets for the job
 	InputDir() string
 
+	// Returns the number of chunks that are fetched in parallel while
+	// downloading the input data for a datum
+	DatumReadahead() int
+
 	// Returns the pachd API client for the driver
 	PachClient() *client.APIClient
 
@@ -110,6 +113,8 @@ type driver struct {
 
 	namespace string
 
+	datumReadahead int
+
 	// User and group IDs used for running user code, determined in the constructor
 	uid *uint32
 	gid *uint32
@@ -134,6 +139,7 @@ func NewDriver(
 	etcdPrefix string,
 	rootPath string,
 	namespace string,
+	datumReadahead int,
 ) (Driver, error) {
 	pfsPath := filepath.Join(rootPath, client.PPSInputPrefix)
 	if err := os.MkdirAll(pfsPath, 0777); err != nil {
@@ -150,6 +156,7 @@ func NewDriver(
 		rootDir:         rootPath,
 		inputDir:        pfsPath,
 		namespace:       namespace,
+		datumReadahead:  datumReadahead,
 	}
 	if pipelineInfo.Transform.User != "" {
 		user, err := lookupDockerUser(pipelineInfo.Transform.User)
@@ -268,7 +275,7 @@ func (d *driver) Pipelines() col.Collection {
 }
 
 func (d *driver) NewTaskWorker() *work.Worker {
-	return work.NewWorker(d.etcdClient, d.etcdPrefix, workNamespace(d.pipelineInfo))
+	return work.NewWorker(d.etcdClient, d.etcdPrefix, workNamespace(d.pipelineInfo), work.WithMaxConcurrentSubtasks(int(d.pipelineInfo.MaxQueueSize)))
 }
 
 func (d *driver) NewTaskQueue() (*work.TaskQueue, error) {
@@ -299,6 +306,10 @@ func (d *driver) InputDir() string {
 	return d.inputDir
 }
 
+func (d *driver) DatumReadahead() int {
+	return d.datumReadahead
+}
+
 func (d *driver) PachClient() *client.APIClient {
 	return d.pachClient
 }
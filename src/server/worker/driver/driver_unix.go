@@ -13,11 +13,11 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/worker/common"
 )
 
-// TODO: Make spouts work with V2.
+// CreateSpoutFifo creates the named pipe that spout user code writes to.
 // Mkfifo does not exist on Windows, so this is left unimplemented there, except for tests
-//func createSpoutFifo(path string) error {
-//	return syscall.Mkfifo(path, 0666)
-//}
+func CreateSpoutFifo(path string) error {
+	return syscall.Mkfifo(path, 0666)
+}
 
 func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	return &syscall.SysProcAttr{
@@ -19,9 +19,9 @@ func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	return nil
 }
 
-// Note: this function only exists for tests, the real system uses a fifo for
+// CreateSpoutFifo only exists for tests, the real system uses a fifo for
 // this (which does not exist in the normal filesystem on Windows)
-func createSpoutFifo(path string) error {
+func CreateSpoutFifo(path string) error {
 	file, err := os.Create(path)
 	if err != nil {
 		return err
@@ -41,6 +41,9 @@ type TaggedLogger interface {
 	WithJob(jobID string) TaggedLogger
 	WithData(data []*common.Input) TaggedLogger
 	WithUserCode() TaggedLogger
+	// WithWriter clones the current logger and constructs a new logger that also
+	// writes its log messages to the given writer.
+	WithWriter(w io.Writer) TaggedLogger
 
 	JobID() string
 }
@@ -49,6 +52,7 @@ type taggedLogger struct {
 	template  pps.LogMessage
 	stderrLog *log.Logger
 	marshaler *jsonpb.Marshaler
+	writer    io.Writer
 
 	buffer bytes.Buffer
 }
@@ -143,6 +147,15 @@ func (logger *taggedLogger) WithUserCode() TaggedLogger {
 	return result
 }
 
+// WithWriter clones the current logger and returns a new one that will also
+// write its log messages (one JSON message per line) to the given writer. This
+// is used to persist the logs for a datum in the meta output.
+func (logger *taggedLogger) WithWriter(w io.Writer) TaggedLogger {
+	result := logger.clone()
+	result.writer = w
+	return result
+}
+
 // JobID returns the current job that the logger is configured with.
 func (logger *taggedLogger) JobID() string {
 	return logger.template.JobID
@@ -153,6 +166,7 @@ func (logger *taggedLogger) clone() *taggedLogger {
 		template:  logger.template,  // Copy struct
 		stderrLog: logger.stderrLog, // logger should be goroutine-safe
 		marshaler: &jsonpb.Marshaler{},
+		writer:    logger.writer,
 	}
 }
 
@@ -174,6 +188,11 @@ func (logger *taggedLogger) Logf(formatString string, args ...interface{}) {
 		return
 	}
 	fmt.Println(msg)
+	if logger.writer != nil {
+		if _, err := logger.writer.Write([]byte(msg + "\n")); err != nil {
+			logger.Errf("could not write log message: %s\n", err)
+		}
+	}
 }
 
 // LogStep will log before and after the given callback function runs, using
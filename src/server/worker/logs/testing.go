@@ -106,6 +106,17 @@ func (ml *MockLogger) WithUserCode() TaggedLogger {
 	return result
 }
 
+// WithWriter duplicates the MockLogger and returns a new one that also writes
+// log statements to the given writer.
+func (ml *MockLogger) WithWriter(w io.Writer) TaggedLogger {
+	result := ml.clone()
+	if result.Writer != nil {
+		w = io.MultiWriter(result.Writer, w)
+	}
+	result.Writer = w
+	return result
+}
+
 // JobID returns the currently tagged job ID for the logger.  This is redundant
 // for MockLogger, as you can access ml.Job directly, but it is needed for the
 // TaggedLogger interface.
@@ -1,267 +1,321 @@
 package pipeline
 
-// TODO: Make spouts work with V2.
-//func openAndWait() error {
-//	// at the end of file, we open the pipe again, since this blocks until something is written to the pipe
-//	openAndWait, err := os.Open("/pfs/out")
-//	if err != nil {
-//		return err
-//	}
-//	// and then we immediately close this reader of the pipe, so that the main reader can continue its standard behavior
-//	err = openAndWait.Close()
-//	if err != nil {
-//		return err
-//	}
-//	return nil
-//}
-//
-//// RunUserCode will run the pipeline's user code until canceled by the context
-//// - used for services and spouts. Unlike how the transform worker runs user
-//// code, this does not set environment variables or collect stats.
-//func RunUserCode(
-//	driver driver.Driver,
-//	logger logs.TaggedLogger,
-//	outputCommit *pfs.Commit,
-//	inputs []*common.Input,
-//) error {
-//	return backoff.RetryUntilCancel(driver.PachClient().Ctx(), func() error {
-//		// TODO: what about the user error handling code?
-//		env := driver.UserCodeEnv(logger.JobID(), outputCommit, inputs)
-//		return driver.RunUserCode(driver.PachClient().Ctx(), logger, env)
-//	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-//		logger.Logf("error in RunUserCode: %+v, retrying in: %+v", err, d)
-//		return nil
-//	})
-//}
-//
-//
-//// ReceiveSpout is used by both services and spouts if a spout is defined on the
-//// pipeline. ctx is separate from pachClient because services may call this, and
-//// they use a cancel function that affects the context but not the pachClient
-//// (so metadata updates can still be made while unwinding).
-//func ReceiveSpout(
-//	ctx context.Context,
-//	pachClient *client.APIClient,
-//	pipelineInfo *pps.PipelineInfo,
-//	logger logs.TaggedLogger,
-//) (retErr error) {
-//	// Open a read connection to the /pfs/out named pipe.
-//	out, err := os.Open("/pfs/out")
-//	if err != nil {
-//		return err
-//	}
-//	defer func() {
-//		if err := out.Close(); retErr == nil {
-//			retErr = err
-//		}
-//	}()
-//	cancelCtx, cancel := context.WithCancel(ctx)
-//	repo := pipelineInfo.Pipeline.Name
-//	for {
-//		if err := withTmpFile("pachyderm_spout_commit", func(f *os.File) error {
-//			if err := getNextTarStream(f, out); err != nil {
-//				return err
-//			}
-//			return withSpoutCommit(cancelCtx, pachClient, pipelineInfo, logger, f, func(commit *pfs.Commit, tr *tar.Reader) error {
-//				for {
-//					hdr, err := tr.Next()
-//					if err != nil {
-//						if errors.Is(err, io.EOF) {
-//							return nil
-//						}
-//						return err
-//					}
-//					if err := withSpoutFile(cancelCtx, logger, tr, func(r io.Reader) error {
-//						if pipelineInfo.Spout.Marker != "" && strings.HasPrefix(path.Clean(hdr.Name), pipelineInfo.Spout.Marker) {
-//							// Check to see if this spout is the latest version of this spout by seeing if its spec commit has any children.
-//							// TODO: There is a race condition here where the spout could be updated after this check, but before the PutFileOverwrite.
-//							spec, err := pachClient.InspectCommit(ppsconsts.SpecRepo, pipelineInfo.SpecCommit.ID)
-//							if err != nil && !errutil.IsNotFoundError(err) {
-//								return err
-//							}
-//							if spec != nil && len(spec.ChildCommits) != 0 {
-//								cancel()
-//								return errors.New("outdated spout, now shutting down")
-//							}
-//							_, err = pachClient.PutFileOverwrite(repo, ppsconsts.SpoutMarkerBranch, hdr.Name, r, 0)
-//							if err != nil {
-//								return err
-//							}
-//						} else if pipelineInfo.Spout.Overwrite {
-//							_, err := pachClient.PutFileOverwrite(repo, commit.ID, hdr.Name, r, 0)
-//							if err != nil {
-//								return err
-//							}
-//						}
-//						_, err := pachClient.PutFile(repo, commit.ID, hdr.Name, r)
-//						return err
-//					}); err != nil {
-//						return err
-//					}
-//				}
-//			})
-//		}); err != nil {
-//			return err
-//		}
-//	}
-//}
-//
-//// TODO: Refactor into a file util package.
-//func withTmpFile(name string, cb func(*os.File) error) (retErr error) {
-//	if err := os.MkdirAll(os.TempDir(), 0700); err != nil {
-//		return err
-//	}
-//	f, err := ioutil.TempFile(os.TempDir(), name)
-//	if err != nil {
-//		return err
-//	}
-//	defer func() {
-//		if err := os.Remove(f.Name()); retErr == nil {
-//			retErr = err
-//		}
-//		if err := f.Close(); retErr == nil {
-//			retErr = err
-//		}
-//	}()
-//	return cb(f)
-//}
-//
-//func getNextTarStream(w io.Writer, r io.Reader) error {
-//	var hdr *tar.Header
-//	var err error
-//	tr := tar.NewReader(newSkipReader(r))
-//	for {
-//		hdr, err = tr.Next()
-//		if err != nil {
-//			if errors.Is(err, io.EOF) {
-//				err = openAndWait()
-//				if err != nil {
-//					return err
-//				}
-//				tr = tar.NewReader(newSkipReader(r))
-//				continue
-//			}
-//			return err
-//		}
-//		break
-//	}
-//	tw := tar.NewWriter(w)
-//	if err := tw.WriteHeader(hdr); err != nil {
-//		return err
-//	}
-//	if _, err := io.Copy(tw, tr); err != nil {
-//		return err
-//	}
-//	if err := copyTar(tw, tr); err != nil {
-//		return err
-//	}
-//	return tw.Close()
-//}
-//
-//type skipReader struct {
-//	buf *bytes.Buffer
-//	r   io.Reader
-//}
-//
-//func newSkipReader(r io.Reader) *skipReader {
-//	return &skipReader{r: r}
-//}
-//
-//func (sr *skipReader) Read(data []byte) (int, error) {
-//	if sr.buf == nil {
-//		if err := sr.skipZeroBlocks(); err != nil {
-//			return 0, err
-//		}
-//	}
-//	bufN, _ := sr.buf.Read(data)
-//	if bufN == len(data) {
-//		return bufN, nil
-//	}
-//	n, err := sr.r.Read(data[bufN:])
-//	return bufN + n, err
-//}
-//
-//func (sr *skipReader) skipZeroBlocks() error {
-//	sr.buf = &bytes.Buffer{}
-//	zeroBlock := make([]byte, 512)
-//	for {
-//		_, err := io.CopyN(sr.buf, sr.r, 512)
-//		if err != nil {
-//			return err
-//		}
-//		if !bytes.Equal(sr.buf.Bytes(), zeroBlock) {
-//			return nil
-//		}
-//		sr.buf.Reset()
-//	}
-//}
-//
-//// TODO: Refactor this into tarutil.
-//func copyTar(tw *tar.Writer, tr *tar.Reader) error {
-//	for {
-//		hdr, err := tr.Next()
-//		if err != nil {
-//			if errors.Is(err, io.EOF) {
-//				return nil
-//			}
-//			return err
-//		}
-//		if err := tw.WriteHeader(hdr); err != nil {
-//			return err
-//		}
-//		_, err = io.Copy(tw, tr)
-//		if err != nil {
-//			return err
-//		}
-//	}
-//}
-//
-//func withSpoutCommit(ctx context.Context, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, logger logs.TaggedLogger, f *os.File, cb func(*pfs.Commit, *tar.Reader) error) error {
-//	repo := pipelineInfo.Pipeline.Name
-//	return backoff.RetryUntilCancel(ctx, func() (retErr error) {
-//		commit, err := pachClient.PfsAPIClient.StartCommit(ctx, &pfs.StartCommitRequest{
-//			Parent:     client.NewCommit(repo, ""),
-//			Branch:     pipelineInfo.OutputBranch,
-//			Provenance: []*pfs.CommitProvenance{client.NewCommitProvenance(ppsconsts.SpecRepo, repo, pipelineInfo.SpecCommit.ID)},
-//		})
-//		if err != nil {
-//			return err
-//		}
-//		defer func() {
-//			if retErr != nil {
-//				pachClient.DeleteCommit(repo, commit.ID)
-//				return
-//			}
-//			if err := pachClient.FinishCommit(repo, commit.ID); retErr == nil {
-//				retErr = err
-//			}
-//		}()
-//		_, err = f.Seek(0, 0)
-//		if err != nil {
-//			return err
-//		}
-//		return cb(commit, tar.NewReader(f))
-//	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-//		logger.Logf("error in withSpoutCommit: %+v, retrying in: %+v", err, d)
-//		return nil
-//	})
-//}
-//
-//func withSpoutFile(ctx context.Context, logger logs.TaggedLogger, r io.Reader, cb func(io.Reader) error) error {
-//	return withTmpFile("pachyderm_spout_file", func(f *os.File) error {
-//		_, err := io.Copy(f, r)
-//		if err != nil {
-//			return err
-//		}
-//		return backoff.RetryUntilCancel(ctx, func() error {
-//			_, err = f.Seek(0, 0)
-//			if err != nil {
-//				return err
-//			}
-//			return cb(f)
-//		}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-//			logger.Logf("error in withSpoutFile: %+v, retrying in: %+v", err, d)
-//			return nil
-//		})
-//	})
-//}
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+func openAndWait(outPath string) error {
+	// at the end of file, we open the pipe again, since this blocks until something is written to the pipe
+	openAndWait, err := os.Open(outPath)
+	if err != nil {
+		return err
+	}
+	// and then we immediately close this reader of the pipe, so that the main reader can continue its standard behavior
+	return openAndWait.Close()
+}
+
+// RunUserCode will run the pipeline's user code until canceled by the context
+// - used for services and spouts. Unlike how the transform worker runs user
+// code, this does not set environment variables or collect stats.
+func RunUserCode(
+	driver driver.Driver,
+	logger logs.TaggedLogger,
+	outputCommit *pfs.Commit,
+	inputs []*common.Input,
+) error {
+	return backoff.RetryUntilCancel(driver.PachClient().Ctx(), func() error {
+		// TODO: what about the user error handling code?
+		env := driver.UserCodeEnv(logger.JobID(), outputCommit, inputs)
+		return driver.RunUserCode(driver.PachClient().Ctx(), logger, env)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logger.Logf("error in RunUserCode: %+v, retrying in: %+v", err, d)
+		return nil
+	})
+}
+
+// ReceiveSpout is used by both services and spouts if a spout is defined on the
+// pipeline. It reads tar streams from the /pfs/out named pipe and writes each
+// one to a new output commit. ctx is separate from the driver's pachClient
+// because services may call this, and they use a cancel function that affects
+// the context but not the pachClient (so metadata updates can still be made
+// while unwinding).
+func ReceiveSpout(
+	ctx context.Context,
+	driver driver.Driver,
+	logger logs.TaggedLogger,
+) (retErr error) {
+	pachClient := driver.PachClient()
+	pipelineInfo := driver.PipelineInfo()
+	outPath := filepath.Join(driver.InputDir(), "out")
+	// Open a read connection to the /pfs/out named pipe.
+	out, err := os.Open(outPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for {
+		if err := withTmpFile("pachyderm_spout_commit", func(f *os.File) error {
+			if err := getNextTarStream(f, out, outPath); err != nil {
+				return err
+			}
+			var hasMarker bool
+			if err := withSpoutCommit(cancelCtx, pachClient, pipelineInfo, logger, func(commit *pfs.Commit) error {
+				if _, err := f.Seek(0, 0); err != nil {
+					return err
+				}
+				hasMarker = false
+				return pachClient.WithModifyFileClient(commit.Repo.Name, commit.ID, func(mfc *client.ModifyFileClient) error {
+					return forEachFile(tar.NewReader(f), func(name string, r io.Reader) error {
+						if isMarker(pipelineInfo, name) {
+							hasMarker = true
+							return nil
+						}
+						return mfc.AppendFile(name, pipelineInfo.Spout.Overwrite, r)
+					})
+				})
+			}); err != nil {
+				return err
+			}
+			if !hasMarker {
+				return nil
+			}
+			return backoff.RetryUntilCancel(cancelCtx, func() error {
+				if _, err := f.Seek(0, 0); err != nil {
+					return err
+				}
+				return updateSpoutMarker(pachClient, pipelineInfo, cancel, f)
+			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+				logger.Logf("error updating spout marker: %+v, retrying in: %+v", err, d)
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func isMarker(pipelineInfo *pps.PipelineInfo, name string) bool {
+	if pipelineInfo.Spout.Marker == "" {
+		return false
+	}
+	marker := path.Join("/", pipelineInfo.Spout.Marker)
+	return name == marker || strings.HasPrefix(name, marker+"/")
+}
+
+// forEachFile calls cb with the cleaned, absolute path and content of each
+// regular file in a tar stream.
+func forEachFile(tr *tar.Reader, cb func(string, io.Reader) error) error {
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := cb(path.Join("/", hdr.Name), tr); err != nil {
+			return err
+		}
+	}
+}
+
+// updateSpoutMarker writes the marker files in a tar stream to the spout
+// marker branch, so that they can be provided to the spout after a restart.
+func updateSpoutMarker(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, cancel context.CancelFunc, r io.Reader) (retErr error) {
+	// Check to see if this spout is the latest version of this spout by seeing if its spec commit has any children.
+	// TODO: There is a race condition here where the spout could be updated after this check, but before the marker is written.
+	spec, err := pachClient.InspectCommit(ppsconsts.SpecRepo, pipelineInfo.SpecCommit.ID)
+	if err != nil && !errutil.IsNotFoundError(err) {
+		return err
+	}
+	if spec != nil && len(spec.ChildCommits) != 0 {
+		cancel()
+		return errors.New("outdated spout, now shutting down")
+	}
+	repo := pipelineInfo.Pipeline.Name
+	commit, err := pachClient.StartCommit(repo, ppsconsts.SpoutMarkerBranch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			pachClient.DeleteCommit(repo, commit.ID)
+			return
+		}
+		retErr = pachClient.FinishCommit(repo, commit.ID)
+	}()
+	return pachClient.WithModifyFileClient(repo, commit.ID, func(mfc *client.ModifyFileClient) error {
+		return forEachFile(tar.NewReader(r), func(name string, r io.Reader) error {
+			if !isMarker(pipelineInfo, name) {
+				return nil
+			}
+			return mfc.AppendFile(name, true, r)
+		})
+	})
+}
+
+// TODO: Refactor into a file util package.
+func withTmpFile(name string, cb func(*os.File) error) (retErr error) {
+	if err := os.MkdirAll(os.TempDir(), 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(os.TempDir(), name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); retErr == nil {
+			retErr = err
+		}
+		if err := f.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	return cb(f)
+}
+
+// getNextTarStream copies the next tar stream written to the named pipe at
+// outPath from r to w. The user code may close the pipe between tar streams,
+// in which case we wait for it to be reopened.
+func getNextTarStream(w io.Writer, r io.Reader, outPath string) error {
+	var hdr *tar.Header
+	var err error
+	tr := tar.NewReader(newSkipReader(r))
+	for {
+		hdr, err = tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				if err := openAndWait(outPath); err != nil {
+					return err
+				}
+				tr = tar.NewReader(newSkipReader(r))
+				continue
+			}
+			return err
+		}
+		break
+	}
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, tr); err != nil {
+		return err
+	}
+	if err := copyTar(tw, tr); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+type skipReader struct {
+	buf *bytes.Buffer
+	r   io.Reader
+}
+
+func newSkipReader(r io.Reader) *skipReader {
+	return &skipReader{r: r}
+}
+
+func (sr *skipReader) Read(data []byte) (int, error) {
+	if sr.buf == nil {
+		if err := sr.skipZeroBlocks(); err != nil {
+			return 0, err
+		}
+	}
+	bufN, _ := sr.buf.Read(data)
+	if bufN == len(data) {
+		return bufN, nil
+	}
+	n, err := sr.r.Read(data[bufN:])
+	return bufN + n, err
+}
+
+func (sr *skipReader) skipZeroBlocks() error {
+	sr.buf = &bytes.Buffer{}
+	zeroBlock := make([]byte, 512)
+	for {
+		_, err := io.CopyN(sr.buf, sr.r, 512)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(sr.buf.Bytes(), zeroBlock) {
+			return nil
+		}
+		sr.buf.Reset()
+	}
+}
+
+// TODO: Refactor this into tarutil.
+func copyTar(tw *tar.Writer, tr *tar.Reader) error {
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err = io.Copy(tw, tr)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func withSpoutCommit(ctx context.Context, pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, logger logs.TaggedLogger, cb func(*pfs.Commit) error) error {
+	repo := pipelineInfo.Pipeline.Name
+	return backoff.RetryUntilCancel(ctx, func() (retErr error) {
+		commit, err := pachClient.PfsAPIClient.StartCommit(ctx, &pfs.StartCommitRequest{
+			Parent:     client.NewCommit(repo, ""),
+			Branch:     pipelineInfo.OutputBranch,
+			Provenance: []*pfs.CommitProvenance{client.NewCommitProvenance(ppsconsts.SpecRepo, repo, pipelineInfo.SpecCommit.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if retErr != nil {
+				pachClient.DeleteCommit(repo, commit.ID)
+				return
+			}
+			retErr = pachClient.FinishCommit(repo, commit.ID)
+		}()
+		return cb(commit)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logger.Logf("error in withSpoutCommit: %+v, retrying in: %+v", err, d)
+		return nil
+	})
+}
@@ -0,0 +1,109 @@
+// +build !windows
+
+package pipeline
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tarutil"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+)
+
+func writeTarStream(w io.Writer, files ...tarutil.File) error {
+	return tarutil.WithWriter(w, func(tw *tar.Writer) error {
+		for _, f := range files {
+			if err := tarutil.WriteFile(tw, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func TestGetNextTarStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spout")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	outPath := filepath.Join(dir, "out")
+	require.NoError(t, driver.CreateSpoutFifo(outPath))
+
+	// The user code writes two tar streams to the pipe without closing it in
+	// between, then reopens the pipe for a third tar stream.
+	streams := [][]tarutil.File{
+		{tarutil.NewMemFile("/a", []byte("foo")), tarutil.NewMemFile("/b", []byte("bar"))},
+		{tarutil.NewMemFile("/c", []byte("baz"))},
+		{tarutil.NewMemFile("/d", []byte("buzz"))},
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- func() error {
+			f, err := os.OpenFile(outPath, os.O_WRONLY, os.ModeNamedPipe)
+			if err != nil {
+				return err
+			}
+			for _, files := range streams[:2] {
+				if err := writeTarStream(f, files...); err != nil {
+					return err
+				}
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			f, err = os.OpenFile(outPath, os.O_WRONLY, os.ModeNamedPipe)
+			if err != nil {
+				return err
+			}
+			if err := writeTarStream(f, streams[2]...); err != nil {
+				return err
+			}
+			return f.Close()
+		}()
+	}()
+
+	out, err := os.Open(outPath)
+	require.NoError(t, err)
+	defer out.Close()
+	for i, files := range streams {
+		buf := &bytes.Buffer{}
+		require.NoError(t, getNextTarStream(buf, out, outPath))
+		require.NoError(t, tarutil.Iterate(buf, func(file tarutil.File) error {
+			require.True(t, len(files) > 0, fmt.Sprintf("unexpected file in tar stream %v", i))
+			ok, err := tarutil.Equal(files[0], file)
+			require.NoError(t, err)
+			require.True(t, ok)
+			files = files[1:]
+			return nil
+		}))
+		require.Equal(t, 0, len(files))
+	}
+	require.NoError(t, <-errCh)
+}
+
+func TestForEachFile(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, tarutil.WithWriter(buf, func(tw *tar.Writer) error {
+		if err := tw.WriteHeader(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0755}); err != nil {
+			return err
+		}
+		return tarutil.WriteFile(tw, tarutil.NewMemFile("./dir/file", []byte("foo")))
+	}))
+	var names []string
+	require.NoError(t, forEachFile(tar.NewReader(buf), func(name string, r io.Reader) error {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		require.Equal(t, "foo", string(data))
+		names = append(names, name)
+		return nil
+	}))
+	require.Equal(t, []string{"/dir/file"}, names)
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
+	"github.com/pachyderm/pachyderm/src/server/worker/datum"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/src/server/worker/pipeline"
+	"golang.org/x/sync/errgroup"
+)
+
+// Run will run a service pipeline until the driver is canceled.
+func Run(driver driver.Driver, logger logs.TaggedLogger) error {
+	pachClient := driver.PachClient()
+	pipelineInfo := driver.PipelineInfo()
+	logger.Logf("service spawner started")
+	return forEachCommit(driver, logger, func(ctx context.Context, commitInfo *pfs.CommitInfo, metaCommit *pfs.Commit) error {
+		// Create a job document matching the service's output commit.
+		jobInfo, err := ensureJob(pachClient, pipelineInfo, commitInfo, metaCommit)
+		if err != nil {
+			return err
+		}
+		logger := logger.WithJob(jobInfo.Job.ID)
+		meta, err := getDatum(pachClient, jobInfo.Input)
+		if err != nil {
+			return err
+		}
+		if err := driver.UpdateJobState(jobInfo.Job.ID, pps.JobState_JOB_RUNNING, ""); err != nil {
+			logger.Logf("error updating job state: %+v", err)
+		}
+		storageRoot := filepath.Join(driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
+		if err := datum.WithSet(pachClient, storageRoot, func(s *datum.Set) error {
+			return s.WithDatum(ctx, meta, func(d *datum.Datum) error {
+				return driver.WithActiveData(meta.Inputs, d.PFSStorageRoot(), func() error {
+					return pipeline.RunUserCode(driver.WithContext(ctx), logger, commitInfo.Commit, meta.Inputs)
+				})
+			}, datum.WithRetry(0))
+		}); err != nil {
+			return err
+		}
+		// Only finish the job if the service was canceled due to a new commit,
+		// rather than the worker shutting down.
+		if pachClient.Ctx().Err() != nil {
+			return nil
+		}
+		return finishJob(pachClient, jobInfo)
+	})
+}
+
+// Repeatedly runs the given callback with the latest commit for the pipeline.
+// The given context will be canceled if a newer commit is ready, then this will
+// wait for the previous callback to return before calling the callback again
+// with the latest commit.
+func forEachCommit(
+	driver driver.Driver,
+	logger logs.TaggedLogger,
+	cb func(context.Context, *pfs.CommitInfo, *pfs.Commit) error,
+) error {
+	pachClient := driver.PachClient()
+	pipelineInfo := driver.PipelineInfo()
+	// These are used to cancel the existing service and wait for it to finish.
+	var cancel context.CancelFunc
+	var eg *errgroup.Group
+	defer func() {
+		if cancel != nil {
+			cancel()
+			eg.Wait()
+		}
+	}()
+	return pachClient.SubscribeCommitF(
+		pipelineInfo.Pipeline.Name,
+		"",
+		nil,
+		"",
+		pfs.CommitState_READY,
+		func(ci *pfs.CommitInfo) error {
+			if ci.Finished != nil {
+				return nil
+			}
+			if cancel != nil {
+				logger.Logf("canceling previous service, new commit ready")
+				cancel()
+				if err := eg.Wait(); err != nil {
+					return err
+				}
+			}
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(pachClient.Ctx())
+			eg, ctx = errgroup.WithContext(ctx)
+			metaCommit := getStatsCommit(ci)
+			eg.Go(func() error { return cb(ctx, ci, metaCommit) })
+			return nil
+		},
+	)
+}
+
+func getStatsCommit(commitInfo *pfs.CommitInfo) *pfs.Commit {
+	for _, commitRange := range commitInfo.Subvenance {
+		if commitRange.Lower.Repo.Name == commitInfo.Commit.Repo.Name {
+			return commitRange.Lower
+		}
+	}
+	return nil
+}
+
+// ensureJob loads an existing job for the given commit in the pipeline, or
+// creates it if there is none.
+func ensureJob(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, commitInfo *pfs.CommitInfo, metaCommit *pfs.Commit) (*pps.JobInfo, error) {
+	jobInfos, err := pachClient.ListJob("", nil, commitInfo.Commit, -1, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobInfos) > 1 {
+		return nil, errors.Errorf("multiple jobs found for commit: %s/%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
+	} else if len(jobInfos) == 1 {
+		return pachClient.InspectJob(jobInfos[0].Job.ID, false)
+	}
+	job, err := pachClient.CreateJob(pipelineInfo.Pipeline.Name, commitInfo.Commit, metaCommit)
+	if err != nil {
+		return nil, err
+	}
+	return pachClient.InspectJob(job.ID, false)
+}
+
+// getDatum returns the single datum that a service runs over.
+func getDatum(pachClient *client.APIClient, input *pps.Input) (*datum.Meta, error) {
+	dit, err := datum.NewIterator(pachClient, input)
+	if err != nil {
+		return nil, err
+	}
+	var meta *datum.Meta
+	if err := dit.Iterate(func(m *datum.Meta) error {
+		if meta != nil {
+			return errors.New("services must have a single datum")
+		}
+		meta = m
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if meta == nil {
+		return nil, errors.New("services must have a single datum")
+	}
+	return meta, nil
+}
+
+// finishJob finishes the output and meta commits of a service job that has been
+// superseded by a newer commit, and marks the job as successful.
+func finishJob(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
+	for _, commit := range []*pfs.Commit{jobInfo.StatsCommit, jobInfo.OutputCommit} {
+		if commit == nil {
+			continue
+		}
+		if err := pachClient.FinishCommit(commit.Repo.Name, commit.ID); err != nil {
+			if !pfsserver.IsCommitFinishedErr(err) && !pfsserver.IsCommitNotFoundErr(err) && !pfsserver.IsCommitDeletedErr(err) {
+				return err
+			}
+		}
+	}
+	if _, err := pachClient.PpsAPIClient.UpdateJobState(pachClient.Ctx(), &pps.UpdateJobStateRequest{
+		Job:   jobInfo.Job,
+		State: pps.JobState_JOB_SUCCESS,
+	}); err != nil {
+		if !ppsserver.IsJobFinishedErr(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,36 +1,106 @@
 package spout
 
-// TODO: Make spout work with V2.
-//// Run will run a spout pipeline until the driver is canceled.
-//func Run(driver driver.Driver, logger logs.TaggedLogger) error {
-//	pachClient := driver.PachClient()
-//	pipelineInfo := driver.PipelineInfo()
-//	logger = logger.WithJob("spout")
-//
-//	// Spouts typically have an open commit waiting for new data. So if the spout needs to be updated, and
-//	// thus spoutSpawner is called, it might hang if the commit never gets closed. So to avoid this, we
-//	// delete open commits that we see here.
-//	// We probably only need to check the first commit, but doing 10 to be safe
-//	pachClient.ListCommitF(pipelineInfo.Pipeline.Name, "", "", 10, false, func(c *pfs.CommitInfo) error {
-//		if c.Finished != nil {
-//			return nil
-//		}
-//		return pachClient.DeleteCommit(pipelineInfo.Pipeline.Name, c.Commit.ID)
-//	})
-//
-//	// TODO: do something with stats?
-//	_, err := driver.WithData(nil, nil, logger, func(dir string, stats *pps.ProcessStats) error {
-//		inputs := []*common.Input{} // Spouts take no inputs
-//		return driver.WithActiveData(inputs, dir, func() error {
-//			eg, serviceCtx := errgroup.WithContext(pachClient.Ctx())
-//
-//			// While spouts do write to output commits, the output commit changes
-//			// frequently and we do not restart the user code for each one. Therefore,
-//			// we leave the output commit out of the user code env.
-//			eg.Go(func() error { return pipeline.RunUserCode(driver.WithContext(serviceCtx), logger, nil, inputs) })
-//			eg.Go(func() error { return pipeline.ReceiveSpout(serviceCtx, pachClient, pipelineInfo, logger) })
-//			return eg.Wait()
-//		})
-//	})
-//	return err
-//}
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfssync"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/src/server/worker/pipeline"
+	"golang.org/x/sync/errgroup"
+)
+
+// Run will run a spout pipeline until the driver is canceled.
+func Run(driver driver.Driver, logger logs.TaggedLogger) error {
+	pachClient := driver.PachClient()
+	pipelineInfo := driver.PipelineInfo()
+	logger = logger.WithJob("spout")
+
+	// Spouts typically have an open commit waiting for new data. So if the spout needs to be updated, and
+	// thus spoutSpawner is called, it might hang if the commit never gets closed. So to avoid this, we
+	// delete open commits that we see here.
+	// We probably only need to check the first commit, but doing 10 to be safe
+	if err := pachClient.ListCommitF(pipelineInfo.Pipeline.Name, "", "", 10, false, func(c *pfs.CommitInfo) error {
+		if c.Finished != nil {
+			return nil
+		}
+		return pachClient.DeleteCommit(pipelineInfo.Pipeline.Name, c.Commit.ID)
+	}); err != nil {
+		return err
+	}
+
+	return WithSpoutData(driver, logger, func(dir string) error {
+		inputs := []*common.Input{} // Spouts take no inputs
+		return driver.WithActiveData(inputs, dir, func() error {
+			eg, serviceCtx := errgroup.WithContext(pachClient.Ctx())
+			// While spouts do write to output commits, the output commit changes
+			// frequently and we do not restart the user code for each one. Therefore,
+			// we leave the output commit out of the user code env.
+			eg.Go(func() error { return pipeline.RunUserCode(driver.WithContext(serviceCtx), logger, nil, inputs) })
+			eg.Go(func() error { return pipeline.ReceiveSpout(serviceCtx, driver, logger) })
+			return eg.Wait()
+		})
+	})
+}
+
+// WithSpoutData sets up a scratch directory for a spout, containing the named
+// pipe that the user code writes to and the latest spout marker (if the spout
+// has one).
+func WithSpoutData(d driver.Driver, logger logs.TaggedLogger, cb func(string) error) (retErr error) {
+	pipelineInfo := d.PipelineInfo()
+	dir := filepath.Join(d.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); retErr == nil {
+			retErr = err
+		}
+	}()
+	if err := driver.CreateSpoutFifo(filepath.Join(dir, "out")); err != nil {
+		return err
+	}
+	if pipelineInfo.Spout.Marker != "" {
+		if err := logger.LogStep("downloading spout marker", func() error {
+			return downloadMarker(d, dir)
+		}); err != nil {
+			return err
+		}
+	}
+	return cb(dir)
+}
+
+func downloadMarker(d driver.Driver, dir string) error {
+	pachClient := d.PachClient()
+	pipelineInfo := d.PipelineInfo()
+	repo := pipelineInfo.Pipeline.Name
+	branchInfo, err := pachClient.InspectBranch(repo, ppsconsts.SpoutMarkerBranch)
+	if err != nil {
+		return err
+	}
+	if branchInfo.Head == nil {
+		// The spout has not written a marker yet.
+		return nil
+	}
+	commitInfo, err := pachClient.InspectCommit(repo, branchInfo.Head.ID)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finished == nil {
+		// The last marker update was interrupted, so fall back to the previous marker.
+		if commitInfo.ParentCommit == nil {
+			return nil
+		}
+		commitInfo, err = pachClient.InspectCommit(repo, commitInfo.ParentCommit.ID)
+		if err != nil {
+			return err
+		}
+	}
+	return pfssync.Pull(pachClient, client.NewFile(repo, commitInfo.Commit.ID, "/"+pipelineInfo.Spout.Marker), dir)
+}
@@ -82,6 +82,9 @@ func (td *testDriver) Namespace() string {
 func (td *testDriver) InputDir() string {
 	return td.inner.InputDir()
 }
+func (td *testDriver) DatumReadahead() int {
+	return td.inner.DatumReadahead()
+}
 func (td *testDriver) PachClient() *client.APIClient {
 	return td.inner.PachClient()
 }
@@ -126,6 +129,7 @@ func withTestEnv(db *sqlx.DB, pipelineInfo *pps.PipelineInfo, cb func(*testEnv)
 			"/pachyderm_test",
 			workerDir,
 			"namespace",
+			4,
 		)
 		if err != nil {
 			return err
@@ -18,6 +18,8 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfssync"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/renew"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
@@ -123,7 +125,6 @@ type registry struct {
 
 // TODO:
 // s3 input / gateway stuff (need more information here).
-// egress.
 // Prometheus stats? (previously in the driver, which included testing we should reuse if possible)
 // capture logs (reuse driver tests and reintroduce tagged logger).
 func newRegistry(driver driver.Driver, logger logs.TaggedLogger) (*registry, error) {
@@ -155,7 +156,63 @@ func (reg *registry) succeedJob(pj *pendingJob) error {
 		newState = pps.JobState_JOB_EGRESSING
 	}
 	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
-	return finishJob(reg.driver.PipelineInfo(), reg.driver.PachClient(), pj, newState, "")
+	if err := finishJob(reg.driver.PipelineInfo(), reg.driver.PachClient(), pj, newState, ""); err != nil {
+		return err
+	}
+	if newState == pps.JobState_JOB_EGRESSING {
+		return reg.egressJob(pj.logger, pj.ji)
+	}
+	return nil
+}
+
+// egressJob copies the output commit of a job in the EGRESSING state to the
+// egress URL, then moves the job to SUCCESS (or FAILURE if the egress could
+// not be completed). The output commit is already finished at this point, so
+// the egress does not go through the normal job restart logic. If the worker
+// is shut down during the egress, startJob resumes it after the restart.
+func (reg *registry) egressJob(logger logs.TaggedLogger, jobInfo *pps.JobInfo) error {
+	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
+	pachClient := reg.driver.PachClient()
+	return logger.LogStep("egressing job data", func() error {
+		if err := egress(pachClient, logger, jobInfo); err != nil {
+			if pachClient.Ctx().Err() != nil {
+				return err
+			}
+			logger.Logf("failing job with reason: egress error: %v", err)
+			jobInfo.State = pps.JobState_JOB_FAILURE
+			jobInfo.Reason = fmt.Sprintf("egress error: %v", err)
+		} else {
+			logger.Logf("egress complete, closing job")
+			jobInfo.State = pps.JobState_JOB_SUCCESS
+		}
+		if err := writeJobInfo(pachClient, jobInfo); err != nil && !ppsserver.IsJobFinishedErr(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// egressMaxElapsedTime is how long egress is retried for before the job is
+// failed.
+var egressMaxElapsedTime = 10 * time.Minute
+
+func egress(pachClient *client.APIClient, logger logs.TaggedLogger, jobInfo *pps.JobInfo) error {
+	url, err := obj.ParseURL(jobInfo.Egress.URL)
+	if err != nil {
+		return err
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(url, false)
+	if err != nil {
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = egressMaxElapsedTime
+	return backoff.RetryUntilCancel(pachClient.Ctx(), func() error {
+		return pfssync.PushObj(pachClient, jobInfo.OutputCommit, objClient, url.Object)
+	}, b, func(err error, d time.Duration) error {
+		logger.Logf("egress error: %v, retrying in %v", err, d)
+		return nil
+	})
 }
 
 func (reg *registry) failJob(pj *pendingJob, reason string) error {
@@ -249,6 +306,10 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, metaCommit *pfs.Commit
 	}
 	switch {
 	case commitInfo.Finished != nil:
+		if jobInfo.State == pps.JobState_JOB_EGRESSING {
+			// The worker restarted during the egress, so resume it.
+			return reg.egressJob(reg.logger.WithJob(jobInfo.Job.ID), jobInfo)
+		}
 		if !ppsutil.IsTerminal(jobInfo.State) {
 			jobInfo.State = pps.JobState_JOB_KILLED
 		}
@@ -291,7 +352,6 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, metaCommit *pfs.Commit
 	}); err != nil {
 		return err
 	}
-	// TODO: we should NOT start the job this way if it is in EGRESSING
 	// TODO: This could probably be scoped to a callback, and we could move job specific features
 	// in the chain package (timeouts for example).
 	// TODO: I use the registry pachclient for the iterators, so I can reuse across jobs for skipping.
@@ -486,8 +546,12 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	// This may be resolved by either explicitly generating deletes first (somewhat similar to this hack) or
 	// relying on temporary fileset identifiers being associated with the commit after the datumsets have been
 	// generated (and therefore after the deletes).
+	var numDatums int64
 	if err := pj.withDeleter(pachClient, func() error {
-		return pj.jdit.Iterate(func(_ *datum.Meta) error { return nil })
+		return pj.jdit.Iterate(func(_ *datum.Meta) error {
+			numDatums++
+			return nil
+		})
 	}); err != nil {
 		return err
 	}
@@ -496,17 +560,11 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	subtasks := make(chan *work.Task)
 	if err := pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
 		// Setup goroutine for creating datum set subtasks.
-		// TODO: When the datum set spec is not set, evenly distribute the datums.
 		eg.Go(func() error {
 			defer close(subtasks)
 			storageRoot := filepath.Join(pj.driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
-			var setSpec *datum.SetSpec
-			if pj.driver.PipelineInfo().ChunkSpec != nil {
-				setSpec = &datum.SetSpec{
-					Number: int(pj.driver.PipelineInfo().ChunkSpec.Number),
-				}
-			}
-			return datum.CreateSets(pj.jdit, storageRoot, setSpec, func(upload func(datum.AppendFileTarClient) error) error {
+			setSpec := reg.createSetSpec(pj.driver.PipelineInfo().ChunkSpec, numDatums)
+			return datum.CreateSets(pj.jdit, storageRoot, setSpec, func(upload func(datum.UploadClient) error) error {
 				subtask, err := createDatumSetSubtask(pachClient, pj, upload, renewer)
 				if err != nil {
 					return err
@@ -556,7 +614,29 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	return reg.succeedJob(pj)
 }
 
-func createDatumSetSubtask(pachClient *client.APIClient, pj *pendingJob, upload func(datum.AppendFileTarClient) error, renewer *renew.StringSet) (*work.Task, error) {
+// setsPerWorker is the number of datum sets each worker should expect to
+// process when the datum set spec is determined automatically. Creating more
+// than one set per worker allows faster workers to pick up more of the work.
+const setsPerWorker = 4
+
+// createSetSpec creates the datum set spec for a job.
+// If the chunk spec is not set, the datums are evenly distributed across the
+// expected number of workers.
+func (reg *registry) createSetSpec(chunkSpec *pps.ChunkSpec, numDatums int64) *datum.SetSpec {
+	if chunkSpec != nil && (chunkSpec.Number > 0 || chunkSpec.SizeBytes > 0) {
+		return &datum.SetSpec{
+			Number:    int(chunkSpec.Number),
+			SizeBytes: chunkSpec.SizeBytes,
+		}
+	}
+	number := numDatums / (reg.concurrency * setsPerWorker)
+	if number < 1 {
+		number = 1
+	}
+	return &datum.SetSpec{Number: int(number)}
+}
+
+func createDatumSetSubtask(pachClient *client.APIClient, pj *pendingJob, upload func(datum.UploadClient) error, renewer *renew.StringSet) (*work.Task, error) {
 	resp, err := pachClient.WithCreateFilesetClient(func(ctfsc *client.CreateFilesetClient) error {
 		return upload(ctfsc)
 	})
@@ -18,7 +18,7 @@ type Status struct {
 	mutex         sync.Mutex
 	jobID         string
 	stats         *pps.ProcessStats
-	queueSize     *int64
+	queueSize     int64
 	dataProcessed *int64
 	dataRecovered *int64
 	datum         []*pps.InputFile
@@ -43,13 +43,20 @@ func (s *Status) withLock(cb func()) {
 	s.mutex.Unlock()
 }
 
+// withJob tracks a subtask of a job. A worker may process multiple subtasks
+// at the same time (up to the pipeline's max queue size), so the job is
+// cleared only when the last of them returns.
 func (s *Status) withJob(jobID string, cb func() error) error {
 	s.withLock(func() {
 		s.jobID = jobID
+		s.queueSize++
 	})
 
 	defer s.withLock(func() {
-		s.jobID = ""
+		s.queueSize--
+		if s.queueSize == 0 {
+			s.jobID = ""
+		}
 	})
 
 	return cb()
@@ -100,13 +107,11 @@ func (s *Status) GetStatus() (*pps.WorkerStatus, error) {
 		return nil, err
 	}
 	result := &pps.WorkerStatus{
-		JobID:   s.jobID,
-		Data:    s.datum,
-		Started: started,
-		Stats:   s.stats,
-	}
-	if s.queueSize != nil {
-		result.QueueSize = atomic.LoadInt64(s.queueSize)
+		JobID:     s.jobID,
+		Data:      s.datum,
+		Started:   started,
+		Stats:     s.stats,
+		QueueSize: s.queueSize,
 	}
 	if s.dataProcessed != nil {
 		result.DataProcessed = atomic.LoadInt64(s.dataProcessed)
@@ -5,7 +5,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -316,6 +320,38 @@ func TestJobSuccess(t *testing.T) {
 	}))
 }
 
+func TestJobDatumReadahead(t *testing.T) {
+	pi := defaultPipelineInfo()
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, withWorkerSpawnerPair(db, pi, func(env *testEnv) error {
+		// Record the readahead of the requests that download the input data.
+		var mu sync.Mutex
+		var readaheads []int64
+		env.MockPachd.PFS.GetFile.Use(func(req *pfs.GetFileRequest, server pfs.API_GetFileServer) error {
+			if req.File.Commit.Repo.Name == pi.Input.Pfs.Repo {
+				mu.Lock()
+				readaheads = append(readaheads, req.Readahead)
+				mu.Unlock()
+			}
+			return env.PFSServer.GetFile(req, server)
+		})
+		ctx, etcdJobInfo := mockBasicJob(t, env, pi)
+		triggerJob(t, env, pi, []tarutil.File{
+			tarutil.NewMemFile("/file", []byte("foobar")),
+		})
+		ctx = withTimeout(ctx, 10*time.Second)
+		<-ctx.Done()
+		require.Equal(t, pps.JobState_JOB_SUCCESS, etcdJobInfo.State)
+		mu.Lock()
+		defer mu.Unlock()
+		require.True(t, len(readaheads) > 0)
+		for _, readahead := range readaheads {
+			require.Equal(t, int64(env.driver.DatumReadahead()), readahead)
+		}
+		return nil
+	}))
+}
+
 func TestJobFailedDatum(t *testing.T) {
 	pi := defaultPipelineInfo()
 	db := dbutil.NewTestDB(t)
@@ -465,6 +501,59 @@ func TestJobSerialDelete(t *testing.T) {
 	}))
 }
 
+func TestJobEgress(t *testing.T) {
+	pi := defaultPipelineInfo()
+	egressDir := filepath.Join("tmp", uuid.NewWithoutDashes())
+	defer os.RemoveAll("/" + egressDir)
+	pi.Egress = &pps.Egress{URL: "local://" + egressDir}
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, withWorkerSpawnerPair(db, pi, func(env *testEnv) error {
+		ctx, etcdJobInfo := mockBasicJob(t, env, pi)
+		tarFiles := []tarutil.File{
+			tarutil.NewMemFile("/a", []byte("foobar")),
+			tarutil.NewMemFile("/b", []byte("barfoo")),
+		}
+		triggerJob(t, env, pi, tarFiles)
+		ctx = withTimeout(ctx, 10*time.Second)
+		<-ctx.Done()
+		require.Equal(t, pps.JobState_JOB_SUCCESS, etcdJobInfo.State)
+
+		// Ensure the output files were egressed.
+		for _, name := range []string{"a", "b"} {
+			data, err := ioutil.ReadFile(filepath.Join("/", egressDir, name))
+			require.NoError(t, err)
+			r, err := env.PachClient.GetTarFile(pi.Pipeline.Name, etcdJobInfo.OutputCommit.ID, "/"+name)
+			require.NoError(t, err)
+			require.NoError(t, tarutil.Iterate(r, func(file tarutil.File) error {
+				ok, err := tarutil.Equal(tarutil.NewMemFile("/"+name, data), file)
+				require.NoError(t, err)
+				require.True(t, ok)
+				return nil
+			}))
+		}
+		return nil
+	}))
+}
+
+func TestJobEgressFailure(t *testing.T) {
+	defer func(d time.Duration) { egressMaxElapsedTime = d }(egressMaxElapsedTime)
+	egressMaxElapsedTime = time.Second
+	pi := defaultPipelineInfo()
+	// Egress can never succeed, as the destination is inside a file.
+	pi.Egress = &pps.Egress{URL: "local:///dev/null/egress"}
+	db := dbutil.NewTestDB(t)
+	require.NoError(t, withWorkerSpawnerPair(db, pi, func(env *testEnv) error {
+		ctx, etcdJobInfo := mockBasicJob(t, env, pi)
+		triggerJob(t, env, pi, []tarutil.File{tarutil.NewMemFile("/a", []byte("foobar"))})
+		ctx = withTimeout(ctx, 30*time.Second)
+		<-ctx.Done()
+		// The job fails once egress stops being retried.
+		require.Equal(t, pps.JobState_JOB_FAILURE, etcdJobInfo.State)
+		require.True(t, strings.HasPrefix(etcdJobInfo.Reason, "egress error"))
+		return nil
+	}))
+}
+
 func deleteFiles(t *testing.T, env *testEnv, pi *pps.PipelineInfo, files []string) {
 	commit, err := env.PachClient.StartCommit(pi.Input.Pfs.Repo, "master")
 	require.NoError(t, err)
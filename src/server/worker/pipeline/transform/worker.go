@@ -16,13 +16,9 @@ import (
 
 // Worker handles a transform pipeline work subtask, then returns.
 // TODO:
-// datum queuing (probably should be handled by datum package).
 // s3 input / gateway stuff (need more information here).
-// spouts.
-// joins.
 // capture datum logs.
 // file download features (empty / lazy files). Need to check over the pipe logic.
-// git inputs.
 // handle custom user set for execution.
 // Taking advantage of symlinks during upload?
 func Worker(driver driver.Driver, logger logs.TaggedLogger, subtask *work.Task, status *Status) (retErr error) {
@@ -69,25 +65,32 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						}
 						opts = append(opts, datum.WithTimeout(timeout))
 					}
+					// The datum logger is set up once the datum's log writer exists,
+					// before the user code (or the error handling code) runs.
+					var datumLogger logs.TaggedLogger
 					if driver.PipelineInfo().Transform.ErrCmd != nil {
 						opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
-							return driver.RunUserErrorHandlingCode(runCtx, logger, env)
+							return driver.RunUserErrorHandlingCode(runCtx, datumLogger, env)
 						}))
 					}
 					return s.WithDatum(ctx, meta, func(d *datum.Datum) error {
 						cancelCtx, cancel := context.WithCancel(ctx)
 						defer cancel()
-						return status.withDatum(inputs, cancel, func() error {
-							return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
+						// Persist the datum's logs in the meta output.
+						datumLogger = logger.WithData(inputs).WithWriter(d.LogWriter())
+						// The active data is shared by the subtasks that the worker
+						// processes concurrently, so the user code runs one datum at a time.
+						return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
+							return status.withDatum(inputs, cancel, func() error {
 								return d.Run(cancelCtx, func(runCtx context.Context) error {
-									return driver.RunUserCode(runCtx, logger, env)
+									return driver.RunUserCode(runCtx, datumLogger, env)
 								})
 							})
 						})
 					}, opts...)
 
 				})
-			}, datum.WithMetaOutput(mfcMeta), datum.WithPFSOutput(mfcPFS), datum.WithStats(datumSet.Stats))
+			}, datum.WithMetaOutput(mfcMeta), datum.WithPFSOutput(mfcPFS), datum.WithStats(datumSet.Stats), datum.WithReadahead(driver.DatumReadahead()))
 		})
 	})
 }
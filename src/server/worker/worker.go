@@ -21,6 +21,8 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/work"
 	"github.com/pachyderm/pachyderm/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/src/server/worker/pipeline/service"
+	"github.com/pachyderm/pachyderm/src/server/worker/pipeline/spout"
 	"github.com/pachyderm/pachyderm/src/server/worker/pipeline/transform"
 	"github.com/pachyderm/pachyderm/src/server/worker/server"
 	"github.com/pachyderm/pachyderm/src/server/worker/stats"
@@ -50,6 +52,7 @@ func NewWorker(
 	workerName string,
 	namespace string,
 	rootPath string,
+	datumReadahead int,
 ) (*Worker, error) {
 	stats.InitPrometheus()
 
@@ -65,6 +68,7 @@ func NewWorker(
 		etcdPrefix,
 		rootPath,
 		namespace,
+		datumReadahead,
 	)
 	if err != nil {
 		return nil, err
@@ -189,11 +193,10 @@ type spawnerFunc func(driver.Driver, logs.TaggedLogger) error
 func runSpawner(driver driver.Driver, logger logs.TaggedLogger) error {
 	pipelineType, runFn := func() (string, spawnerFunc) {
 		switch {
-		// TODO: Make work with V2.
-		//case driver.PipelineInfo().Service != nil:
-		//	return "service", service.Run
-		//case driver.PipelineInfo().Spout != nil:
-		//	return "spout", spout.Run
+		case driver.PipelineInfo().Service != nil:
+			return "service", service.Run
+		case driver.PipelineInfo().Spout != nil:
+			return "spout", spout.Run
 		default:
 			return "transform", transform.Run
 		}